@@ -94,6 +94,10 @@ import (
 
 const appName = "WasmApp"
 
+// WasmMigrationsUpgradeName is the name of the upgrade plan that migrates the wasm module state of chains that started
+// with an earlier version. The migrations can be applied to an up to date state, too.
+const WasmMigrationsUpgradeName = "wasm-migrations"
+
 // We pull these out so we can set them with LDFLAGS in the Makefile
 var (
 	NodeDir      = ".wasmd"
@@ -181,6 +185,7 @@ var (
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 		govtypes.ModuleName:            {authtypes.Burner},
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
+		wasm.ModuleName:                nil,
 	}
 
 	// module accounts that are allowed to receive tokens
@@ -375,6 +380,15 @@ func NewWasmApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest b
 		wasmOpts...,
 	)
 
+	app.upgradeKeeper.SetUpgradeHandler(WasmMigrationsUpgradeName, func(ctx sdk.Context, plan upgradetypes.Plan) {
+		m := wasm.NewMigrator(app.wasmKeeper)
+		for _, migrate := range []func(sdk.Context) error{m.Migrate1to2, m.Migrate2to3, m.Migrate3to4, m.Migrate4to5} {
+			if err := migrate(ctx); err != nil {
+				panic(err)
+			}
+		}
+	})
+
 	// The gov proposal types can be individually enabled
 	if len(enabledProposals) != 0 {
 		govRouter.AddRoute(wasm.RouterKey, wasm.NewWasmProposalHandler(app.wasmKeeper, enabledProposals))
@@ -430,7 +444,7 @@ func NewWasmApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest b
 		upgradetypes.ModuleName, minttypes.ModuleName, distrtypes.ModuleName, slashingtypes.ModuleName,
 		evidencetypes.ModuleName, stakingtypes.ModuleName, ibchost.ModuleName,
 	)
-	app.mm.SetOrderEndBlockers(crisistypes.ModuleName, govtypes.ModuleName, stakingtypes.ModuleName)
+	app.mm.SetOrderEndBlockers(crisistypes.ModuleName, govtypes.ModuleName, stakingtypes.ModuleName, wasm.ModuleName)
 
 	// NOTE: The genutils module must occur after staking so that pools are
 	// properly initialized with tokens from genesis accounts.
@@ -1,16 +1,26 @@
 package app
 
 import (
+	"bytes"
 	"encoding/json"
+	"fmt"
+	"io/ioutil"
 	"os"
 	"testing"
+	"time"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	db "github.com/tendermint/tm-db"
 
 	"github.com/CosmWasm/wasmd/x/wasm"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
@@ -107,3 +117,43 @@ func setGenesis(gapp *WasmApp) error {
 	gapp.Commit()
 	return nil
 }
+
+func TestWasmMigrationsUpgrade(t *testing.T) {
+	wasmApp := Setup(false)
+	ctx := wasmApp.BaseApp.NewContext(false, tmproto.Header{Height: 10, Time: time.Now()})
+	k := wasmApp.wasmKeeper
+	creator := sdk.AccAddress(bytes.Repeat([]byte{1}, sdk.AddrLen))
+	wasmCode, err := ioutil.ReadFile("../x/wasm/keeper/testdata/hackatom.wasm")
+	require.NoError(t, err)
+	codeID, err := k.Create(ctx, creator, wasmCode, "", "", nil)
+	require.NoError(t, err)
+	initMsg := []byte(fmt.Sprintf(`{"verifier":%q,"beneficiary":%q}`, creator.String(), creator.String()))
+	contractAddr, _, err := k.Instantiate(ctx, codeID, creator, nil, initMsg, "demo contract", nil)
+	require.NoError(t, err)
+	expStats := k.GetContractStorageStats(ctx, contractAddr)
+	require.NotZero(t, expStats.KeyCount)
+	checksum := k.GetCodeInfo(ctx, codeID).CodeHash
+	creatorKey := wasmtypes.GetContractByCreatorSecondaryIndexKey(creator, contractAddr, k.GetContractInfo(ctx, contractAddr))
+
+	// the state of a chain that started with the initial version
+	paramStore := prefix.NewStore(ctx.KVStore(wasmApp.keys[paramstypes.StoreKey]), []byte(wasm.ModuleName+"/"))
+	for _, key := range [][]byte{wasmtypes.ParamStoreKeyGasMultiplier, wasmtypes.ParamStoreKeyInstanceCost, wasmtypes.ParamStoreKeyMaxScheduledCallbackGas} {
+		paramStore.Delete(key)
+	}
+	wasmStore := ctx.KVStore(wasmApp.keys[wasmtypes.StoreKey])
+	wasmStore.Delete(wasmtypes.GetContractStorageStatsKey(contractAddr))
+	wasmStore.Delete(creatorKey)
+	wasmStore.Delete(wasmtypes.GetCodeByChecksumIndexKey(checksum, codeID))
+	assert.Equal(t, wasmtypes.DefaultParams(), k.GetParams(ctx))
+
+	// when
+	wasmApp.upgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: WasmMigrationsUpgradeName, Height: ctx.BlockHeight()})
+
+	// then
+	assert.True(t, paramStore.Has(wasmtypes.ParamStoreKeyGasMultiplier))
+	assert.True(t, paramStore.Has(wasmtypes.ParamStoreKeyMaxScheduledCallbackGas))
+	assert.Equal(t, wasmtypes.DefaultParams(), k.GetParams(ctx))
+	assert.Equal(t, expStats, k.GetContractStorageStats(ctx, contractAddr))
+	assert.True(t, wasmStore.Has(creatorKey))
+	assert.Equal(t, []uint64{codeID}, k.GetCodeIDsByChecksum(ctx, checksum))
+}
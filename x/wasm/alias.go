@@ -67,6 +67,7 @@ var (
 	EncodeStakingMsg          = keeper.EncodeStakingMsg
 	EncodeWasmMsg             = keeper.EncodeWasmMsg
 	NewKeeper                 = keeper.NewKeeper
+	NewMigrator               = keeper.NewMigrator
 	NewLegacyQuerier          = keeper.NewLegacyQuerier
 	DefaultQueryPlugins       = keeper.DefaultQueryPlugins
 	BankQuerier               = keeper.BankQuerier
@@ -102,40 +103,60 @@ var (
 )
 
 type (
-	ProposalType                   = types.ProposalType
-	GenesisState                   = types.GenesisState
-	Code                           = types.Code
-	Contract                       = types.Contract
-	MsgStoreCode                   = types.MsgStoreCode
-	MsgStoreCodeResponse           = types.MsgStoreCodeResponse
-	MsgInstantiateContract         = types.MsgInstantiateContract
-	MsgInstantiateContractResponse = types.MsgInstantiateContractResponse
-	MsgExecuteContract             = types.MsgExecuteContract
-	MsgExecuteContractResponse     = types.MsgExecuteContractResponse
-	MsgMigrateContract             = types.MsgMigrateContract
-	MsgMigrateContractResponse     = types.MsgMigrateContractResponse
-	MsgUpdateAdmin                 = types.MsgUpdateAdmin
-	MsgUpdateAdminResponse         = types.MsgUpdateAdminResponse
-	MsgClearAdmin                  = types.MsgClearAdmin
-	MsgWasmIBCCall                 = types.MsgIBCSend
-	MsgClearAdminResponse          = types.MsgClearAdminResponse
-	MsgServer                      = types.MsgServer
-	Model                          = types.Model
-	CodeInfo                       = types.CodeInfo
-	ContractInfo                   = types.ContractInfo
-	CreatedAt                      = types.AbsoluteTxPosition
-	Config                         = types.WasmConfig
-	ContractInfoWithAddress        = types.ContractInfoWithAddress
-	CodeInfoResponse               = types.CodeInfoResponse
-	MessageHandler                 = keeper.SDKMessageHandler
-	BankEncoder                    = keeper.BankEncoder
-	CustomEncoder                  = keeper.CustomEncoder
-	StakingEncoder                 = keeper.StakingEncoder
-	WasmEncoder                    = keeper.WasmEncoder
-	MessageEncoders                = keeper.MessageEncoders
-	Keeper                         = keeper.Keeper
-	QueryHandler                   = keeper.QueryHandler
-	CustomQuerier                  = keeper.CustomQuerier
-	QueryPlugins                   = keeper.QueryPlugins
-	Option                         = keeper.Option
+	ProposalType                           = types.ProposalType
+	GenesisState                           = types.GenesisState
+	Code                                   = types.Code
+	Contract                               = types.Contract
+	MsgStoreCode                           = types.MsgStoreCode
+	MsgStoreCodeResponse                   = types.MsgStoreCodeResponse
+	MsgInstantiateContract                 = types.MsgInstantiateContract
+	MsgInstantiateContractResponse         = types.MsgInstantiateContractResponse
+	MsgInstantiateContract2                = types.MsgInstantiateContract2
+	MsgInstantiateContract2Response        = types.MsgInstantiateContract2Response
+	MsgStoreAndInstantiateContract         = types.MsgStoreAndInstantiateContract
+	MsgStoreAndInstantiateContractResponse = types.MsgStoreAndInstantiateContractResponse
+	MsgExecuteContract                     = types.MsgExecuteContract
+	MsgExecuteContractResponse             = types.MsgExecuteContractResponse
+	MsgMigrateContract                     = types.MsgMigrateContract
+	MsgMigrateContractResponse             = types.MsgMigrateContractResponse
+	MsgUpdateAdmin                         = types.MsgUpdateAdmin
+	MsgUpdateAdminResponse                 = types.MsgUpdateAdminResponse
+	MsgClearAdmin                          = types.MsgClearAdmin
+	MsgWasmIBCCall                         = types.MsgIBCSend
+	MsgClearAdminResponse                  = types.MsgClearAdminResponse
+	MsgProposeAdmin                        = types.MsgProposeAdmin
+	MsgProposeAdminResponse                = types.MsgProposeAdminResponse
+	MsgAcceptAdmin                         = types.MsgAcceptAdmin
+	MsgAcceptAdminResponse                 = types.MsgAcceptAdminResponse
+	MsgCancelProposedAdmin                 = types.MsgCancelProposedAdmin
+	MsgCancelProposedAdminResponse         = types.MsgCancelProposedAdminResponse
+	MsgUpdateMigrationAllowlist            = types.MsgUpdateMigrationAllowlist
+	MsgUpdateMigrationAllowlistResponse    = types.MsgUpdateMigrationAllowlistResponse
+	MsgDeleteCode                          = types.MsgDeleteCode
+	MsgDeleteCodeResponse                  = types.MsgDeleteCodeResponse
+	MsgUpdateInstantiateConfig             = types.MsgUpdateInstantiateConfig
+	MsgUpdateInstantiateConfigResponse     = types.MsgUpdateInstantiateConfigResponse
+	MsgScheduleCallback                    = types.MsgScheduleCallback
+	MsgScheduleCallbackResponse            = types.MsgScheduleCallbackResponse
+	MsgCancelCallback                      = types.MsgCancelCallback
+	MsgCancelCallbackResponse              = types.MsgCancelCallbackResponse
+	MsgServer                              = types.MsgServer
+	Model                                  = types.Model
+	CodeInfo                               = types.CodeInfo
+	ContractInfo                           = types.ContractInfo
+	CreatedAt                              = types.AbsoluteTxPosition
+	Config                                 = types.WasmConfig
+	ContractInfoWithAddress                = types.ContractInfoWithAddress
+	CodeInfoResponse                       = types.CodeInfoResponse
+	MessageHandler                         = keeper.SDKMessageHandler
+	BankEncoder                            = keeper.BankEncoder
+	CustomEncoder                          = keeper.CustomEncoder
+	StakingEncoder                         = keeper.StakingEncoder
+	WasmEncoder                            = keeper.WasmEncoder
+	MessageEncoders                        = keeper.MessageEncoders
+	Keeper                                 = keeper.Keeper
+	QueryHandler                           = keeper.QueryHandler
+	CustomQuerier                          = keeper.CustomQuerier
+	QueryPlugins                           = keeper.QueryPlugins
+	Option                                 = keeper.Option
 )
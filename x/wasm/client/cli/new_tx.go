@@ -1,14 +1,17 @@
 package cli
 
 import (
+	"fmt"
 	"strconv"
 
 	"github.com/CosmWasm/wasmd/x/wasm/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
 )
 
 // MigrateContractCmd will migrate a contract to a new code version
@@ -109,3 +112,322 @@ func ClearContractAdminCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// ProposeContractAdminCmd records a pending admin for a contract
+func ProposeContractAdminCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-contract-admin [contract_addr_bech32] [pending_admin_addr_bech32]",
+		Short: "Proposes a new admin for a contract that takes over when it accepts",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgProposeAdmin{
+				Sender:       clientCtx.GetFromAddress().String(),
+				Contract:     args[0],
+				PendingAdmin: args[1],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// AcceptContractAdminCmd completes the admin transfer to the pending admin
+func AcceptContractAdminCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-contract-admin [contract_addr_bech32]",
+		Short: "Accepts the admin role for a contract as the pending admin",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgAcceptAdmin{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Contract: args[0],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CancelProposedContractAdminCmd removes the pending admin of a contract
+func CancelProposedContractAdminCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-proposed-contract-admin [contract_addr_bech32]",
+		Short: "Removes the pending admin of a contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgCancelProposedAdmin{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Contract: args[0],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// DeleteCodeCmd removes an unused wasm code
+func DeleteCodeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete-code [code_id_int64]",
+		Short: "Deletes a wasm code that is neither pinned nor used by any contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "code id")
+			}
+			msg := types.MsgDeleteCode{
+				Sender: clientCtx.GetFromAddress().String(),
+				CodeID: codeID,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// ScheduleCallbackCmd registers a sudo call into a contract for a future block
+func ScheduleCallbackCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule-callback [contract_addr_bech32] [json_encoded_sudo_args] --at-height [height] --callback-gas [gas] --callback-fee [coins]",
+		Short: "Schedules a sudo call into a contract at the end of a future block",
+		Long: `Schedules a sudo call into a contract at the end of a future block. With --interval the call is repeated
+every number of blocks until it is canceled. The callback fee is paid by the sender for every execution. It is
+required and must cover the min callback fee of the chain params. Only the contract itself or its admin can schedule callbacks.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			height, err := cmd.Flags().GetUint64(flagAtHeight)
+			if err != nil {
+				return sdkerrors.Wrap(err, "height")
+			}
+			interval, err := cmd.Flags().GetUint64(flagInterval)
+			if err != nil {
+				return sdkerrors.Wrap(err, "interval")
+			}
+			gasLimit, err := cmd.Flags().GetUint64(flagCallbackGas)
+			if err != nil {
+				return sdkerrors.Wrap(err, "callback gas")
+			}
+			feeStr, err := cmd.Flags().GetString(flagCallbackFee)
+			if err != nil {
+				return sdkerrors.Wrap(err, "callback fee")
+			}
+			fee, err := sdk.ParseCoinsNormalized(feeStr)
+			if err != nil {
+				return sdkerrors.Wrap(err, "callback fee")
+			}
+			msg := types.MsgScheduleCallback{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Contract: args[0],
+				Msg:      []byte(args[1]),
+				Height:   height,
+				Interval: interval,
+				GasLimit: gasLimit,
+				Fee:      fee,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().Uint64(flagAtHeight, 0, "Block height of the first execution")
+	cmd.Flags().Uint64(flagInterval, 0, "Number of blocks between repeated executions, optional")
+	cmd.Flags().Uint64(flagCallbackGas, 0, "Max gas a single execution can consume")
+	cmd.Flags().String(flagCallbackFee, "", "Fee paid for every execution")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CancelCallbackCmd removes a scheduled callback
+func CancelCallbackCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-callback [callback_id_int64]",
+		Short: "Cancels a scheduled callback. Paid fees are not refunded",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "callback id")
+			}
+			msg := types.MsgCancelCallback{
+				Sender: clientCtx.GetFromAddress().String(),
+				ID:     id,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// UpdateInstantiateConfigCmd sets the instantiate permission of a wasm code
+func UpdateInstantiateConfigCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-instantiate-config [code_id_int64]",
+		Short: "Sets the instantiate permission of a wasm code",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "code id")
+			}
+			perm, err := parseNewInstantiatePermission(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			msg := types.MsgUpdateInstantiateConfig{
+				Sender:                   clientCtx.GetFromAddress().String(),
+				CodeID:                   codeID,
+				NewInstantiatePermission: perm,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	addNewInstantiatePermissionFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func addNewInstantiatePermissionFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagInstantiateByEverybody, "", "Everybody can instantiate a contract from the code")
+	cmd.Flags().String(flagInstantiateByAddress, "", "Only this address can instantiate a contract instance from the code")
+	cmd.Flags().StringSlice(flagInstantiateByAnyOfAddress, []string{}, "Any of the addresses can instantiate a contract instance from the code")
+	cmd.Flags().String(flagInstantiateByContract, "", "Only this contract can instantiate a contract instance from the code")
+	cmd.Flags().Bool(flagInstantiateNobody, false, "Nobody except the governance process can instantiate a contract from the code")
+}
+
+// parseNewInstantiatePermission returns the instantiate permission that is set by the flags. Exactly one
+// permission is required.
+func parseNewInstantiatePermission(flags *flag.FlagSet) (*types.AccessConfig, error) {
+	nobody, err := flags.GetBool(flagInstantiateNobody)
+	if err != nil {
+		return nil, fmt.Errorf("instantiate by nobody: %s", err)
+	}
+	perm, err := parseAccessConfigFlags(flags)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case nobody && perm != nil:
+		return nil, fmt.Errorf("%s can not be combined with other permissions", flagInstantiateNobody)
+	case nobody:
+		return &types.AllowNobody, nil
+	case perm == nil:
+		return nil, fmt.Errorf("instantiate permission required: set one of --%s, --%s, --%s, --%s or --%s",
+			flagInstantiateByAddress, flagInstantiateByAnyOfAddress, flagInstantiateByContract, flagInstantiateByEverybody, flagInstantiateNobody)
+	}
+	return perm, nil
+}
+
+// UpdateMigrationAllowlistCmd sets the codes that a contract can be migrated to
+func UpdateMigrationAllowlistCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-migration-allowlist [contract_addr_bech32] --code-ids [code_ids] --code-creators [addresses]",
+		Short: "Sets the code ids and code creators that a contract can be migrated to",
+		Long: "Sets the code ids and code creators that a contract can be migrated to. Once an allowlist is set, " +
+			"entries can only be removed. An empty allowlist prevents migrations that are not done by governance.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			allowlist, err := parseMigrationAllowlist(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			msg := types.MsgUpdateMigrationAllowlist{
+				Sender:    clientCtx.GetFromAddress().String(),
+				Contract:  args[0],
+				Allowlist: allowlist,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	addMigrationAllowlistFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func addMigrationAllowlistFlags(cmd *cobra.Command) {
+	cmd.Flags().UintSlice(flagAllowedCodeIDs, []uint{}, "Code ids that the contract can be migrated to")
+	cmd.Flags().StringSlice(flagAllowedCodeCreators, []string{}, "Creators of the codes that the contract can be migrated to")
+}
+
+func parseMigrationAllowlist(flags *flag.FlagSet) (types.MigrationAllowlist, error) {
+	codeIDs, err := flags.GetUintSlice(flagAllowedCodeIDs)
+	if err != nil {
+		return types.MigrationAllowlist{}, fmt.Errorf("code ids: %s", err)
+	}
+	creators, err := flags.GetStringSlice(flagAllowedCodeCreators)
+	if err != nil {
+		return types.MigrationAllowlist{}, fmt.Errorf("code creators: %s", err)
+	}
+	allowlist := types.MigrationAllowlist{CodeCreators: creators}
+	for _, id := range codeIDs {
+		allowlist.CodeIDs = append(allowlist.CodeIDs, uint64(id))
+	}
+	return allowlist, nil
+}
@@ -31,14 +31,50 @@ func GetQueryCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		GetCmdListCode(),
 		GetCmdListContractByCode(),
+		GetCmdListContractsByCreator(),
+		GetCmdListContractsByAdmin(),
 		GetCmdQueryCode(),
+		GetCmdQueryCodeByChecksum(),
 		GetCmdGetContractInfo(),
 		GetCmdGetContractHistory(),
 		GetCmdGetContractState(),
+		GetCmdBuildAddress(),
+		GetCmdListScheduledCallbacks(),
+		GetCmdListAcceptedStargateQueries(),
+		GetCmdListStargateMsgs(),
+		GetCmdGetContractStorageStats(),
 	)
 	return queryCmd
 }
 
+// GetCmdBuildAddress computes the address of a contract instantiated with a salt
+func GetCmdBuildAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "build-address [code-hash] [creator-address] [salt]",
+		Short: "build contract address",
+		Long:  "Computes the address a contract gets when it is instantiated with instantiate2. The code hash is hex encoded.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			codeHash, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("code-hash: %s", err)
+			}
+			creator, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return fmt.Errorf("creator: %s", err)
+			}
+			salt, err := decodeSalt(args[2], cmd.Flags())
+			if err != nil {
+				return err
+			}
+			cmd.Println(types.BuildContractAddressPredictable(codeHash, creator, salt).String())
+			return nil
+		},
+	}
+	cmd.Flags().Bool(flagHexSalt, false, "Salt is hex encoded")
+	return cmd
+}
+
 // GetCmdListCode lists all wasm code uploaded
 func GetCmdListCode() *cobra.Command {
 	cmd := &cobra.Command{
@@ -74,6 +110,135 @@ func GetCmdListCode() *cobra.Command {
 	return cmd
 }
 
+// GetCmdListScheduledCallbacks lists all pending scheduled callbacks
+func GetCmdListScheduledCallbacks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-scheduled-callbacks",
+		Short: "List all pending scheduled callbacks",
+		Long:  "List all pending scheduled callbacks, optionally filtered by contract address",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract, err := cmd.Flags().GetString(flagContract)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ScheduledCallbacks(
+				context.Background(),
+				&types.QueryScheduledCallbacksRequest{
+					Contract:   contract,
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.WithJSONMarshaler(&VanillaStdJsonMarshaller{}).PrintProto(res)
+		},
+	}
+	cmd.Flags().String(flagContract, "", "Contract address to filter the callbacks by")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "list scheduled callbacks")
+	return cmd
+}
+
+// GetCmdListAcceptedStargateQueries lists the gRPC query paths that contracts can call
+func GetCmdListAcceptedStargateQueries() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-accepted-stargate-queries",
+		Short: "List the gRPC query paths that contracts can call",
+		Long:  "List the gRPC query paths that contracts can call via stargate queries",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.AcceptedStargateQueries(
+				context.Background(),
+				&types.QueryAcceptedStargateQueriesRequest{},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdListStargateMsgs lists the message type URLs that contracts can or can not dispatch
+func GetCmdListStargateMsgs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-stargate-msgs",
+		Short: "List the message types that contracts can dispatch",
+		Long:  "List the accepted and denied message type URLs that contracts can dispatch via stargate messages",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.StargateMsgs(
+				context.Background(),
+				&types.QueryStargateMsgsRequest{},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdGetContractStorageStats shows the storage usage of a contract
+func GetCmdGetContractStorageStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-storage-stats [bech32_address]",
+		Short: "Prints out the storage usage of a contract",
+		Long:  "Prints out the number of keys and bytes that a contract stores and the storage limit of the contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractStorageStats(
+				context.Background(),
+				&types.QueryContractStorageStatsRequest{Address: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdListContractByCode lists all wasm code uploaded for given code id
 func GetCmdListContractByCode() *cobra.Command {
 	cmd := &cobra.Command{
@@ -115,6 +280,88 @@ func GetCmdListContractByCode() *cobra.Command {
 	return cmd
 }
 
+// GetCmdListContractsByCreator lists all contracts that were instantiated by an address
+func GetCmdListContractsByCreator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-contracts-by-creator [creator]",
+		Short: "List all contracts instantiated by the given address",
+		Long:  "List all contracts instantiated by the given address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractsByCreator(
+				context.Background(),
+				&types.QueryContractsByCreatorRequest{
+					CreatorAddress: args[0],
+					Pagination:     pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.WithJSONMarshaler(&VanillaStdJsonMarshaller{}).PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "list contracts by creator")
+	return cmd
+}
+
+// GetCmdListContractsByAdmin lists all contracts that an address is the admin of
+func GetCmdListContractsByAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-contracts-by-admin [admin]",
+		Short: "List all contracts with the given admin address",
+		Long:  "List all contracts with the given admin address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractsByAdmin(
+				context.Background(),
+				&types.QueryContractsByAdminRequest{
+					AdminAddress: args[0],
+					Pagination:   pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.WithJSONMarshaler(&VanillaStdJsonMarshaller{}).PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "list contracts by admin")
+	return cmd
+}
+
 // GetCmdQueryCode returns the bytecode for a given contract
 func GetCmdQueryCode() *cobra.Command {
 	cmd := &cobra.Command{
@@ -155,6 +402,40 @@ func GetCmdQueryCode() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryCodeByChecksum lists the code infos for a given checksum
+func GetCmdQueryCodeByChecksum() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "code-by-hash [checksum]",
+		Short: "Prints out metadata of all codes with the given checksum",
+		Long:  "Prints out metadata of all codes with the given checksum. The checksum is the hex encoded sha256 hash of the wasm code.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := hex.DecodeString(args[0]); err != nil {
+				return fmt.Errorf("checksum: %s", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CodeByChecksum(
+				context.Background(),
+				&types.QueryCodeByChecksumRequest{
+					Checksum: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdGetContractInfo gets details about a given contract
 func GetCmdGetContractInfo() *cobra.Command {
 	cmd := &cobra.Command{
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -18,15 +19,28 @@ import (
 )
 
 const (
-	flagAmount                 = "amount"
-	flagSource                 = "source"
-	flagBuilder                = "builder"
-	flagLabel                  = "label"
-	flagAdmin                  = "admin"
-	flagRunAs                  = "run-as"
-	flagInstantiateByEverybody = "instantiate-everybody"
-	flagInstantiateByAddress   = "instantiate-only-address"
-	flagProposalType           = "type"
+	flagAmount                    = "amount"
+	flagSource                    = "source"
+	flagBuilder                   = "builder"
+	flagLabel                     = "label"
+	flagAdmin                     = "admin"
+	flagRunAs                     = "run-as"
+	flagInstantiateByEverybody    = "instantiate-everybody"
+	flagInstantiateByAddress      = "instantiate-only-address"
+	flagInstantiateByAnyOfAddress = "instantiate-anyof-addresses"
+	flagInstantiateByContract     = "instantiate-only-contract"
+	flagInstantiateNobody         = "instantiate-nobody"
+	flagProposalType              = "type"
+	flagHexSalt                   = "hex"
+	flagAtHeight                  = "at-height"
+	flagInterval                  = "interval"
+	flagCallbackGas               = "callback-gas"
+	flagCallbackFee               = "callback-fee"
+	flagContract                  = "contract"
+	flagFromCommunityPool         = "from-community-pool"
+	flagAllowedCodeIDs            = "code-ids"
+	flagAllowedCodeCreators       = "code-creators"
+	flagRemoveAllowlist           = "remove"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -41,10 +55,20 @@ func GetTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		StoreCodeCmd(),
 		InstantiateContractCmd(),
+		InstantiateContract2Cmd(),
+		StoreAndInstantiateContractCmd(),
 		ExecuteContractCmd(),
 		MigrateContractCmd(),
 		UpdateContractAdminCmd(),
 		ClearContractAdminCmd(),
+		ProposeContractAdminCmd(),
+		AcceptContractAdminCmd(),
+		CancelProposedContractAdminCmd(),
+		DeleteCodeCmd(),
+		UpdateInstantiateConfigCmd(),
+		UpdateMigrationAllowlistCmd(),
+		ScheduleCallbackCmd(),
+		CancelCallbackCmd(),
 	)
 	return txCmd
 }
@@ -72,10 +96,68 @@ func StoreCodeCmd() *cobra.Command {
 	cmd.Flags().String(flagBuilder, "", "A valid docker tag for the build system, optional")
 	cmd.Flags().String(flagInstantiateByEverybody, "", "Everybody can instantiate a contract from the code, optional")
 	cmd.Flags().String(flagInstantiateByAddress, "", "Only this address can instantiate a contract instance from the code, optional")
+	cmd.Flags().StringSlice(flagInstantiateByAnyOfAddress, []string{}, "Any of the addresses can instantiate a contract instance from the code, optional")
+	cmd.Flags().String(flagInstantiateByContract, "", "Only this contract can instantiate a contract instance from the code, optional")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
 
+// parseAccessConfigFlags returns the instantiate permission that is set by the flags or nil
+func parseAccessConfigFlags(flags *flag.FlagSet) (*types.AccessConfig, error) {
+	onlyAddrStr, err := flags.GetString(flagInstantiateByAddress)
+	if err != nil {
+		return nil, fmt.Errorf("instantiate by address: %s", err)
+	}
+	if onlyAddrStr != "" {
+		allowedAddr, err := sdk.AccAddressFromBech32(onlyAddrStr)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, flagInstantiateByAddress)
+		}
+		x := types.AccessTypeOnlyAddress.With(allowedAddr)
+		return &x, nil
+	}
+	anyAddrStrs, err := flags.GetStringSlice(flagInstantiateByAnyOfAddress)
+	if err != nil {
+		return nil, fmt.Errorf("instantiate by any of addresses: %s", err)
+	}
+	if len(anyAddrStrs) != 0 {
+		allowedAddrs := make([]sdk.AccAddress, len(anyAddrStrs))
+		for i, v := range anyAddrStrs {
+			if allowedAddrs[i], err = sdk.AccAddressFromBech32(v); err != nil {
+				return nil, sdkerrors.Wrap(err, flagInstantiateByAnyOfAddress)
+			}
+		}
+		x := types.AccessTypeAnyOfAddresses.With(allowedAddrs...)
+		return &x, nil
+	}
+	onlyContractStr, err := flags.GetString(flagInstantiateByContract)
+	if err != nil {
+		return nil, fmt.Errorf("instantiate by contract: %s", err)
+	}
+	if onlyContractStr != "" {
+		contractAddr, err := sdk.AccAddressFromBech32(onlyContractStr)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, flagInstantiateByContract)
+		}
+		x := types.AccessTypeOnlyContract.With(contractAddr)
+		return &x, nil
+	}
+	everybodyStr, err := flags.GetString(flagInstantiateByEverybody)
+	if err != nil {
+		return nil, fmt.Errorf("instantiate by everybody: %s", err)
+	}
+	if everybodyStr != "" {
+		ok, err := strconv.ParseBool(everybodyStr)
+		if err != nil {
+			return nil, fmt.Errorf("boolean value expected for instantiate by everybody: %s", err)
+		}
+		if ok {
+			return &types.AllowEverybody, nil
+		}
+	}
+	return nil, nil
+}
+
 func parseStoreCodeArgs(file string, sender sdk.AccAddress, flags *flag.FlagSet) (types.MsgStoreCode, error) {
 	wasm, err := ioutil.ReadFile(file)
 	if err != nil {
@@ -93,32 +175,9 @@ func parseStoreCodeArgs(file string, sender sdk.AccAddress, flags *flag.FlagSet)
 		return types.MsgStoreCode{}, fmt.Errorf("invalid input file. Use wasm binary or gzip")
 	}
 
-	var perm *types.AccessConfig
-	onlyAddrStr, err := flags.GetString(flagInstantiateByAddress)
+	perm, err := parseAccessConfigFlags(flags)
 	if err != nil {
-		return types.MsgStoreCode{}, fmt.Errorf("instantiate by address: %s", err)
-	}
-	if onlyAddrStr != "" {
-		allowedAddr, err := sdk.AccAddressFromBech32(onlyAddrStr)
-		if err != nil {
-			return types.MsgStoreCode{}, sdkerrors.Wrap(err, flagInstantiateByAddress)
-		}
-		x := types.AccessTypeOnlyAddress.With(allowedAddr)
-		perm = &x
-	} else {
-		everybodyStr, err := flags.GetString(flagInstantiateByEverybody)
-		if err != nil {
-			return types.MsgStoreCode{}, fmt.Errorf("instantiate by everybody: %s", err)
-		}
-		if everybodyStr != "" {
-			ok, err := strconv.ParseBool(everybodyStr)
-			if err != nil {
-				return types.MsgStoreCode{}, fmt.Errorf("boolean value expected for instantiate by everybody: %s", err)
-			}
-			if ok {
-				perm = &types.AllowEverybody
-			}
-		}
+		return types.MsgStoreCode{}, err
 	}
 
 	// build and sign the transaction, then broadcast to Tendermint
@@ -176,24 +235,9 @@ func parseInstantiateArgs(rawCodeID, initMsg string, sender sdk.AccAddress, flag
 		return types.MsgInstantiateContract{}, err
 	}
 
-	amountStr, err := flags.GetString(flagAmount)
-	if err != nil {
-		return types.MsgInstantiateContract{}, fmt.Errorf("amount: %s", err)
-	}
-	amount, err := sdk.ParseCoinsNormalized(amountStr)
-	if err != nil {
-		return types.MsgInstantiateContract{}, fmt.Errorf("amount: %s", err)
-	}
-	label, err := flags.GetString(flagLabel)
-	if err != nil {
-		return types.MsgInstantiateContract{}, fmt.Errorf("label: %s", err)
-	}
-	if label == "" {
-		return types.MsgInstantiateContract{}, errors.New("label is required on all contracts")
-	}
-	adminStr, err := flags.GetString(flagAdmin)
+	label, adminStr, amount, err := parseInstantiateFlags(flags)
 	if err != nil {
-		return types.MsgInstantiateContract{}, fmt.Errorf("admin: %s", err)
+		return types.MsgInstantiateContract{}, err
 	}
 
 	// build and sign the transaction, then broadcast to Tendermint
@@ -208,6 +252,147 @@ func parseInstantiateArgs(rawCodeID, initMsg string, sender sdk.AccAddress, flag
 	return msg, nil
 }
 
+func parseInstantiateFlags(flags *flag.FlagSet) (label string, admin string, amount sdk.Coins, err error) {
+	amountStr, err := flags.GetString(flagAmount)
+	if err != nil {
+		return "", "", nil, fmt.Errorf("amount: %s", err)
+	}
+	amount, err = sdk.ParseCoinsNormalized(amountStr)
+	if err != nil {
+		return "", "", nil, fmt.Errorf("amount: %s", err)
+	}
+	label, err = flags.GetString(flagLabel)
+	if err != nil {
+		return "", "", nil, fmt.Errorf("label: %s", err)
+	}
+	if label == "" {
+		return "", "", nil, errors.New("label is required on all contracts")
+	}
+	admin, err = flags.GetString(flagAdmin)
+	if err != nil {
+		return "", "", nil, fmt.Errorf("admin: %s", err)
+	}
+	return label, admin, amount, nil
+}
+
+// StoreAndInstantiateContractCmd will upload code and instantiate a contract from it in a single step.
+func StoreAndInstantiateContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "store-instantiate [wasm file] [json_encoded_init_args] --label [text] --admin [address,optional] --amount [coins,optional] --source [source,optional] --builder [builder,optional]",
+		Short: "Upload a wasm binary and instantiate a contract from it",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			msg, err := parseStoreAndInstantiateArgs(args[0], args[1], clientCtx.GetFromAddress(), cmd.Flags())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(flagSource, "", "A valid URI reference to the contract's source code, optional")
+	cmd.Flags().String(flagBuilder, "", "A valid docker tag for the build system, optional")
+	cmd.Flags().String(flagInstantiateByEverybody, "", "Everybody can instantiate a contract from the code, optional")
+	cmd.Flags().String(flagInstantiateByAddress, "", "Only this address can instantiate a contract instance from the code, optional")
+	cmd.Flags().StringSlice(flagInstantiateByAnyOfAddress, []string{}, "Any of the addresses can instantiate a contract instance from the code, optional")
+	cmd.Flags().String(flagInstantiateByContract, "", "Only this contract can instantiate a contract instance from the code, optional")
+	cmd.Flags().String(flagAmount, "", "Coins to send to the contract during instantiation")
+	cmd.Flags().String(flagLabel, "", "A human-readable name for this contract in lists")
+	cmd.Flags().String(flagAdmin, "", "Address of an admin")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func parseStoreAndInstantiateArgs(file, initMsg string, sender sdk.AccAddress, flags *flag.FlagSet) (types.MsgStoreAndInstantiateContract, error) {
+	storeMsg, err := parseStoreCodeArgs(file, sender, flags)
+	if err != nil {
+		return types.MsgStoreAndInstantiateContract{}, err
+	}
+	label, adminStr, amount, err := parseInstantiateFlags(flags)
+	if err != nil {
+		return types.MsgStoreAndInstantiateContract{}, err
+	}
+	return types.MsgStoreAndInstantiateContract{
+		Sender:                sender.String(),
+		WASMByteCode:          storeMsg.WASMByteCode,
+		Source:                storeMsg.Source,
+		Builder:               storeMsg.Builder,
+		InstantiatePermission: storeMsg.InstantiatePermission,
+		Admin:                 adminStr,
+		Label:                 label,
+		InitMsg:               []byte(initMsg),
+		Funds:                 amount,
+	}, nil
+}
+
+// InstantiateContract2Cmd will instantiate a contract from previously uploaded code with a predictable address.
+func InstantiateContract2Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "instantiate2 [code_id_int64] [json_encoded_init_args] [salt] --label [text] --admin [address,optional] --amount [coins,optional] --hex [bool,optional]",
+		Short: "Instantiate a wasm contract with predictable address",
+		Long: `Creates a new instance of an uploaded wasm code with the given 'constructor' message.
+The contract address is derived from the code checksum, the sender and the salt so that it can be computed
+before the transaction is executed. The salt is used as plain text unless the --hex flag is set.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			salt, err := decodeSalt(args[2], cmd.Flags())
+			if err != nil {
+				return err
+			}
+			data, err := parseInstantiateArgs(args[0], args[1], clientCtx.GetFromAddress(), cmd.Flags())
+			if err != nil {
+				return err
+			}
+			msg := types.MsgInstantiateContract2{
+				Sender:  data.Sender,
+				Admin:   data.Admin,
+				CodeID:  data.CodeID,
+				Label:   data.Label,
+				InitMsg: data.InitMsg,
+				Funds:   data.Funds,
+				Salt:    salt,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(flagAmount, "", "Coins to send to the contract during instantiation")
+	cmd.Flags().String(flagLabel, "", "A human-readable name for this contract in lists")
+	cmd.Flags().String(flagAdmin, "", "Address of an admin")
+	cmd.Flags().Bool(flagHexSalt, false, "Salt is hex encoded")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func decodeSalt(rawSalt string, flags *flag.FlagSet) ([]byte, error) {
+	isHex, err := flags.GetBool(flagHexSalt)
+	if err != nil {
+		return nil, fmt.Errorf("hex: %s", err)
+	}
+	if !isHex {
+		return []byte(rawSalt), nil
+	}
+	salt, err := hex.DecodeString(rawSalt)
+	if err != nil {
+		return nil, fmt.Errorf("salt: %s", err)
+	}
+	return salt, nil
+}
+
 // ExecuteContractCmd will instantiate a contract from previously uploaded code.
 func ExecuteContractCmd() *cobra.Command {
 	cmd := &cobra.Command{
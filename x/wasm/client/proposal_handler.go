@@ -13,4 +13,12 @@ var ProposalHandlers = []govclient.ProposalHandler{
 	govclient.NewProposalHandler(cli.ProposalMigrateContractCmd, rest.MigrateProposalHandler),
 	govclient.NewProposalHandler(cli.ProposalUpdateContractAdminCmd, rest.UpdateContractAdminProposalHandler),
 	govclient.NewProposalHandler(cli.ProposalClearContractAdminCmd, rest.ClearContractAdminProposalHandler),
+	govclient.NewProposalHandler(cli.ProposalDeleteCodesCmd, rest.DeleteCodesProposalHandler),
+	govclient.NewProposalHandler(cli.ProposalPauseContractCmd, rest.PauseContractProposalHandler),
+	govclient.NewProposalHandler(cli.ProposalUnpauseContractCmd, rest.UnpauseContractProposalHandler),
+	govclient.NewProposalHandler(cli.ProposalExecuteContractCmd, rest.ExecuteProposalHandler),
+	govclient.NewProposalHandler(cli.ProposalSudoContractCmd, rest.SudoProposalHandler),
+	govclient.NewProposalHandler(cli.ProposalStoreAndInstantiateContractCmd, rest.StoreAndInstantiateContractProposalHandler),
+	govclient.NewProposalHandler(cli.ProposalUpdateInstantiateConfigCmd, rest.UpdateInstantiateConfigProposalHandler),
+	govclient.NewProposalHandler(cli.ProposalUpdateMigrationAllowlistCmd, rest.UpdateMigrationAllowlistProposalHandler),
 }
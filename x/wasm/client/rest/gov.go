@@ -251,6 +251,368 @@ func ClearContractAdminProposalHandler(cliCtx client.Context) govrest.ProposalRE
 	}
 }
 
+type DeleteCodesJsonReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+
+	Proposer string    `json:"proposer" yaml:"proposer"`
+	Deposit  sdk.Coins `json:"deposit" yaml:"deposit"`
+
+	CodeIDs []uint64 `json:"code_ids" yaml:"code_ids"`
+}
+
+func (s DeleteCodesJsonReq) Content() govtypes.Content {
+	return &types.DeleteCodesProposal{
+		Title:       s.Title,
+		Description: s.Description,
+		CodeIDs:     s.CodeIDs,
+	}
+}
+func (s DeleteCodesJsonReq) GetProposer() string {
+	return s.Proposer
+}
+func (s DeleteCodesJsonReq) GetDeposit() sdk.Coins {
+	return s.Deposit
+}
+func (s DeleteCodesJsonReq) GetBaseReq() rest.BaseReq {
+	return s.BaseReq
+}
+func DeleteCodesProposalHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "wasm_delete_codes",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req DeleteCodesJsonReq
+			if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+				return
+			}
+			toStdTxResponse(cliCtx, w, req)
+		},
+	}
+}
+
+type PauseContractJsonReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+
+	Proposer string    `json:"proposer" yaml:"proposer"`
+	Deposit  sdk.Coins `json:"deposit" yaml:"deposit"`
+
+	Contract string `json:"contract" yaml:"contract"`
+}
+
+func (s PauseContractJsonReq) Content() govtypes.Content {
+	return &types.PauseContractProposal{
+		Title:       s.Title,
+		Description: s.Description,
+		Contract:    s.Contract,
+	}
+}
+func (s PauseContractJsonReq) GetProposer() string {
+	return s.Proposer
+}
+func (s PauseContractJsonReq) GetDeposit() sdk.Coins {
+	return s.Deposit
+}
+func (s PauseContractJsonReq) GetBaseReq() rest.BaseReq {
+	return s.BaseReq
+}
+func PauseContractProposalHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "wasm_pause_contract",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req PauseContractJsonReq
+			if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+				return
+			}
+			toStdTxResponse(cliCtx, w, req)
+		},
+	}
+}
+
+type UnpauseContractJsonReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+
+	Proposer string    `json:"proposer" yaml:"proposer"`
+	Deposit  sdk.Coins `json:"deposit" yaml:"deposit"`
+
+	Contract string `json:"contract" yaml:"contract"`
+}
+
+func (s UnpauseContractJsonReq) Content() govtypes.Content {
+	return &types.UnpauseContractProposal{
+		Title:       s.Title,
+		Description: s.Description,
+		Contract:    s.Contract,
+	}
+}
+func (s UnpauseContractJsonReq) GetProposer() string {
+	return s.Proposer
+}
+func (s UnpauseContractJsonReq) GetDeposit() sdk.Coins {
+	return s.Deposit
+}
+func (s UnpauseContractJsonReq) GetBaseReq() rest.BaseReq {
+	return s.BaseReq
+}
+func UnpauseContractProposalHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "wasm_unpause_contract",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req UnpauseContractJsonReq
+			if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+				return
+			}
+			toStdTxResponse(cliCtx, w, req)
+		},
+	}
+}
+
+type ExecuteProposalJsonReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+
+	Proposer string    `json:"proposer" yaml:"proposer"`
+	Deposit  sdk.Coins `json:"deposit" yaml:"deposit"`
+
+	Contract string          `json:"contract" yaml:"contract"`
+	Msg      json.RawMessage `json:"msg" yaml:"msg"`
+	// RunAs is the role that is passed to the contract's environment
+	RunAs             string    `json:"run_as" yaml:"run_as"`
+	Funds             sdk.Coins `json:"funds" yaml:"funds"`
+	FromCommunityPool bool      `json:"from_community_pool" yaml:"from_community_pool"`
+}
+
+func (s ExecuteProposalJsonReq) Content() govtypes.Content {
+	return &types.ExecuteContractProposal{
+		Title:             s.Title,
+		Description:       s.Description,
+		Contract:          s.Contract,
+		Msg:               s.Msg,
+		RunAs:             s.RunAs,
+		Funds:             s.Funds,
+		FromCommunityPool: s.FromCommunityPool,
+	}
+}
+func (s ExecuteProposalJsonReq) GetProposer() string {
+	return s.Proposer
+}
+func (s ExecuteProposalJsonReq) GetDeposit() sdk.Coins {
+	return s.Deposit
+}
+func (s ExecuteProposalJsonReq) GetBaseReq() rest.BaseReq {
+	return s.BaseReq
+}
+func ExecuteProposalHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "wasm_execute",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req ExecuteProposalJsonReq
+			if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+				return
+			}
+			toStdTxResponse(cliCtx, w, req)
+		},
+	}
+}
+
+type SudoProposalJsonReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+
+	Proposer string    `json:"proposer" yaml:"proposer"`
+	Deposit  sdk.Coins `json:"deposit" yaml:"deposit"`
+
+	Contract string          `json:"contract" yaml:"contract"`
+	Msg      json.RawMessage `json:"msg" yaml:"msg"`
+}
+
+func (s SudoProposalJsonReq) Content() govtypes.Content {
+	return &types.SudoContractProposal{
+		Title:       s.Title,
+		Description: s.Description,
+		Contract:    s.Contract,
+		Msg:         s.Msg,
+	}
+}
+func (s SudoProposalJsonReq) GetProposer() string {
+	return s.Proposer
+}
+func (s SudoProposalJsonReq) GetDeposit() sdk.Coins {
+	return s.Deposit
+}
+func (s SudoProposalJsonReq) GetBaseReq() rest.BaseReq {
+	return s.BaseReq
+}
+func SudoProposalHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "wasm_sudo",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req SudoProposalJsonReq
+			if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+				return
+			}
+			toStdTxResponse(cliCtx, w, req)
+		},
+	}
+}
+
+type StoreAndInstantiateContractProposalJsonReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string    `json:"title" yaml:"title"`
+	Description string    `json:"description" yaml:"description"`
+	Proposer    string    `json:"proposer" yaml:"proposer"`
+	Deposit     sdk.Coins `json:"deposit" yaml:"deposit"`
+
+	RunAs string `json:"run_as" yaml:"run_as"`
+	// WASMByteCode can be raw or gzip compressed
+	WASMByteCode []byte `json:"wasm_byte_code" yaml:"wasm_byte_code"`
+	// Source is a valid absolute HTTPS URI to the contract's source code, optional
+	Source string `json:"source" yaml:"source"`
+	// Builder is a valid docker image name with tag, optional
+	Builder string `json:"builder" yaml:"builder"`
+	// InstantiatePermission to apply on contract creation, optional
+	InstantiatePermission *types.AccessConfig `json:"instantiate_permission" yaml:"instantiate_permission"`
+
+	Admin string          `json:"admin,omitempty" yaml:"admin"`
+	Label string          `json:"label" yaml:"label"`
+	Msg   json.RawMessage `json:"msg" yaml:"msg"`
+	Funds sdk.Coins       `json:"funds" yaml:"funds"`
+}
+
+func (s StoreAndInstantiateContractProposalJsonReq) Content() govtypes.Content {
+	return &types.StoreAndInstantiateContractProposal{
+		Title:                 s.Title,
+		Description:           s.Description,
+		RunAs:                 s.RunAs,
+		WASMByteCode:          s.WASMByteCode,
+		Source:                s.Source,
+		Builder:               s.Builder,
+		InstantiatePermission: s.InstantiatePermission,
+		Admin:                 s.Admin,
+		Label:                 s.Label,
+		InitMsg:               s.Msg,
+		Funds:                 s.Funds,
+	}
+}
+func (s StoreAndInstantiateContractProposalJsonReq) GetProposer() string {
+	return s.Proposer
+}
+func (s StoreAndInstantiateContractProposalJsonReq) GetDeposit() sdk.Coins {
+	return s.Deposit
+}
+func (s StoreAndInstantiateContractProposalJsonReq) GetBaseReq() rest.BaseReq {
+	return s.BaseReq
+}
+func StoreAndInstantiateContractProposalHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "wasm_store_and_instantiate",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req StoreAndInstantiateContractProposalJsonReq
+			if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+				return
+			}
+			toStdTxResponse(cliCtx, w, req)
+		},
+	}
+}
+
+type UpdateInstantiateConfigProposalJsonReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+
+	Proposer string    `json:"proposer" yaml:"proposer"`
+	Deposit  sdk.Coins `json:"deposit" yaml:"deposit"`
+
+	AccessConfigUpdates []types.AccessConfigUpdate `json:"access_config_updates" yaml:"access_config_updates"`
+}
+
+func (s UpdateInstantiateConfigProposalJsonReq) Content() govtypes.Content {
+	return &types.UpdateInstantiateConfigProposal{
+		Title:               s.Title,
+		Description:         s.Description,
+		AccessConfigUpdates: s.AccessConfigUpdates,
+	}
+}
+func (s UpdateInstantiateConfigProposalJsonReq) GetProposer() string {
+	return s.Proposer
+}
+func (s UpdateInstantiateConfigProposalJsonReq) GetDeposit() sdk.Coins {
+	return s.Deposit
+}
+func (s UpdateInstantiateConfigProposalJsonReq) GetBaseReq() rest.BaseReq {
+	return s.BaseReq
+}
+func UpdateInstantiateConfigProposalHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "wasm_update_instantiate_config",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req UpdateInstantiateConfigProposalJsonReq
+			if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+				return
+			}
+			toStdTxResponse(cliCtx, w, req)
+		},
+	}
+}
+
+type UpdateMigrationAllowlistProposalJsonReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+
+	Proposer string    `json:"proposer" yaml:"proposer"`
+	Deposit  sdk.Coins `json:"deposit" yaml:"deposit"`
+
+	Contract string `json:"contract" yaml:"contract"`
+	// Allowlist to set, the allowlist is removed when not set
+	Allowlist *types.MigrationAllowlist `json:"allowlist" yaml:"allowlist"`
+}
+
+func (s UpdateMigrationAllowlistProposalJsonReq) Content() govtypes.Content {
+	return &types.UpdateMigrationAllowlistProposal{
+		Title:       s.Title,
+		Description: s.Description,
+		Contract:    s.Contract,
+		Allowlist:   s.Allowlist,
+	}
+}
+func (s UpdateMigrationAllowlistProposalJsonReq) GetProposer() string {
+	return s.Proposer
+}
+func (s UpdateMigrationAllowlistProposalJsonReq) GetDeposit() sdk.Coins {
+	return s.Deposit
+}
+func (s UpdateMigrationAllowlistProposalJsonReq) GetBaseReq() rest.BaseReq {
+	return s.BaseReq
+}
+func UpdateMigrationAllowlistProposalHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "wasm_update_migration_allowlist",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req UpdateMigrationAllowlistProposalJsonReq
+			if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+				return
+			}
+			toStdTxResponse(cliCtx, w, req)
+		},
+	}
+}
+
 type wasmProposalData interface {
 	Content() govtypes.Content
 	GetProposer() string
@@ -15,6 +15,7 @@ import (
 
 func registerTxRoutes(cliCtx client.Context, r *mux.Router) {
 	r.HandleFunc("/wasm/code", storeCodeHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/wasm/code-and-contract", storeAndInstantiateContractHandlerFn(cliCtx)).Methods("POST")
 	r.HandleFunc("/wasm/code/{codeId}", instantiateContractHandlerFn(cliCtx)).Methods("POST")
 	r.HandleFunc("/wasm/contract/{contractAddr}", executeContractHandlerFn(cliCtx)).Methods("POST")
 }
@@ -32,6 +33,17 @@ type instantiateContractReq struct {
 	InitMsg []byte       `json:"init_msg" yaml:"init_msg"`
 }
 
+type storeAndInstantiateContractReq struct {
+	BaseReq   rest.BaseReq `json:"base_req" yaml:"base_req"`
+	WasmBytes []byte       `json:"wasm_bytes"`
+	// InstantiatePermission to apply on contract creation, optional
+	InstantiatePermission *types.AccessConfig `json:"instantiate_permission,omitempty" yaml:"instantiate_permission"`
+	Label                 string              `json:"label" yaml:"label"`
+	Deposit               sdk.Coins           `json:"deposit" yaml:"deposit"`
+	Admin                 string              `json:"admin,omitempty" yaml:"admin"`
+	InitMsg               []byte              `json:"init_msg" yaml:"init_msg"`
+}
+
 type executeContractReq struct {
 	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
 	ExecMsg []byte       `json:"exec_msg" yaml:"exec_msg"`
@@ -80,6 +92,52 @@ func storeCodeHandlerFn(cliCtx client.Context) http.HandlerFunc {
 	}
 }
 
+func storeAndInstantiateContractHandlerFn(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req storeAndInstantiateContractReq
+		if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		var err error
+		wasm := req.WasmBytes
+
+		// gzip the wasm file
+		if wasmUtils.IsWasm(wasm) {
+			wasm, err = wasmUtils.GzipIt(wasm)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		} else if !wasmUtils.IsGzip(wasm) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "Invalid input file, use wasm binary or zip")
+			return
+		}
+
+		msg := types.MsgStoreAndInstantiateContract{
+			Sender:                req.BaseReq.From,
+			WASMByteCode:          wasm,
+			InstantiatePermission: req.InstantiatePermission,
+			Label:                 req.Label,
+			Funds:                 req.Deposit,
+			InitMsg:               req.InitMsg,
+			Admin:                 req.Admin,
+		}
+
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, &msg)
+	}
+}
+
 func instantiateContractHandlerFn(cliCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var req instantiateContractReq
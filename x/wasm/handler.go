@@ -27,6 +27,10 @@ func NewHandler(k *Keeper) sdk.Handler {
 			res, err = msgServer.StoreCode(sdk.WrapSDKContext(ctx), msg)
 		case *MsgInstantiateContract:
 			res, err = msgServer.InstantiateContract(sdk.WrapSDKContext(ctx), msg)
+		case *MsgInstantiateContract2:
+			res, err = msgServer.InstantiateContract2(sdk.WrapSDKContext(ctx), msg)
+		case *MsgStoreAndInstantiateContract:
+			res, err = msgServer.StoreAndInstantiateContract(sdk.WrapSDKContext(ctx), msg)
 		case *MsgExecuteContract:
 			res, err = msgServer.ExecuteContract(sdk.WrapSDKContext(ctx), msg)
 		case *MsgMigrateContract:
@@ -35,6 +39,22 @@ func NewHandler(k *Keeper) sdk.Handler {
 			res, err = msgServer.UpdateAdmin(sdk.WrapSDKContext(ctx), msg)
 		case *MsgClearAdmin:
 			res, err = msgServer.ClearAdmin(sdk.WrapSDKContext(ctx), msg)
+		case *MsgProposeAdmin:
+			res, err = msgServer.ProposeAdmin(sdk.WrapSDKContext(ctx), msg)
+		case *MsgAcceptAdmin:
+			res, err = msgServer.AcceptAdmin(sdk.WrapSDKContext(ctx), msg)
+		case *MsgCancelProposedAdmin:
+			res, err = msgServer.CancelProposedAdmin(sdk.WrapSDKContext(ctx), msg)
+		case *MsgUpdateMigrationAllowlist:
+			res, err = msgServer.UpdateMigrationAllowlist(sdk.WrapSDKContext(ctx), msg)
+		case *MsgDeleteCode:
+			res, err = msgServer.DeleteCode(sdk.WrapSDKContext(ctx), msg)
+		case *MsgUpdateInstantiateConfig:
+			res, err = msgServer.UpdateInstantiateConfig(sdk.WrapSDKContext(ctx), msg)
+		case *MsgScheduleCallback:
+			res, err = msgServer.ScheduleCallback(sdk.WrapSDKContext(ctx), msg)
+		case *MsgCancelCallback:
+			res, err = msgServer.CancelCallback(sdk.WrapSDKContext(ctx), msg)
 		default:
 			errMsg := fmt.Sprintf("unrecognized wasm message type: %T", msg)
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
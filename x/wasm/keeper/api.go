@@ -2,28 +2,42 @@ package keeper
 
 import (
 	"fmt"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
 	wasmvm "github.com/CosmWasm/wasmvm"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 var (
-	CostHumanize  = 5 * GasMultiplier
-	CostCanonical = 4 * GasMultiplier
+	// CostHumanize is the default wasmvm gas charged for humanizing an address
+	CostHumanize = types.DefaultHumanizeCost * GasMultiplier
+	// CostCanonical is the default wasmvm gas charged for canonicalizing an address
+	CostCanonical = types.DefaultCanonicalizeCost * GasMultiplier
 )
 
-func humanAddress(canon []byte) (string, uint64, error) {
-	if len(canon) != sdk.AddrLen {
-		return "", CostHumanize, fmt.Errorf("Expected %d byte address", sdk.AddrLen)
+// cosmwasmAPI returns the address conversion API with the costs from the params. The costs are returned in
+// wasmvm gas.
+func (k Keeper) cosmwasmAPI(ctx sdk.Context) wasmvm.GoAPI {
+	multiplier := k.GetGasMultiplier(ctx)
+	humanizeCost := k.GetHumanizeCost(ctx) * multiplier
+	canonicalizeCost := k.GetCanonicalizeCost(ctx) * multiplier
+	return wasmvm.GoAPI{
+		HumanAddress: func(canon []byte) (string, uint64, error) {
+			return humanAddress(canon, humanizeCost)
+		},
+		CanonicalAddress: func(human string) ([]byte, uint64, error) {
+			return canonicalAddress(human, canonicalizeCost)
+		},
 	}
-	return sdk.AccAddress(canon).String(), CostHumanize, nil
 }
 
-func canonicalAddress(human string) ([]byte, uint64, error) {
-	bz, err := sdk.AccAddressFromBech32(human)
-	return bz, CostCanonical, err
+func humanAddress(canon []byte, cost uint64) (string, uint64, error) {
+	if len(canon) != sdk.AddrLen {
+		return "", cost, fmt.Errorf("Expected %d byte address", sdk.AddrLen)
+	}
+	return sdk.AccAddress(canon).String(), cost, nil
 }
 
-var cosmwasmAPI = wasmvm.GoAPI{
-	HumanAddress:     humanAddress,
-	CanonicalAddress: canonicalAddress,
+func canonicalAddress(human string, cost uint64) ([]byte, uint64, error) {
+	bz, err := sdk.AccAddressFromBech32(human)
+	return bz, cost, err
 }
@@ -5,38 +5,107 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// AuthorizationPolicy is consulted by the keeper on all privileged operations. A custom policy can be set
+// with the keeper Option `WithAuthorizationPolicy`. Governance proposals always use the `GovAuthorizationPolicy`.
 type AuthorizationPolicy interface {
-	CanCreateCode(c types.AccessConfig, creator sdk.AccAddress) bool
-	CanInstantiateContract(c types.AccessConfig, actor sdk.AccAddress) bool
-	CanModifyContract(admin, actor sdk.AccAddress) bool
+	CanCreateCode(ctx sdk.Context, c types.AccessConfig, creator sdk.AccAddress) bool
+	CanInstantiateContract(ctx sdk.Context, c types.AccessConfig, actor sdk.AccAddress) bool
+	CanModifyContract(ctx sdk.Context, admin, actor sdk.AccAddress) bool
+	CanExecuteContract(ctx sdk.Context, contractAddress, actor sdk.AccAddress) bool
+	// CanSudoContract is consulted for calls from other modules that have no actor, like the scheduled callbacks
+	CanSudoContract(ctx sdk.Context, contractAddress sdk.AccAddress) bool
+	// CanPinCode is consulted to pin and to unpin a code. The actor is nil for governance and the genesis import.
+	CanPinCode(ctx sdk.Context, codeID uint64, actor sdk.AccAddress) bool
+	CanDeleteCode(ctx sdk.Context, creator, actor sdk.AccAddress) bool
+	CanUpdateInstantiateConfig(ctx sdk.Context, creator, actor sdk.AccAddress) bool
+	CanMigratePausedContract(ctx sdk.Context) bool
+	// CanIgnoreMigrationAllowlist is consulted to migrate to a code that is not in the allowlist of the contract
+	// and to widen or remove the allowlist
+	CanIgnoreMigrationAllowlist(ctx sdk.Context) bool
 }
 
 type DefaultAuthorizationPolicy struct {
 }
 
-func (p DefaultAuthorizationPolicy) CanCreateCode(config types.AccessConfig, actor sdk.AccAddress) bool {
+func (p DefaultAuthorizationPolicy) CanCreateCode(_ sdk.Context, config types.AccessConfig, actor sdk.AccAddress) bool {
 	return config.Allowed(actor)
 }
 
-func (p DefaultAuthorizationPolicy) CanInstantiateContract(config types.AccessConfig, actor sdk.AccAddress) bool {
+func (p DefaultAuthorizationPolicy) CanInstantiateContract(_ sdk.Context, config types.AccessConfig, actor sdk.AccAddress) bool {
 	return config.Allowed(actor)
 }
 
-func (p DefaultAuthorizationPolicy) CanModifyContract(admin, actor sdk.AccAddress) bool {
+func (p DefaultAuthorizationPolicy) CanModifyContract(_ sdk.Context, admin, actor sdk.AccAddress) bool {
 	return admin != nil && admin.Equals(actor)
 }
 
+func (p DefaultAuthorizationPolicy) CanExecuteContract(sdk.Context, sdk.AccAddress, sdk.AccAddress) bool {
+	return true
+}
+
+func (p DefaultAuthorizationPolicy) CanSudoContract(sdk.Context, sdk.AccAddress) bool {
+	return true
+}
+
+func (p DefaultAuthorizationPolicy) CanPinCode(sdk.Context, uint64, sdk.AccAddress) bool {
+	return true
+}
+
+func (p DefaultAuthorizationPolicy) CanDeleteCode(_ sdk.Context, creator, actor sdk.AccAddress) bool {
+	return creator != nil && creator.Equals(actor)
+}
+
+func (p DefaultAuthorizationPolicy) CanUpdateInstantiateConfig(_ sdk.Context, creator, actor sdk.AccAddress) bool {
+	return creator != nil && creator.Equals(actor)
+}
+
+func (p DefaultAuthorizationPolicy) CanMigratePausedContract(sdk.Context) bool {
+	return false
+}
+
+func (p DefaultAuthorizationPolicy) CanIgnoreMigrationAllowlist(sdk.Context) bool {
+	return false
+}
+
 type GovAuthorizationPolicy struct {
 }
 
-func (p GovAuthorizationPolicy) CanCreateCode(types.AccessConfig, sdk.AccAddress) bool {
+func (p GovAuthorizationPolicy) CanCreateCode(sdk.Context, types.AccessConfig, sdk.AccAddress) bool {
+	return true
+}
+
+func (p GovAuthorizationPolicy) CanInstantiateContract(sdk.Context, types.AccessConfig, sdk.AccAddress) bool {
+	return true
+}
+
+func (p GovAuthorizationPolicy) CanModifyContract(sdk.Context, sdk.AccAddress, sdk.AccAddress) bool {
+	return true
+}
+
+func (p GovAuthorizationPolicy) CanExecuteContract(sdk.Context, sdk.AccAddress, sdk.AccAddress) bool {
+	return true
+}
+
+func (p GovAuthorizationPolicy) CanSudoContract(sdk.Context, sdk.AccAddress) bool {
+	return true
+}
+
+func (p GovAuthorizationPolicy) CanPinCode(sdk.Context, uint64, sdk.AccAddress) bool {
+	return true
+}
+
+func (p GovAuthorizationPolicy) CanDeleteCode(sdk.Context, sdk.AccAddress, sdk.AccAddress) bool {
+	return true
+}
+
+func (p GovAuthorizationPolicy) CanUpdateInstantiateConfig(sdk.Context, sdk.AccAddress, sdk.AccAddress) bool {
 	return true
 }
 
-func (p GovAuthorizationPolicy) CanInstantiateContract(types.AccessConfig, sdk.AccAddress) bool {
+func (p GovAuthorizationPolicy) CanMigratePausedContract(sdk.Context) bool {
 	return true
 }
 
-func (p GovAuthorizationPolicy) CanModifyContract(sdk.AccAddress, sdk.AccAddress) bool {
+func (p GovAuthorizationPolicy) CanIgnoreMigrationAllowlist(sdk.Context) bool {
 	return true
 }
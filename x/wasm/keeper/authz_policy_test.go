@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// registryAuthorizationPolicy grants contract calls to registered accounts only
+type registryAuthorizationPolicy struct {
+	DefaultAuthorizationPolicy
+	registered map[string]bool
+}
+
+func (p registryAuthorizationPolicy) CanExecuteContract(_ sdk.Context, _, actor sdk.AccAddress) bool {
+	return p.registered[actor.String()]
+}
+
+func (p registryAuthorizationPolicy) CanSudoContract(sdk.Context, sdk.AccAddress) bool {
+	return false
+}
+
+func (p registryAuthorizationPolicy) CanPinCode(_ sdk.Context, _ uint64, actor sdk.AccAddress) bool {
+	return p.registered[actor.String()]
+}
+
+func TestCustomAuthorizationPolicy(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures)
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	k := *keepers.WasmKeeper
+	policy := registryAuthorizationPolicy{registered: map[string]bool{example.VerifierAddr.String(): true}}
+	WithAuthorizationPolicy(policy).apply(&k)
+	stranger := RandomAccountAddress(t)
+
+	// execute is granted to registered accounts only
+	_, err := k.Execute(ctx, example.Contract, stranger, []byte(`{"release":{}}`), nil)
+	assert.True(t, sdkerrors.ErrUnauthorized.Is(err), "got %+v", err)
+	_, err = k.Execute(ctx, example.Contract, example.VerifierAddr, []byte(`{"release":{}}`), nil)
+	require.NoError(t, err)
+
+	// sudo is rejected
+	_, err = k.Sudo(ctx, example.Contract, []byte(`{}`))
+	assert.True(t, sdkerrors.ErrUnauthorized.Is(err), "got %+v", err)
+
+	// pinning is granted to registered accounts only
+	err = k.PinCodeWithActor(ctx, example.CodeID, stranger)
+	assert.True(t, sdkerrors.ErrUnauthorized.Is(err), "got %+v", err)
+	assert.False(t, k.IsPinnedCode(ctx, example.CodeID))
+	require.NoError(t, k.PinCodeWithActor(ctx, example.CodeID, example.VerifierAddr))
+	assert.True(t, k.IsPinnedCode(ctx, example.CodeID))
+	err = k.UnpinCodeWithActor(ctx, example.CodeID, stranger)
+	assert.True(t, sdkerrors.ErrUnauthorized.Is(err), "got %+v", err)
+	assert.True(t, k.IsPinnedCode(ctx, example.CodeID))
+
+	// while governance is not affected by the policy
+	require.NoError(t, k.unpinCode(ctx, example.CodeID, nil, GovAuthorizationPolicy{}))
+	assert.False(t, k.IsPinnedCode(ctx, example.CodeID))
+}
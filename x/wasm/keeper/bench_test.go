@@ -1,7 +1,9 @@
 package keeper
 
 import (
+	"fmt"
 	"github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	"github.com/syndtr/goleveldb/leveldb/opt"
 	dbm "github.com/tendermint/tm-db"
@@ -53,3 +55,54 @@ func BenchmarkExecution(b *testing.B) {
 		})
 	}
 }
+
+// BenchmarkContractStorage measures the contract storage access with the default storage gas params. The reported
+// gas/op and ns/gas metrics are used to calibrate the costs per operation and size.
+func BenchmarkContractStorage(b *testing.B) {
+	ops := map[string]func(store sdk.KVStore, key, value []byte){
+		"read": func(store sdk.KVStore, key, value []byte) {
+			store.Get(key)
+		},
+		"write": func(store sdk.KVStore, key, value []byte) {
+			store.Set(key, value)
+		},
+		"delete": func(store sdk.KVStore, key, value []byte) {
+			store.Delete(key)
+		},
+		"iterate 10": func(store sdk.KVStore, key, value []byte) {
+			iter := store.Iterator(nil, nil)
+			for i := 0; i < 10 && iter.Valid(); i++ {
+				iter.Next()
+			}
+			iter.Close()
+		},
+	}
+	for _, size := range []int{32, 1024, 16 * 1024} {
+		for opName, op := range ops {
+			b.Run(fmt.Sprintf("%s %d bytes, level db", opName, size), func(b *testing.B) {
+				levelDB, err := dbm.NewGoLevelDBWithOpts("testing", b.TempDir(), &opt.Options{BlockCacher: opt.NoCacher})
+				require.NoError(b, err)
+				ctx, keepers := createTestInput(b, false, SupportedFeatures, types.WasmConfig{MemoryCacheSize: 0}, levelDB)
+				contractAddr := RandomAccountAddress(b)
+				store := keepers.WasmKeeper.contractStore(ctx, contractAddr, 1)
+				value := make([]byte, size)
+				for i := 0; i < 100; i++ {
+					store.Set([]byte(fmt.Sprintf("key%03d", i)), value)
+				}
+				ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+				store = keepers.WasmKeeper.contractStore(ctx, contractAddr, 1)
+
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					op(store, []byte(fmt.Sprintf("key%03d", i%100)), value)
+				}
+				b.StopTimer()
+				gasPerOp := float64(ctx.GasMeter().GasConsumed()) / float64(b.N)
+				b.ReportMetric(gasPerOp, "gas/op")
+				if gasPerOp != 0 {
+					b.ReportMetric(float64(b.Elapsed().Nanoseconds())/float64(b.N)/gasPerOp, "ns/gas")
+				}
+			})
+		}
+	}
+}
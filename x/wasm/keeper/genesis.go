@@ -28,7 +28,8 @@ func InitGenesis(ctx sdk.Context, keeper *Keeper, data types.GenesisState, staki
 			maxCodeID = code.CodeID
 		}
 		if code.Pinned {
-			if err := keeper.PinCode(ctx, code.CodeID); err != nil {
+			// the pins of the exported state are restored independent of the authorization policy
+			if err := keeper.pinCode(ctx, code.CodeID, nil, GovAuthorizationPolicy{}); err != nil {
 				return nil, sdkerrors.Wrapf(err, "contract number %d", i)
 			}
 		}
@@ -44,9 +45,21 @@ func InitGenesis(ctx sdk.Context, keeper *Keeper, data types.GenesisState, staki
 		if err != nil {
 			return nil, sdkerrors.Wrapf(err, "contract number %d", i)
 		}
+		keeper.setStorageDeposit(ctx, contractAddr, contract.StorageDeposit)
 		maxContractID = i + 1 // not ideal but max(contractID) is not persisted otherwise
 	}
 
+	var maxCallbackID uint64
+	for i := range data.ScheduledCallbacks {
+		callback := data.ScheduledCallbacks[i]
+		if err := keeper.importScheduledCallback(ctx, &callback); err != nil {
+			return nil, sdkerrors.Wrapf(err, "scheduled callback number %d", i)
+		}
+		if callback.ID > maxCallbackID {
+			maxCallbackID = callback.ID
+		}
+	}
+
 	for i, seq := range data.Sequences {
 		err := keeper.importAutoIncrementID(ctx, seq.IDKey, seq.Value)
 		if err != nil {
@@ -61,6 +74,9 @@ func InitGenesis(ctx sdk.Context, keeper *Keeper, data types.GenesisState, staki
 	if keeper.peekAutoIncrementID(ctx, types.KeyLastInstanceID) <= uint64(maxContractID) {
 		return nil, sdkerrors.Wrapf(types.ErrInvalid, "seq %s must be greater %d ", string(types.KeyLastInstanceID), maxContractID)
 	}
+	if keeper.peekAutoIncrementID(ctx, types.KeyLastCallbackID) <= maxCallbackID {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "seq %s must be greater %d ", string(types.KeyLastCallbackID), maxCallbackID)
+	}
 
 	if len(data.GenMsgs) == 0 {
 		return nil, nil
@@ -110,16 +126,26 @@ func ExportGenesis(ctx sdk.Context, keeper *Keeper) *types.GenesisState {
 		}
 		// redact contract info
 		contract.Created = nil
+		var storageDeposit sdk.Coins
+		if d := keeper.GetStorageDeposit(ctx, addr); !d.IsZero() {
+			storageDeposit = d
+		}
 		genState.Contracts = append(genState.Contracts, types.Contract{
 			ContractAddress: addr.String(),
 			ContractInfo:    contract,
 			ContractState:   state,
+			StorageDeposit:  storageDeposit,
 		})
 
 		return false
 	})
 
-	for _, k := range [][]byte{types.KeyLastCodeID, types.KeyLastInstanceID} {
+	keeper.IterateScheduledCallbacks(ctx, func(callback types.ScheduledCallback) bool {
+		genState.ScheduledCallbacks = append(genState.ScheduledCallbacks, callback)
+		return false
+	})
+
+	for _, k := range [][]byte{types.KeyLastCodeID, types.KeyLastInstanceID, types.KeyLastCallbackID} {
 		genState.Sequences = append(genState.Sequences, types.Sequence{
 			IDKey: k,
 			Value: keeper.peekAutoIncrementID(ctx, k),
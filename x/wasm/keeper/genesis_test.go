@@ -60,6 +60,10 @@ func TestGenesisExportImport(t *testing.T) {
 		f.Fuzz(&pinned)
 		creatorAddr, err := sdk.AccAddressFromBech32(codeInfo.Creator)
 		require.NoError(t, err)
+		if codeInfo.InstantiateConfig.Permission == types.AccessTypeOnlyContract {
+			// the fuzzed address is not a contract
+			codeInfo.InstantiateConfig = types.AccessTypeAnyOfAddresses.With(creatorAddr)
+		}
 		codeID, err := srcKeeper.Create(srcCtx, creatorAddr, wasmCode, codeInfo.Source, codeInfo.Builder, &codeInfo.InstantiateConfig)
 		require.NoError(t, err)
 		if pinned {
@@ -71,6 +75,16 @@ func TestGenesisExportImport(t *testing.T) {
 		srcKeeper.storeContractInfo(srcCtx, contractAddr, &contract)
 		srcKeeper.appendToContractHistory(srcCtx, contractAddr, history...)
 		srcKeeper.importContractState(srcCtx, contractAddr, stateModels)
+		srcKeeper.setStorageDeposit(srcCtx, contractAddr, sdk.NewCoins(sdk.NewInt64Coin("denom", int64(i))))
+		srcKeeper.storeScheduledCallback(srcCtx, &types.ScheduledCallback{
+			ID:       srcKeeper.autoIncrementID(srcCtx, types.KeyLastCallbackID),
+			Contract: contractAddr.String(),
+			Creator:  creatorAddr.String(),
+			Msg:      []byte(`{}`),
+			Height:   uint64(i + 1),
+			GasLimit: 100_000,
+			Fee:      sdk.NewCoins(sdk.NewInt64Coin("denom", 1)),
+		})
 	}
 	var wasmParams types.Params
 	f.NilChance(0).Fuzz(&wasmParams)
@@ -286,6 +300,67 @@ func TestGenesisInit(t *testing.T) {
 			},
 			expSuccess: true,
 		},
+		"happy path: scheduled callback": {
+			src: types.GenesisState{
+				Codes: []types.Code{{
+					CodeID:    firstCodeID,
+					CodeInfo:  myCodeInfo,
+					CodeBytes: wasmCode,
+				}},
+				Contracts: []types.Contract{
+					{
+						ContractAddress: contractAddress(1, 1).String(),
+						ContractInfo:    types.ContractInfoFixture(func(c *wasmTypes.ContractInfo) { c.CodeID = 1 }, types.OnlyGenesisFields),
+					},
+				},
+				Sequences: []types.Sequence{
+					{IDKey: types.KeyLastCodeID, Value: 2},
+					{IDKey: types.KeyLastInstanceID, Value: 2},
+					{IDKey: types.KeyLastCallbackID, Value: 2},
+				},
+				Params: types.DefaultParams(),
+				ScheduledCallbacks: []types.ScheduledCallback{{
+					ID:       1,
+					Contract: contractAddress(1, 1).String(),
+					Creator:  contractAddress(1, 1).String(),
+					Msg:      []byte(`{}`),
+					Height:   1,
+					GasLimit: types.DefaultMaxScheduledCallbackGas,
+					Fee:      sdk.NewCoins(sdk.NewInt64Coin("denom", 1)),
+				}},
+			},
+			expSuccess: true,
+		},
+		"prevent scheduled callback with gas limit above max": {
+			src: types.GenesisState{
+				Codes: []types.Code{{
+					CodeID:    firstCodeID,
+					CodeInfo:  myCodeInfo,
+					CodeBytes: wasmCode,
+				}},
+				Contracts: []types.Contract{
+					{
+						ContractAddress: contractAddress(1, 1).String(),
+						ContractInfo:    types.ContractInfoFixture(func(c *wasmTypes.ContractInfo) { c.CodeID = 1 }, types.OnlyGenesisFields),
+					},
+				},
+				Sequences: []types.Sequence{
+					{IDKey: types.KeyLastCodeID, Value: 2},
+					{IDKey: types.KeyLastInstanceID, Value: 2},
+					{IDKey: types.KeyLastCallbackID, Value: 2},
+				},
+				Params: types.DefaultParams(),
+				ScheduledCallbacks: []types.ScheduledCallback{{
+					ID:       1,
+					Contract: contractAddress(1, 1).String(),
+					Creator:  contractAddress(1, 1).String(),
+					Msg:      []byte(`{}`),
+					Height:   1,
+					GasLimit: types.DefaultMaxScheduledCallbackGas + 1,
+					Fee:      sdk.NewCoins(sdk.NewInt64Coin("denom", 1)),
+				}},
+			},
+		},
 		"prevent contracts that points to non existing codeID": {
 			src: types.GenesisState{
 				Contracts: []types.Contract{
@@ -444,7 +519,18 @@ func TestImportContractWithCodeHistoryReset(t *testing.T) {
 			"permission": "Everybody"
 		},
 		"instantiate_default_permission": "Everybody",
-		"max_wasm_code_size": 500000
+		"max_wasm_code_size": 500000,
+		"gas_multiplier": "100",
+		"instance_cost": "40000",
+		"compile_cost": "2",
+		"humanize_cost": "5",
+		"canonicalize_cost": "4",
+		"contract_memory_limit": 32,
+		"max_scheduled_callback_gas": "10000000",
+		"storage_read_cost_per_byte": "3",
+		"storage_write_cost_per_byte": "30",
+		"storage_iter_next_cost": "30",
+		"storage_delete_cost": "1000"
 	},
   "codes": [
     {
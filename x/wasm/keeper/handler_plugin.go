@@ -20,29 +20,40 @@ type msgEncoder interface {
 
 // SDKMessageHandler can handles messages that can be encoded into sdk.Message types and routed.
 type SDKMessageHandler struct {
-	router   sdk.Router
-	encoders msgEncoder
+	router       sdk.Router
+	encoders     msgEncoder
+	stargateMsgs stargateMsgAcceptList
 }
 
-func NewDefaultMessageHandler(router sdk.Router, channelKeeper types.ChannelKeeper, capabilityKeeper types.CapabilityKeeper, unpacker codectypes.AnyUnpacker, portSource types.ICS20TransferPortSource, customEncoders ...*MessageEncoders) messenger {
+// stargateMsgAcceptList decides which message types a contract can dispatch via stargate
+type stargateMsgAcceptList interface {
+	AcceptedStargateMsg(ctx sdk.Context, typeURL string) bool
+}
+
+func NewDefaultMessageHandler(router sdk.Router, channelKeeper types.ChannelKeeper, capabilityKeeper types.CapabilityKeeper, unpacker codectypes.AnyUnpacker, portSource types.ICS20TransferPortSource, stargateMsgs stargateMsgAcceptList, customEncoders ...*MessageEncoders) messenger {
 	encoders := DefaultEncoders(unpacker, portSource)
 	for _, e := range customEncoders {
 		encoders = encoders.Merge(e)
 	}
 	return NewMessageHandlerChain(
-		NewSDKMessageHandler(router, encoders),
+		NewSDKMessageHandler(router, encoders, stargateMsgs),
 		NewIBCRawPacketHandler(channelKeeper, capabilityKeeper),
 	)
 }
 
-func NewSDKMessageHandler(router sdk.Router, encoders msgEncoder) SDKMessageHandler {
+// NewSDKMessageHandler constructor. Stargate messages are rejected unless the accept list allows their type.
+func NewSDKMessageHandler(router sdk.Router, encoders msgEncoder, stargateMsgs stargateMsgAcceptList) SDKMessageHandler {
 	return SDKMessageHandler{
-		router:   router,
-		encoders: encoders,
+		router:       router,
+		encoders:     encoders,
+		stargateMsgs: stargateMsgs,
 	}
 }
 
 func (h SDKMessageHandler) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) (events []sdk.Event, data [][]byte, err error) {
+	if msg.Stargate != nil && (h.stargateMsgs == nil || !h.stargateMsgs.AcceptedStargateMsg(ctx, msg.Stargate.TypeURL)) {
+		return nil, nil, sdkerrors.Wrap(types.ErrStargateMsgNotAllowed, msg.Stargate.TypeURL)
+	}
 	sdkMsgs, err := h.encoders.Encode(ctx, contractAddr, contractIBCPortID, msg)
 	if err != nil {
 		return nil, nil, err
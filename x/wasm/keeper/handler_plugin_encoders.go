@@ -10,6 +10,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	ibctransfertypes "github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
 	ibcclienttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/02-client/types"
 	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
@@ -22,6 +23,8 @@ type StakingEncoder func(sender sdk.AccAddress, msg *wasmvmtypes.StakingMsg) ([]
 type StargateEncoder func(sender sdk.AccAddress, msg *wasmvmtypes.StargateMsg) ([]sdk.Msg, error)
 type WasmEncoder func(sender sdk.AccAddress, msg *wasmvmtypes.WasmMsg) ([]sdk.Msg, error)
 type IBCEncoder func(ctx sdk.Context, sender sdk.AccAddress, contractIBCPortID string, msg *wasmvmtypes.IBCMsg) ([]sdk.Msg, error)
+type GovEncoder func(sender sdk.AccAddress, msg *types.GovMsg) ([]sdk.Msg, error)
+type DistributionEncoder func(sender sdk.AccAddress, msg *types.DistributionMsg) ([]sdk.Msg, error)
 
 type MessageEncoders struct {
 	Bank     func(sender sdk.AccAddress, msg *wasmvmtypes.BankMsg) ([]sdk.Msg, error)
@@ -109,6 +112,25 @@ func NoCustomMsg(sender sdk.AccAddress, msg json.RawMessage) ([]sdk.Msg, error)
 	return nil, sdkerrors.Wrap(types.ErrUnknownMsg, "custom variant not supported")
 }
 
+// ExtensionMsgEncoder returns an opt-in custom encoder for the gov and distribution messages that contracts send
+// within the custom variant, as the wasmvm CosmosMsg type in use has no variants for them. All other custom messages
+// are passed to the fallback encoder. Chains whose own custom messages use a top level `gov` or `distribution` key
+// must not use it.
+func ExtensionMsgEncoder(gov GovEncoder, distribution DistributionEncoder, fallback CustomEncoder) CustomEncoder {
+	return func(sender sdk.AccAddress, msg json.RawMessage) ([]sdk.Msg, error) {
+		var ext types.ExtensionMsg
+		if err := json.Unmarshal(msg, &ext); err == nil {
+			switch {
+			case ext.Gov != nil:
+				return gov(sender, ext.Gov)
+			case ext.Distribution != nil:
+				return distribution(sender, ext.Distribution)
+			}
+		}
+		return fallback(sender, msg)
+	}
+}
+
 func EncodeStakingMsg(sender sdk.AccAddress, msg *wasmvmtypes.StakingMsg) ([]sdk.Msg, error) {
 	switch {
 	case msg.Delegate != nil:
@@ -166,6 +188,61 @@ func EncodeStakingMsg(sender sdk.AccAddress, msg *wasmvmtypes.StakingMsg) ([]sdk
 	}
 }
 
+func EncodeGovMsg(sender sdk.AccAddress, msg *types.GovMsg) ([]sdk.Msg, error) {
+	switch {
+	case msg.Vote != nil:
+		option, err := convertVoteOption(msg.Vote.Vote)
+		if err != nil {
+			return nil, err
+		}
+		sdkMsg := govtypes.NewMsgVote(sender, msg.Vote.ProposalID, option)
+		return []sdk.Msg{sdkMsg}, nil
+	case msg.VoteWeighted != nil:
+		// the gov module in use has no weighted votes, a split vote can not be mapped to a single option
+		return nil, sdkerrors.Wrap(types.ErrInvalidMsg, "weighted votes are not supported by the gov module")
+	default:
+		return nil, sdkerrors.Wrap(types.ErrUnknownMsg, "unknown variant of Gov")
+	}
+}
+
+func convertVoteOption(o types.VoteOption) (govtypes.VoteOption, error) {
+	switch o {
+	case types.VoteOptionYes:
+		return govtypes.OptionYes, nil
+	case types.VoteOptionNo:
+		return govtypes.OptionNo, nil
+	case types.VoteOptionAbstain:
+		return govtypes.OptionAbstain, nil
+	case types.VoteOptionNoWithVeto:
+		return govtypes.OptionNoWithVeto, nil
+	default:
+		return govtypes.OptionEmpty, sdkerrors.Wrapf(types.ErrInvalidMsg, "vote option: %q", o)
+	}
+}
+
+func EncodeDistributionMsg(sender sdk.AccAddress, msg *types.DistributionMsg) ([]sdk.Msg, error) {
+	switch {
+	case msg.SetWithdrawAddress != nil:
+		sdkMsg := distributiontypes.MsgSetWithdrawAddress{
+			DelegatorAddress: sender.String(),
+			WithdrawAddress:  msg.SetWithdrawAddress.Address,
+		}
+		return []sdk.Msg{&sdkMsg}, nil
+	case msg.FundCommunityPool != nil:
+		amount, err := convertWasmCoinsToSdkCoins(msg.FundCommunityPool.Amount)
+		if err != nil {
+			return nil, err
+		}
+		sdkMsg := distributiontypes.MsgFundCommunityPool{
+			Depositor: sender.String(),
+			Amount:    amount,
+		}
+		return []sdk.Msg{&sdkMsg}, nil
+	default:
+		return nil, sdkerrors.Wrap(types.ErrUnknownMsg, "unknown variant of Distribution")
+	}
+}
+
 func EncodeStargateMsg(unpacker codectypes.AnyUnpacker) StargateEncoder {
 	return func(sender sdk.AccAddress, msg *wasmvmtypes.StargateMsg) ([]sdk.Msg, error) {
 		any := codectypes.Any{
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"encoding/json"
+	"fmt"
 	"github.com/CosmWasm/wasmd/x/wasm/keeper/wasmtesting"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -320,6 +321,13 @@ func TestEncoding(t *testing.T) {
 				},
 			},
 		},
+		"custom message with gov key is chain specific": {
+			sender: addr1,
+			srcMsg: wasmvmtypes.CosmosMsg{
+				Custom: []byte(`{"gov":{"vote":{"proposal_id":1,"vote":"yes"}}}`),
+			},
+			isError: true,
+		},
 		"stargate encoded bank msg": {
 			sender: addr2,
 			srcMsg: wasmvmtypes.CosmosMsg{
@@ -450,3 +458,116 @@ func TestEncoding(t *testing.T) {
 		})
 	}
 }
+
+func TestEncodeExtensionMsg(t *testing.T) {
+	addr1 := RandomAccountAddress(t)
+	addr2 := RandomAccountAddress(t)
+	encodingConfig := MakeEncodingConfig(t)
+	fallback := func(sender sdk.AccAddress, msg json.RawMessage) ([]sdk.Msg, error) {
+		return []sdk.Msg{&banktypes.MsgSend{FromAddress: sender.String()}}, nil
+	}
+
+	cases := map[string]struct {
+		sender sdk.AccAddress
+		srcMsg wasmvmtypes.CosmosMsg
+		// set if valid
+		output []sdk.Msg
+		// set if invalid
+		isError bool
+	}{
+		"gov vote": {
+			sender: addr1,
+			srcMsg: wasmvmtypes.CosmosMsg{
+				Custom: []byte(`{"gov":{"vote":{"proposal_id":1,"vote":"no_with_veto"}}}`),
+			},
+			output: []sdk.Msg{
+				&govtypes.MsgVote{
+					ProposalId: 1,
+					Voter:      addr1.String(),
+					Option:     govtypes.OptionNoWithVeto,
+				},
+			},
+		},
+		"gov vote with invalid option": {
+			sender: addr1,
+			srcMsg: wasmvmtypes.CosmosMsg{
+				Custom: []byte(`{"gov":{"vote":{"proposal_id":1,"vote":"maybe"}}}`),
+			},
+			isError: true,
+		},
+		"gov weighted vote": {
+			sender: addr1,
+			srcMsg: wasmvmtypes.CosmosMsg{
+				Custom: []byte(`{"gov":{"vote_weighted":{"proposal_id":2,"options":[{"option":"yes","weight":"1.0"}]}}}`),
+			},
+			isError: true,
+		},
+		"gov weighted vote with split options": {
+			sender: addr1,
+			srcMsg: wasmvmtypes.CosmosMsg{
+				Custom: []byte(`{"gov":{"vote_weighted":{"proposal_id":2,"options":[{"option":"yes","weight":"0.5"},{"option":"no","weight":"0.5"}]}}}`),
+			},
+			isError: true,
+		},
+		"distribution set withdraw address": {
+			sender: addr1,
+			srcMsg: wasmvmtypes.CosmosMsg{
+				Custom: []byte(fmt.Sprintf(`{"distribution":{"set_withdraw_address":{"address":%q}}}`, addr2.String())),
+			},
+			output: []sdk.Msg{
+				&distributiontypes.MsgSetWithdrawAddress{
+					DelegatorAddress: addr1.String(),
+					WithdrawAddress:  addr2.String(),
+				},
+			},
+		},
+		"distribution fund community pool": {
+			sender: addr1,
+			srcMsg: wasmvmtypes.CosmosMsg{
+				Custom: []byte(`{"distribution":{"fund_community_pool":{"amount":[{"denom":"uatom","amount":"12345"}]}}}`),
+			},
+			output: []sdk.Msg{
+				&distributiontypes.MsgFundCommunityPool{
+					Depositor: addr1.String(),
+					Amount:    sdk.NewCoins(sdk.NewInt64Coin("uatom", 12345)),
+				},
+			},
+		},
+		"distribution fund community pool with invalid amount": {
+			sender: addr1,
+			srcMsg: wasmvmtypes.CosmosMsg{
+				Custom: []byte(`{"distribution":{"fund_community_pool":{"amount":[{"denom":"uatom","amount":"foo"}]}}}`),
+			},
+			isError: true,
+		},
+		"distribution unknown variant": {
+			sender: addr1,
+			srcMsg: wasmvmtypes.CosmosMsg{
+				Custom: []byte(`{"distribution":{}}`),
+			},
+			isError: true,
+		},
+		"other custom message": {
+			sender: addr1,
+			srcMsg: wasmvmtypes.CosmosMsg{
+				Custom: []byte(`{"foo":{"bar":1}}`),
+			},
+			output: []sdk.Msg{&banktypes.MsgSend{FromAddress: addr1.String()}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var ctx sdk.Context
+			encoder := DefaultEncoders(encodingConfig.Marshaler, nil).Merge(&MessageEncoders{
+				Custom: ExtensionMsgEncoder(EncodeGovMsg, EncodeDistributionMsg, fallback),
+			})
+			res, err := encoder.Encode(ctx, tc.sender, "", tc.srcMsg)
+			if tc.isError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.output, res)
+			}
+		})
+	}
+}
@@ -192,7 +192,7 @@ func TestSDKMessageHandlerDispatch(t *testing.T) {
 
 			// when
 			ctx := sdk.Context{}
-			h := NewSDKMessageHandler(router, MessageEncoders{Custom: spec.srcEncoder})
+			h := NewSDKMessageHandler(router, MessageEncoders{Custom: spec.srcEncoder}, nil)
 			gotEvents, gotData, gotErr := h.DispatchMsg(ctx, myContractAddr, "myPort", myContractMessage)
 
 			// then
@@ -210,6 +210,101 @@ func TestSDKMessageHandlerDispatch(t *testing.T) {
 	}
 }
 
+func TestSDKMessageHandlerStargateMsgs(t *testing.T) {
+	const myTypeURL = "/cosmwasm.wasm.v1beta1.MsgExecuteContract"
+	myContractAddr := RandomAccountAddress(t)
+	var gotMsg []sdk.Msg
+	router := baseapp.NewRouter()
+	router.AddRoute(sdk.NewRoute(types.RouterKey, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		gotMsg = append(gotMsg, msg)
+		return &sdk.Result{}, nil
+	}))
+	encoders := MessageEncoders{
+		Stargate: func(sender sdk.AccAddress, msg *wasmvmtypes.StargateMsg) ([]sdk.Msg, error) {
+			return []sdk.Msg{&types.MsgExecuteContract{
+				Sender:   myContractAddr.String(),
+				Contract: RandomBech32AccountAddress(t),
+				Msg:      []byte("{}"),
+			}}, nil
+		},
+	}
+	specs := map[string]struct {
+		srcAcceptList stargateMsgAcceptList
+		expErr        *sdkerrors.Error
+	}{
+		"accepted": {
+			srcAcceptList: stargateMsgAcceptListFn(func(ctx sdk.Context, typeURL string) bool {
+				return typeURL == myTypeURL
+			}),
+		},
+		"not accepted": {
+			srcAcceptList: stargateMsgAcceptListFn(func(ctx sdk.Context, typeURL string) bool {
+				return false
+			}),
+			expErr: types.ErrStargateMsgNotAllowed,
+		},
+		"no accept list": {
+			expErr: types.ErrStargateMsgNotAllowed,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotMsg = nil
+			h := NewSDKMessageHandler(router, encoders, spec.srcAcceptList)
+			_, _, gotErr := h.DispatchMsg(sdk.Context{}, myContractAddr, "", wasmvmtypes.CosmosMsg{Stargate: &wasmvmtypes.StargateMsg{TypeURL: myTypeURL}})
+			require.True(t, spec.expErr.Is(gotErr), "exp %v but got %#+v", spec.expErr, gotErr)
+			if spec.expErr != nil {
+				assert.Len(t, gotMsg, 0)
+				return
+			}
+			assert.Len(t, gotMsg, 1)
+		})
+	}
+}
+
+func TestSDKMessageHandlerStargateMsgsWithoutParams(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures)
+	// a param store without the params, as on chains that upgrade from a version without them
+	keeper := *keepers.WasmKeeper
+	keeper.paramSpace = keepers.ParamsKeeper.Subspace("legacywasm").WithKeyTable(types.ParamKeyTable())
+	require.False(t, keeper.paramSpace.Has(ctx, types.ParamStoreKeyAcceptedStargateMsgs))
+
+	myContractAddr := RandomAccountAddress(t)
+	var gotMsg []sdk.Msg
+	router := baseapp.NewRouter()
+	router.AddRoute(sdk.NewRoute(types.RouterKey, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		gotMsg = append(gotMsg, msg)
+		return &sdk.Result{}, nil
+	}))
+	encoders := MessageEncoders{
+		Stargate: func(sender sdk.AccAddress, msg *wasmvmtypes.StargateMsg) ([]sdk.Msg, error) {
+			return []sdk.Msg{&types.MsgExecuteContract{
+				Sender:   myContractAddr.String(),
+				Contract: RandomBech32AccountAddress(t),
+				Msg:      []byte("{}"),
+			}}, nil
+		},
+	}
+	h := NewSDKMessageHandler(router, encoders, keeper)
+
+	// when
+	_, _, err := h.DispatchMsg(ctx, myContractAddr, "", wasmvmtypes.CosmosMsg{Stargate: &wasmvmtypes.StargateMsg{TypeURL: "/cosmwasm.wasm.v1beta1.MsgExecuteContract"}})
+
+	// then the defaults are accepted
+	require.NoError(t, err)
+	assert.Len(t, gotMsg, 1)
+	accepted, denied := keeper.GetStargateMsgs(ctx)
+	assert.Equal(t, types.DefaultAcceptedStargateMsgs, accepted)
+	assert.Empty(t, denied)
+	assert.False(t, keeper.AcceptedStargateMsg(ctx, "/cosmos.gov.v1beta1.MsgSubmitProposal"))
+}
+
+type stargateMsgAcceptListFn func(ctx sdk.Context, typeURL string) bool
+
+func (f stargateMsgAcceptListFn) AcceptedStargateMsg(ctx sdk.Context, typeURL string) bool {
+	return f(ctx, typeURL)
+}
+
 func TestIBCRawPacketHandler(t *testing.T) {
 	ibcPort := "contractsIBCPort"
 	var ctx sdk.Context
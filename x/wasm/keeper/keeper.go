@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"encoding/binary"
 	"fmt"
 	"github.com/CosmWasm/wasmd/x/wasm/types"
@@ -13,37 +14,38 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/gogo/protobuf/proto"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/libs/log"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"time"
 )
 
-// GasMultiplier is how many cosmwasm gas points = 1 sdk gas point
-// SDK reference costs can be found here: https://github.com/cosmos/cosmos-sdk/blob/02c6c9fafd58da88550ab4d7d494724a477c8a68/store/types/gas.go#L153-L164
-// A write at ~3000 gas and ~200us = 10 gas per us (microsecond) cpu/io
-// Rough timing have 88k gas at 90us, which is equal to 1k sdk gas... (one read)
-//
-// Please not that all gas prices returned to the wasmer engine should have this multiplied
-const GasMultiplier uint64 = 100
+// GasMultiplier is the default of how many cosmwasm gas points = 1 sdk gas point.
+// The value in use is read from the params.
+const GasMultiplier = types.DefaultGasMultiplier
 
 // MaxGas for a contract is 10 billion wasmer gas (enforced in rust to prevent overflow)
 // The limit for v0.9.3 is defined here: https://github.com/CosmWasm/cosmwasm/blob/v0.9.3/packages/vm/src/backends/singlepass.rs#L15-L23
 // (this will be increased in future releases)
 const MaxGas = 10_000_000_000
 
-// InstanceCost is how much SDK gas we charge each time we load a WASM instance.
-// Creating a new instance is costly, and this helps put a recursion limit to contracts calling contracts.
-const InstanceCost uint64 = 40_000
+// InstanceCost is the default of how much SDK gas we charge each time we load a WASM instance.
+// The value in use is read from the params.
+const InstanceCost = types.DefaultInstanceCost
 
-// CompileCost is how much SDK gas we charge *per byte* for compiling WASM code.
-const CompileCost uint64 = 2
+// CompileCost is the default of how much SDK gas we charge *per byte* for compiling WASM code.
+// The value in use is read from the params.
+const CompileCost = types.DefaultCompileCost
 
-// contractMemoryLimit is the memory limit of each contract execution (in MiB)
-// constant value so all nodes run with the same limit.
-const contractMemoryLimit = 32
+// contractMemoryLimit is the memory limit the VM is created with (in MiB). The limit from the params
+// is applied by InitializePinnedCodes on node start and at the end of the block in which it changes.
+const contractMemoryLimit = types.DefaultContractMemoryLimit
 
 // Option is an extension point to instantiate keeper with non default values
 type Option interface {
@@ -67,12 +69,21 @@ type coinTransferrer interface {
 	TransferCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
 }
 
+// storageDepositTransferrer is implemented by coin transferrers that support the storage deposits
+type storageDepositTransferrer interface {
+	// LockStorageDeposit moves the coins from the account to the wasm module account.
+	LockStorageDeposit(ctx sdk.Context, fromAddr sdk.AccAddress, amt sdk.Coins) error
+	// RefundStorageDeposit moves the coins from the wasm module account to the account.
+	RefundStorageDeposit(ctx sdk.Context, toAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
 // Keeper will have a reference to Wasmer with it's own data directory.
 type Keeper struct {
 	storeKey           sdk.StoreKey
 	cdc                codec.Marshaler
 	accountKeeper      types.AccountKeeper
 	bank               coinTransferrer
+	distrKeeper        types.DistributionKeeper
 	ChannelKeeper      types.ChannelKeeper
 	portKeeper         types.PortKeeper
 	capabilityKeeper   types.CapabilityKeeper
@@ -83,6 +94,8 @@ type Keeper struct {
 	queryGasLimit uint64
 	authZPolicy   AuthorizationPolicy
 	paramSpace    paramtypes.Subspace
+	// acceptedStargateQueries are the response types of the stargate query paths that can be enabled via params
+	acceptedStargateQueries AcceptedStargateQueries
 }
 
 // NewKeeper creates a new contract Keeper instance
@@ -106,7 +119,7 @@ func NewKeeper(
 	supportedFeatures string,
 	opts ...Option,
 ) Keeper {
-	wasmer, err := wasmvm.NewVM(filepath.Join(homeDir, "wasm"), supportedFeatures, contractMemoryLimit, wasmConfig.ContractDebugMode, wasmConfig.MemoryCacheSize)
+	wasmer, err := newWasmVMEngine(filepath.Join(homeDir, "wasm"), supportedFeatures, contractMemoryLimit, wasmConfig.ContractDebugMode, wasmConfig.MemoryCacheSize)
 	if err != nil {
 		panic(err)
 	}
@@ -121,14 +134,17 @@ func NewKeeper(
 		wasmVM:           wasmer,
 		accountKeeper:    accountKeeper,
 		bank:             NewBankCoinTransferrer(bankKeeper),
+		distrKeeper:      distKeeper,
 		ChannelKeeper:    channelKeeper,
 		portKeeper:       portKeeper,
 		capabilityKeeper: capabilityKeeper,
-		messenger:        NewDefaultMessageHandler(router, channelKeeper, capabilityKeeper, cdc, portSource),
 		queryGasLimit:    wasmConfig.SmartQueryGasLimit,
 		authZPolicy:      DefaultAuthorizationPolicy{},
 		paramSpace:       paramSpace,
+
+		acceptedStargateQueries: DefaultAcceptedStargateQueries(),
 	}
+	keeper.messenger = NewDefaultMessageHandler(router, channelKeeper, capabilityKeeper, cdc, portSource, &keeper)
 	keeper.wasmVMQueryHandler = DefaultQueryPlugins(bankKeeper, stakingKeeper, distKeeper, channelKeeper, queryRouter, &keeper)
 	for _, o := range opts {
 		o.apply(&keeper)
@@ -154,10 +170,124 @@ func (k Keeper) GetMaxWasmCodeSize(ctx sdk.Context) uint64 {
 	return a
 }
 
-// GetParams returns the total set of wasm parameters.
+// getGasParam returns the uint64 param for the key or the default when it was not set, yet.
+// The param is read without charging gas so that the costs of a contract call depend on the param
+// values only.
+func (k Keeper) getGasParam(ctx sdk.Context, key []byte, defaultValue uint64) uint64 {
+	a := defaultValue
+	k.paramSpace.GetIfExists(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), key, &a)
+	return a
+}
+
+// GetGasMultiplier returns how many cosmwasm gas points = 1 sdk gas point
+func (k Keeper) GetGasMultiplier(ctx sdk.Context) uint64 {
+	return k.getGasParam(ctx, types.ParamStoreKeyGasMultiplier, types.DefaultGasMultiplier)
+}
+
+// GetInstanceCost returns the sdk gas charged for loading a wasm instance that is not pinned
+func (k Keeper) GetInstanceCost(ctx sdk.Context) uint64 {
+	return k.getGasParam(ctx, types.ParamStoreKeyInstanceCost, types.DefaultInstanceCost)
+}
+
+// GetCompileCost returns the sdk gas charged per byte for compiling wasm code
+func (k Keeper) GetCompileCost(ctx sdk.Context) uint64 {
+	return k.getGasParam(ctx, types.ParamStoreKeyCompileCost, types.DefaultCompileCost)
+}
+
+// GetHumanizeCost returns the sdk gas charged for converting a canonical address to bech32
+func (k Keeper) GetHumanizeCost(ctx sdk.Context) uint64 {
+	return k.getGasParam(ctx, types.ParamStoreKeyHumanizeCost, types.DefaultHumanizeCost)
+}
+
+// GetCanonicalizeCost returns the sdk gas charged for converting a bech32 address to canonical
+func (k Keeper) GetCanonicalizeCost(ctx sdk.Context) uint64 {
+	return k.getGasParam(ctx, types.ParamStoreKeyCanonicalizeCost, types.DefaultCanonicalizeCost)
+}
+
+// GetContractMemoryLimit returns the memory limit of each contract execution (in MiB)
+func (k Keeper) GetContractMemoryLimit(ctx sdk.Context) uint32 {
+	a := types.DefaultContractMemoryLimit
+	k.paramSpace.GetIfExists(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), types.ParamStoreKeyContractMemoryLimit, &a)
+	return a
+}
+
+// getAcceptedStargateQueryPaths returns the paths that are enabled via params. Chains that did not set the
+// param yet accept the default paths. Like the other params, the read is not charged to the gas meter of the caller.
+func (k Keeper) getAcceptedStargateQueryPaths(ctx sdk.Context) []string {
+	// a copy so that the decoded param can not overwrite the defaults
+	a := append([]string{}, types.DefaultAcceptedStargateQueries...)
+	k.paramSpace.GetIfExists(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), types.ParamStoreKeyAcceptedStargateQueries, &a)
+	return a
+}
+
+// AcceptedStargateQuery returns a new instance of the response type when the query path is enabled via params
+// and has a registered response type.
+func (k Keeper) AcceptedStargateQuery(ctx sdk.Context, path string) (codec.ProtoMarshaler, bool) {
+	prototype, ok := k.acceptedStargateQueries[path]
+	if !ok {
+		return nil, false
+	}
+	for _, p := range k.getAcceptedStargateQueryPaths(ctx) {
+		if p == path {
+			res := proto.Clone(prototype).(codec.ProtoMarshaler)
+			res.Reset()
+			return res, true
+		}
+	}
+	return nil, false
+}
+
+// AcceptedStargateQueryPaths returns the sorted paths that are enabled via params and have a registered
+// response type.
+func (k Keeper) AcceptedStargateQueryPaths(ctx sdk.Context) []string {
+	r := make([]string, 0)
+	for _, p := range k.getAcceptedStargateQueryPaths(ctx) {
+		if _, ok := k.acceptedStargateQueries[p]; ok {
+			r = append(r, p)
+		}
+	}
+	sort.Strings(r)
+	return r
+}
+
+// AcceptedStargateMsg returns true when contracts can dispatch the message type via stargate. The deny list takes
+// precedence over the accept list. Chains that did not set the params yet accept the default messages.
+func (k Keeper) AcceptedStargateMsg(ctx sdk.Context, typeURL string) bool {
+	accepted, denied := k.GetStargateMsgs(ctx)
+	for _, d := range denied {
+		if d == typeURL {
+			return false
+		}
+	}
+	for _, a := range accepted {
+		if a == typeURL {
+			return true
+		}
+	}
+	return false
+}
+
+// GetStargateMsgs returns the accepted and denied stargate message type URLs from the params. The default accept list
+// is returned when the param is not set. The reads are not charged to the gas meter of the caller.
+func (k Keeper) GetStargateMsgs(ctx sdk.Context) (accepted []string, denied []string) {
+	// a copy so that the decoded param can not overwrite the defaults
+	accepted = append([]string{}, types.DefaultAcceptedStargateMsgs...)
+	paramCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	k.paramSpace.GetIfExists(paramCtx, types.ParamStoreKeyAcceptedStargateMsgs, &accepted)
+	k.paramSpace.GetIfExists(paramCtx, types.ParamStoreKeyDeniedStargateMsgs, &denied)
+	return accepted, denied
+}
+
+// GetParams returns the total set of wasm parameters. Params that are not set in the param store, yet, have their
+// default value, as on chains that did not run the migrations.
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	var params types.Params
-	k.paramSpace.GetParamSet(ctx, &params)
+	params := types.DefaultParams()
+	// copies so that the decoded params can not overwrite the defaults
+	params.AcceptedStargateQueries = append([]string{}, params.AcceptedStargateQueries...)
+	params.AcceptedStargateMsgs = append([]string{}, params.AcceptedStargateMsgs...)
+	for _, pair := range params.ParamSetPairs() {
+		k.paramSpace.GetIfExists(ctx, pair.Key, pair.Value)
+	}
 	return params
 }
 
@@ -171,33 +301,90 @@ func (k Keeper) Create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte,
 }
 
 func (k Keeper) create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, source string, builder string, instantiateAccess *types.AccessConfig, authZ AuthorizationPolicy) (codeID uint64, err error) {
-	if !authZ.CanCreateCode(k.getUploadAccessConfig(ctx), creator) {
+	if !authZ.CanCreateCode(ctx, k.getUploadAccessConfig(ctx), creator) {
 		return 0, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not create code")
 	}
+	if instantiateAccess != nil {
+		if err := k.requireContractOfAccessConfig(ctx, *instantiateAccess); err != nil {
+			return 0, err
+		}
+	}
 	wasmCode, err = uncompress(wasmCode, k.GetMaxWasmCodeSize(ctx))
 	if err != nil {
 		return 0, sdkerrors.Wrap(types.ErrCreateFailed, err.Error())
 	}
-	ctx.GasMeter().ConsumeGas(CompileCost*uint64(len(wasmCode)), "Compiling WASM Bytecode")
+	if instantiateAccess == nil {
+		defaultAccessConfig := k.getInstantiateAccessConfig(ctx).With(creator)
+		instantiateAccess = &defaultAccessConfig
+	}
+	// the wasmvm checksum is the sha256 hash of the code, so that a duplicate is found without compiling it
+	checksum := sha256.Sum256(wasmCode)
+	if existingID, ok := k.findDuplicateCode(ctx, checksum[:], creator, *instantiateAccess); ok {
+		return existingID, nil
+	}
+	ctx.GasMeter().ConsumeGas(k.GetCompileCost(ctx)*uint64(len(wasmCode)), "Compiling WASM Bytecode")
 
 	codeHash, err := k.wasmVM.Create(wasmCode)
 	if err != nil {
 		return 0, sdkerrors.Wrap(types.ErrCreateFailed, err.Error())
 	}
 	codeID = k.autoIncrementID(ctx, types.KeyLastCodeID)
-	if instantiateAccess == nil {
-		defaultAccessConfig := k.getInstantiateAccessConfig(ctx).With(creator)
-		instantiateAccess = &defaultAccessConfig
-	}
 	codeInfo := types.NewCodeInfo(codeHash, creator, source, builder, *instantiateAccess)
 	k.storeCodeInfo(ctx, codeID, codeInfo)
 	return codeID, nil
 }
 
+// requireContractOfAccessConfig ensures that the address of an OnlyContract config belongs to a contract.
+// Accounts can not sign for a contract address so that only the contract itself is granted access.
+func (k Keeper) requireContractOfAccessConfig(ctx sdk.Context, config types.AccessConfig) error {
+	if config.Permission != types.AccessTypeOnlyContract {
+		return nil
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(config.Address)
+	if err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	if k.GetContractInfo(ctx, contractAddr) == nil {
+		return sdkerrors.Wrapf(types.ErrNotFound, "contract %s", config.Address)
+	}
+	return nil
+}
+
+// findDuplicateCode returns the first code of the creator with the checksum and instantiate permission when the
+// deduplication of code uploads is enabled. Codes of other creators are never returned so that the creator of a
+// code id is always the one that uploaded it.
+func (k Keeper) findDuplicateCode(ctx sdk.Context, codeHash []byte, creator sdk.AccAddress, instantiateAccess types.AccessConfig) (uint64, bool) {
+	var deduplicate bool
+	k.paramSpace.GetIfExists(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), types.ParamStoreKeyDeduplicateCodeUploads, &deduplicate)
+	if !deduplicate {
+		return 0, false
+	}
+	for _, codeID := range k.GetCodeIDsByChecksum(ctx, codeHash) {
+		codeInfo := k.GetCodeInfo(ctx, codeID)
+		if codeInfo != nil && codeInfo.Creator == creator.String() && codeInfo.InstantiateConfig.Equals(instantiateAccess) {
+			return codeID, true
+		}
+	}
+	return 0, false
+}
+
+// GetCodeIDsByChecksum returns the ids of all codes with the checksum in ascending order
+func (k Keeper) GetCodeIDsByChecksum(ctx sdk.Context, checksum []byte) []uint64 {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetCodeByChecksumIndexPrefix(checksum))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	var r []uint64
+	for ; iter.Valid(); iter.Next() {
+		r = append(r, binary.BigEndian.Uint64(iter.Key()))
+	}
+	return r
+}
+
 func (k Keeper) storeCodeInfo(ctx sdk.Context, codeID uint64, codeInfo types.CodeInfo) {
 	store := ctx.KVStore(k.storeKey)
 	// 0x01 | codeID (uint64) -> ContractInfo
 	store.Set(types.GetCodeKey(codeID), k.cdc.MustMarshalBinaryBare(&codeInfo))
+	store.Set(types.GetCodeByChecksumIndexKey(codeInfo.CodeHash, codeID), []byte{})
 }
 
 func (k Keeper) importCode(ctx sdk.Context, codeID uint64, codeInfo types.CodeInfo, wasmCode []byte) error {
@@ -218,27 +405,52 @@ func (k Keeper) importCode(ctx sdk.Context, codeID uint64, codeInfo types.CodeIn
 	if store.Has(key) {
 		return sdkerrors.Wrapf(types.ErrDuplicate, "duplicate code: %d", codeID)
 	}
-	// 0x01 | codeID (uint64) -> ContractInfo
-	store.Set(key, k.cdc.MustMarshalBinaryBare(&codeInfo))
+	k.storeCodeInfo(ctx, codeID, codeInfo)
 	return nil
 }
 
 // Instantiate creates an instance of a WASM contract
 func (k Keeper) Instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins) (sdk.AccAddress, []byte, error) {
-	return k.instantiate(ctx, codeID, creator, admin, initMsg, label, deposit, k.authZPolicy)
+	return k.instantiate(ctx, codeID, creator, admin, initMsg, label, deposit, k.classicAddressGenerator(), k.authZPolicy)
+}
+
+// Instantiate2 creates an instance of a WASM contract. Unlike Instantiate the contract address is derived from
+// the code checksum, the creator and the given salt so that it is known before the contract exists.
+func (k Keeper) Instantiate2(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins, salt []byte) (sdk.AccAddress, []byte, error) {
+	return k.instantiate(ctx, codeID, creator, admin, initMsg, label, deposit, predictableAddressGenerator(creator, salt), k.authZPolicy)
 }
 
-func (k Keeper) instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins, authZ AuthorizationPolicy) (sdk.AccAddress, []byte, error) {
+func (k Keeper) instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins, addressGenerator addressGenerator, authZ AuthorizationPolicy) (sdk.AccAddress, []byte, error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "instantiate")
 	if !k.IsPinnedCode(ctx, codeID) {
-		ctx.GasMeter().ConsumeGas(InstanceCost, "Loading CosmWasm module: instantiate")
+		ctx.GasMeter().ConsumeGas(k.GetInstanceCost(ctx), "Loading CosmWasm module: instantiate")
+	}
+
+	// get contact info
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetCodeKey(codeID))
+	if bz == nil {
+		return nil, nil, sdkerrors.Wrap(types.ErrNotFound, "code")
+	}
+	var codeInfo types.CodeInfo
+	k.cdc.MustUnmarshalBinaryBare(bz, &codeInfo)
+
+	if !authZ.CanInstantiateContract(ctx, codeInfo.InstantiateConfig, creator) {
+		return nil, nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not instantiate")
 	}
 
 	// create contract address
-	contractAddress := k.generateContractAddress(ctx, codeID)
+	contractAddress := addressGenerator(ctx, codeID, codeInfo.CodeHash)
 	existingAcct := k.accountKeeper.GetAccount(ctx, contractAddress)
 	if existingAcct != nil {
-		return nil, nil, sdkerrors.Wrap(types.ErrAccountExists, existingAcct.GetAddress().String())
+		if k.containsContractInfo(ctx, contractAddress) {
+			return nil, nil, sdkerrors.Wrap(types.ErrDuplicate, "instance with this code id, sender and salt exists")
+		}
+		// an address that is known upfront may have received tokens already. Such a plain account was never
+		// used to sign anything and can be taken over by the contract.
+		if !isUnusedBaseAccount(existingAcct) {
+			return nil, nil, sdkerrors.Wrap(types.ErrAccountExists, existingAcct.GetAddress().String())
+		}
 	}
 
 	// deposit initial contract funds
@@ -247,49 +459,48 @@ func (k Keeper) instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.A
 			return nil, nil, err
 		}
 
-	} else {
+	} else if existingAcct == nil {
 		// create an empty account (so we don't have issues later)
 		// TODO: can we remove this?
 		contractAccount := k.accountKeeper.NewAccountWithAddress(ctx, contractAddress)
 		k.accountKeeper.SetAccount(ctx, contractAccount)
 	}
 
-	// get contact info
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.GetCodeKey(codeID))
-	if bz == nil {
-		return nil, nil, sdkerrors.Wrap(types.ErrNotFound, "code")
-	}
-	var codeInfo types.CodeInfo
-	k.cdc.MustUnmarshalBinaryBare(bz, &codeInfo)
-
-	if !authZ.CanInstantiateContract(codeInfo.InstantiateConfig, creator) {
-		return nil, nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not instantiate")
-	}
-
 	// prepare params for contract instantiate call
 	env := types.NewEnv(ctx, contractAddress)
 	info := types.NewInfo(creator, deposit)
 
 	// create prefixed data store
 	// 0x03 | contractAddress (sdk.AccAddress)
-	prefixStoreKey := types.GetContractStorePrefix(contractAddress)
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), prefixStoreKey)
+	prefixStore := k.contractStore(ctx, contractAddress, codeID)
 
 	// prepare querier
-	querier := NewQueryHandler(ctx, k.wasmVMQueryHandler, contractAddress)
+	querier := NewQueryHandler(ctx, k.wasmVMQueryHandler, contractAddress, k.GetGasMultiplier(ctx))
 
 	// instantiate wasm contract
-	gas := gasForContract(ctx)
-	res, gasUsed, err := k.wasmVM.Instantiate(codeInfo.CodeHash, env, info, initMsg, prefixStore, cosmwasmAPI, querier, gasMeter(ctx), gas)
-	consumeGas(ctx, gasUsed)
+	gas := k.gasForContract(ctx)
+	res, gasUsed, err := k.wasmVM.Instantiate(codeInfo.CodeHash, env, info, initMsg, prefixStore, k.cosmwasmAPI(ctx), querier, k.gasMeter(ctx), gas)
+	k.consumeGas(ctx, gasUsed)
+	if storeErr := contractStoreErr(prefixStore); storeErr != nil {
+		return contractAddress, nil, storeErr
+	}
 	if err != nil {
 		return contractAddress, nil, sdkerrors.Wrap(types.ErrInstantiateFailed, err.Error())
 	}
+	if err := k.settleStorageDeposit(ctx, contractAddress, prefixStore, creator); err != nil {
+		return contractAddress, nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeInstantiate,
+		sdk.NewAttribute(types.AttributeKeyContractAddrReserved, contractAddress.String()),
+		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(codeID, 10)),
+	))
 
 	// emit all events from this contract itself
-	events := types.ParseEvents(res.Attributes, contractAddress)
-	ctx.EventManager().EmitEvents(events)
+	if err := emitContractEvents(ctx, contractAddress, res.Attributes, nil); err != nil {
+		return nil, nil, err
+	}
 
 	// persist instance first
 	createdAt := types.NewAbsoluteTxPosition(ctx)
@@ -324,14 +535,24 @@ func (k Keeper) instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.A
 
 // Execute executes the contract instance
 func (k Keeper) Execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) (*sdk.Result, error) {
+	return k.execute(ctx, contractAddress, caller, msg, coins, k.authZPolicy)
+}
+
+func (k Keeper) execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins, authZ AuthorizationPolicy) (*sdk.Result, error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "execute")
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddress)
 	if err != nil {
 		return nil, err
 	}
+	if !authZ.CanExecuteContract(ctx, contractAddress, caller) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not execute")
+	}
+	if contractInfo.Paused {
+		return nil, sdkerrors.Wrap(types.ErrContractPaused, "execute")
+	}
 
 	if !k.IsPinnedCode(ctx, contractInfo.CodeID) {
-		ctx.GasMeter().ConsumeGas(InstanceCost, "Loading CosmWasm module: execute")
+		ctx.GasMeter().ConsumeGas(k.GetInstanceCost(ctx), "Loading CosmWasm module: execute")
 	}
 
 	// add more funds
@@ -345,17 +566,30 @@ func (k Keeper) Execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 	info := types.NewInfo(caller, coins)
 
 	// prepare querier
-	querier := NewQueryHandler(ctx, k.wasmVMQueryHandler, contractAddress)
-	gas := gasForContract(ctx)
-	res, gasUsed, execErr := k.wasmVM.Execute(codeInfo.CodeHash, env, info, msg, prefixStore, cosmwasmAPI, querier, gasMeter(ctx), gas)
-	consumeGas(ctx, gasUsed)
+	querier := NewQueryHandler(ctx, k.wasmVMQueryHandler, contractAddress, k.GetGasMultiplier(ctx))
+	gas := k.gasForContract(ctx)
+	res, gasUsed, execErr := k.wasmVM.Execute(codeInfo.CodeHash, env, info, msg, prefixStore, k.cosmwasmAPI(ctx), querier, k.gasMeter(ctx), gas)
+	k.consumeGas(ctx, gasUsed)
+	if storeErr := contractStoreErr(prefixStore); storeErr != nil {
+		return nil, storeErr
+	}
 	if execErr != nil {
 		return nil, sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
+	if err := k.settleStorageDeposit(ctx, contractAddress, prefixStore, caller); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeExecute,
+		sdk.NewAttribute(types.AttributeKeyContractAddrReserved, contractAddress.String()),
+		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(contractInfo.CodeID, 10)),
+	))
 
 	// emit all events from this contract itself
-	events := types.ParseEvents(res.Attributes, contractAddress)
-	ctx.EventManager().EmitEvents(events)
+	if err := emitContractEvents(ctx, contractAddress, res.Attributes, nil); err != nil {
+		return nil, err
+	}
 
 	// dispatch submessages then messages
 	err = k.dispatchAll(ctx, contractAddress, contractInfo.IBCPortID, res.Submessages, res.Messages)
@@ -376,21 +610,28 @@ func (k Keeper) Migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 func (k Keeper) migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, newCodeID uint64, msg []byte, authZ AuthorizationPolicy) (*sdk.Result, error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "migrate")
 	if !k.IsPinnedCode(ctx, newCodeID) {
-		ctx.GasMeter().ConsumeGas(InstanceCost, "Loading CosmWasm module: migrate")
+		ctx.GasMeter().ConsumeGas(k.GetInstanceCost(ctx), "Loading CosmWasm module: migrate")
 	}
 
 	contractInfo := k.GetContractInfo(ctx, contractAddress)
 	if contractInfo == nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
 	}
-	if !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
+	if !authZ.CanModifyContract(ctx, contractInfo.AdminAddr(), caller) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not migrate")
 	}
+	if contractInfo.Paused && !authZ.CanMigratePausedContract(ctx) {
+		return nil, sdkerrors.Wrap(types.ErrContractPaused, "migrate")
+	}
 
 	newCodeInfo := k.GetCodeInfo(ctx, newCodeID)
 	if newCodeInfo == nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unknown code")
 	}
+	if allowlist := contractInfo.MigrationAllowlist; allowlist != nil && !authZ.CanIgnoreMigrationAllowlist(ctx) &&
+		!allowlist.Allows(newCodeID, newCodeInfo.Creator) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "code %d not in migration allowlist", newCodeID)
+	}
 
 	// check for IBC flag
 	switch report, err := k.wasmVM.AnalyzeCode(newCodeInfo.CodeHash); {
@@ -411,20 +652,32 @@ func (k Keeper) migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 	env := types.NewEnv(ctx, contractAddress)
 
 	// prepare querier
-	querier := NewQueryHandler(ctx, k.wasmVMQueryHandler, contractAddress)
+	querier := NewQueryHandler(ctx, k.wasmVMQueryHandler, contractAddress, k.GetGasMultiplier(ctx))
 
-	prefixStoreKey := types.GetContractStorePrefix(contractAddress)
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), prefixStoreKey)
-	gas := gasForContract(ctx)
-	res, gasUsed, err := k.wasmVM.Migrate(newCodeInfo.CodeHash, env, msg, &prefixStore, cosmwasmAPI, &querier, gasMeter(ctx), gas)
-	consumeGas(ctx, gasUsed)
+	prefixStore := k.contractStore(ctx, contractAddress, newCodeID)
+	gas := k.gasForContract(ctx)
+	res, gasUsed, err := k.wasmVM.Migrate(newCodeInfo.CodeHash, env, msg, prefixStore, k.cosmwasmAPI(ctx), &querier, k.gasMeter(ctx), gas)
+	k.consumeGas(ctx, gasUsed)
+	if storeErr := contractStoreErr(prefixStore); storeErr != nil {
+		return nil, storeErr
+	}
 	if err != nil {
 		return nil, sdkerrors.Wrap(types.ErrMigrationFailed, err.Error())
 	}
+	if err := k.settleStorageDeposit(ctx, contractAddress, prefixStore, caller); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeMigrate,
+		sdk.NewAttribute(types.AttributeKeyContractAddrReserved, contractAddress.String()),
+		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(newCodeID, 10)),
+	))
 
 	// emit all events from this contract migration itself
-	events := types.ParseEvents(res.Attributes, contractAddress)
-	ctx.EventManager().EmitEvents(events)
+	if err := emitContractEvents(ctx, contractAddress, res.Attributes, nil); err != nil {
+		return nil, err
+	}
 
 	// delete old secondary index entry
 	k.deleteContractSecondIndex(ctx, contractAddress, contractInfo)
@@ -444,34 +697,57 @@ func (k Keeper) migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 	}, nil
 }
 
-// Sudo allows priviledged access to a contract. This can never be called by governance or external tx, but only by
-// another native Go module directly. Thus, the keeper doesn't place any access controls on it, that is the
-// responsibility or the app developer (who passes the wasm.Keeper in app.go)
+// Sudo allows priviledged access to a contract. This can never be called by external tx, but only by governance or
+// another native Go module directly. Thus, the keeper only consults the authorization policy, other access controls
+// are the responsibility or the app developer (who passes the wasm.Keeper in app.go)
 func (k Keeper) Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) (*sdk.Result, error) {
+	return k.sudo(ctx, contractAddress, msg, k.authZPolicy)
+}
+
+func (k Keeper) sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte, authZ AuthorizationPolicy) (*sdk.Result, error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "sudo")
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddress)
 	if err != nil {
 		return nil, err
 	}
+	if !authZ.CanSudoContract(ctx, contractAddress) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not sudo")
+	}
+	if contractInfo.Paused {
+		return nil, sdkerrors.Wrap(types.ErrContractPaused, "sudo")
+	}
 
 	if !k.IsPinnedCode(ctx, contractInfo.CodeID) {
-		ctx.GasMeter().ConsumeGas(InstanceCost, "Loading CosmWasm module: sudo")
+		ctx.GasMeter().ConsumeGas(k.GetInstanceCost(ctx), "Loading CosmWasm module: sudo")
 	}
 
 	env := types.NewEnv(ctx, contractAddress)
 
 	// prepare querier
-	querier := NewQueryHandler(ctx, k.wasmVMQueryHandler, contractAddress)
-	gas := gasForContract(ctx)
-	res, gasUsed, execErr := k.wasmVM.Sudo(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, gasMeter(ctx), gas)
-	consumeGas(ctx, gasUsed)
+	querier := NewQueryHandler(ctx, k.wasmVMQueryHandler, contractAddress, k.GetGasMultiplier(ctx))
+	gas := k.gasForContract(ctx)
+	res, gasUsed, execErr := k.wasmVM.Sudo(codeInfo.CodeHash, env, msg, prefixStore, k.cosmwasmAPI(ctx), querier, k.gasMeter(ctx), gas)
+	k.consumeGas(ctx, gasUsed)
+	if storeErr := contractStoreErr(prefixStore); storeErr != nil {
+		return nil, storeErr
+	}
 	if execErr != nil {
 		return nil, sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
+	if err := k.settleStorageDeposit(ctx, contractAddress, prefixStore, nil); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSudo,
+		sdk.NewAttribute(types.AttributeKeyContractAddrReserved, contractAddress.String()),
+		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(contractInfo.CodeID, 10)),
+	))
 
 	// emit all events from this contract itself
-	events := types.ParseEvents(res.Attributes, contractAddress)
-	ctx.EventManager().EmitEvents(events)
+	if err := emitContractEvents(ctx, contractAddress, res.Attributes, nil); err != nil {
+		return nil, err
+	}
 
 	// dispatch submessages then messages
 	err = k.dispatchAll(ctx, contractAddress, contractInfo.IBCPortID, res.Submessages, res.Messages)
@@ -484,6 +760,14 @@ func (k Keeper) Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte
 	}, nil
 }
 
+// fundFromCommunityPool sends the given amount from the community pool to the receiver
+func (k Keeper) fundFromCommunityPool(ctx sdk.Context, receiver sdk.AccAddress, amount sdk.Coins) error {
+	if amount.IsZero() {
+		return nil
+	}
+	return k.distrKeeper.DistributeFromFeePool(ctx, amount, receiver)
+}
+
 // reply is only called from keeper internal functions (dispatchSubmessages) after processing the submessage
 // it
 func (k Keeper) reply(ctx sdk.Context, contractAddress sdk.AccAddress, reply wasmvmtypes.Reply) (*sdk.Result, error) {
@@ -491,29 +775,36 @@ func (k Keeper) reply(ctx sdk.Context, contractAddress sdk.AccAddress, reply was
 	if err != nil {
 		return nil, err
 	}
+	if contractInfo.Paused {
+		return nil, sdkerrors.Wrap(types.ErrContractPaused, "reply")
+	}
 
 	// current thought is to charge gas like a fresh run, we can revisit whether to give it a discount later
 	if !k.IsPinnedCode(ctx, contractInfo.CodeID) {
-		ctx.GasMeter().ConsumeGas(InstanceCost, "Loading CosmWasm module: reply")
+		ctx.GasMeter().ConsumeGas(k.GetInstanceCost(ctx), "Loading CosmWasm module: reply")
 	}
 
 	env := types.NewEnv(ctx, contractAddress)
 
 	// prepare querier
-	querier := QueryHandler{
-		Ctx:     ctx,
-		Plugins: k.wasmVMQueryHandler,
+	querier := NewQueryHandler(ctx, k.wasmVMQueryHandler, contractAddress, k.GetGasMultiplier(ctx))
+	gas := k.gasForContract(ctx)
+	res, gasUsed, execErr := k.wasmVM.Reply(codeInfo.CodeHash, env, reply, prefixStore, k.cosmwasmAPI(ctx), querier, k.gasMeter(ctx), gas)
+	k.consumeGas(ctx, gasUsed)
+	if storeErr := contractStoreErr(prefixStore); storeErr != nil {
+		return nil, storeErr
 	}
-	gas := gasForContract(ctx)
-	res, gasUsed, execErr := k.wasmVM.Reply(codeInfo.CodeHash, env, reply, prefixStore, cosmwasmAPI, querier, gasMeter(ctx), gas)
-	consumeGas(ctx, gasUsed)
 	if execErr != nil {
 		return nil, sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
+	if err := k.settleStorageDeposit(ctx, contractAddress, prefixStore, nil); err != nil {
+		return nil, err
+	}
 
 	// emit all events from this contract itself
-	events := types.ParseEvents(res.Attributes, contractAddress)
-	ctx.EventManager().EmitEvents(events)
+	if err := emitContractEvents(ctx, contractAddress, res.Attributes, nil); err != nil {
+		return nil, err
+	}
 
 	// dispatch submessages then messages
 	err = k.dispatchAll(ctx, contractAddress, contractInfo.IBCPortID, res.Submessages, res.Messages)
@@ -527,7 +818,14 @@ func (k Keeper) reply(ctx sdk.Context, contractAddress sdk.AccAddress, reply was
 }
 
 func (k Keeper) deleteContractSecondIndex(ctx sdk.Context, contractAddress sdk.AccAddress, contractInfo *types.ContractInfo) {
-	ctx.KVStore(k.storeKey).Delete(types.GetContractByCreatedSecondaryIndexKey(contractAddress, contractInfo))
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetContractByCreatedSecondaryIndexKey(contractAddress, contractInfo))
+	if creator := contractInfo.CreatorAddr(); creator != nil {
+		store.Delete(types.GetContractByCreatorSecondaryIndexKey(creator, contractAddress, contractInfo))
+	}
+	if admin := contractInfo.AdminAddr(); admin != nil {
+		store.Delete(types.GetContractByAdminSecondaryIndexKey(admin, contractAddress, contractInfo))
+	}
 }
 
 // UpdateContractAdmin sets the admin value on the ContractInfo. It must be a valid address (use ClearContractAdmin to remove it)
@@ -545,11 +843,138 @@ func (k Keeper) setContractAdmin(ctx sdk.Context, contractAddress, caller, newAd
 	if contractInfo == nil {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
 	}
-	if !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
+	if !authZ.CanModifyContract(ctx, contractInfo.AdminAddr(), caller) {
 		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not modify contract")
 	}
+	k.changeContractAdmin(ctx, contractAddress, contractInfo, newAdmin)
+	return nil
+}
+
+// changeContractAdmin sets the new admin and drops any pending admin
+func (k Keeper) changeContractAdmin(ctx sdk.Context, contractAddress sdk.AccAddress, contractInfo *types.ContractInfo, newAdmin sdk.AccAddress) {
+	k.deleteContractSecondIndex(ctx, contractAddress, contractInfo)
 	contractInfo.Admin = newAdmin.String()
+	contractInfo.PendingAdmin = ""
 	k.storeContractInfo(ctx, contractAddress, contractInfo)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUpdateAdmin,
+		sdk.NewAttribute(types.AttributeKeyContractAddrReserved, contractAddress.String()),
+		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(contractInfo.CodeID, 10)),
+		sdk.NewAttribute(types.AttributeKeyNewAdmin, contractInfo.Admin),
+	))
+}
+
+// ProposeContractAdmin records the pending admin of a contract. The current admin stays in charge until the
+// pending admin accepts with AcceptContractAdmin. A former pending admin is replaced.
+func (k Keeper) ProposeContractAdmin(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, pendingAdmin sdk.AccAddress) error {
+	return k.setPendingContractAdmin(ctx, contractAddress, caller, pendingAdmin, k.authZPolicy)
+}
+
+// CancelProposedContractAdmin removes the pending admin of a contract
+func (k Keeper) CancelProposedContractAdmin(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress) error {
+	return k.setPendingContractAdmin(ctx, contractAddress, caller, nil, k.authZPolicy)
+}
+
+func (k Keeper) setPendingContractAdmin(ctx sdk.Context, contractAddress, caller, pendingAdmin sdk.AccAddress, authZ AuthorizationPolicy) error {
+	contractInfo := k.GetContractInfo(ctx, contractAddress)
+	if contractInfo == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
+	}
+	if !authZ.CanModifyContract(ctx, contractInfo.AdminAddr(), caller) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not modify contract")
+	}
+	eventType := types.EventTypeProposeAdmin
+	if pendingAdmin == nil {
+		if contractInfo.PendingAdmin == "" {
+			return sdkerrors.Wrap(types.ErrNotFound, "pending admin")
+		}
+		eventType = types.EventTypeCancelProposedAdmin
+	}
+	contractInfo.PendingAdmin = pendingAdmin.String()
+	k.storeContractInfo(ctx, contractAddress, contractInfo)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(types.AttributeKeyContractAddrReserved, contractAddress.String()),
+		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(contractInfo.CodeID, 10)),
+		sdk.NewAttribute(types.AttributeKeyPendingAdmin, contractInfo.PendingAdmin),
+	))
+	return nil
+}
+
+// UpdateMigrationAllowlist sets the code ids and code creators that the contract can be migrated to.
+// Once an allowlist is set the admin can only remove entries. Governance can replace or remove the list.
+func (k Keeper) UpdateMigrationAllowlist(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, allowlist types.MigrationAllowlist) error {
+	return k.setMigrationAllowlist(ctx, contractAddress, caller, &allowlist, k.authZPolicy)
+}
+
+func (k Keeper) setMigrationAllowlist(ctx sdk.Context, contractAddress, caller sdk.AccAddress, allowlist *types.MigrationAllowlist, authZ AuthorizationPolicy) error {
+	contractInfo := k.GetContractInfo(ctx, contractAddress)
+	if contractInfo == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
+	}
+	if !authZ.CanModifyContract(ctx, contractInfo.AdminAddr(), caller) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not modify contract")
+	}
+	if current := contractInfo.MigrationAllowlist; current != nil && !authZ.CanIgnoreMigrationAllowlist(ctx) &&
+		(allowlist == nil || !allowlist.IsSubsetOf(*current)) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can only remove entries from migration allowlist")
+	}
+	contractInfo.MigrationAllowlist = allowlist
+	k.storeContractInfo(ctx, contractAddress, contractInfo)
+
+	var allowlistAttr string
+	if allowlist != nil {
+		allowlistAttr = allowlist.String()
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUpdateMigrationAllowlist,
+		sdk.NewAttribute(types.AttributeKeyContractAddrReserved, contractAddress.String()),
+		sdk.NewAttribute(types.AttributeKeyMigrationAllowlist, allowlistAttr),
+	))
+	return nil
+}
+
+// AcceptContractAdmin completes the admin transfer. Only the pending admin is authorized.
+func (k Keeper) AcceptContractAdmin(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress) error {
+	contractInfo := k.GetContractInfo(ctx, contractAddress)
+	if contractInfo == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
+	}
+	pendingAdmin := contractInfo.PendingAdminAddr()
+	if pendingAdmin.Empty() || !pendingAdmin.Equals(caller) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "not the pending admin")
+	}
+	k.changeContractAdmin(ctx, contractAddress, contractInfo, pendingAdmin)
+	return nil
+}
+
+// PauseContract blocks any execution of the contract until it is unpaused. Queries are not affected.
+func (k Keeper) PauseContract(ctx sdk.Context, contractAddress sdk.AccAddress) error {
+	return k.setContractPaused(ctx, contractAddress, true)
+}
+
+// UnpauseContract resumes a paused contract.
+func (k Keeper) UnpauseContract(ctx sdk.Context, contractAddress sdk.AccAddress) error {
+	return k.setContractPaused(ctx, contractAddress, false)
+}
+
+func (k Keeper) setContractPaused(ctx sdk.Context, contractAddress sdk.AccAddress, paused bool) error {
+	contractInfo := k.GetContractInfo(ctx, contractAddress)
+	if contractInfo == nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "contract")
+	}
+	contractInfo.Paused = paused
+	k.storeContractInfo(ctx, contractAddress, contractInfo)
+
+	eventType := types.EventTypeUnpauseContract
+	if paused {
+		eventType = types.EventTypePauseContract
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyContract, contractAddress.String()),
+	))
 	return nil
 }
 
@@ -589,15 +1014,15 @@ func (k Keeper) QuerySmart(ctx sdk.Context, contractAddr sdk.AccAddress, req []b
 		return nil, err
 	}
 	if !k.IsPinnedCode(ctx, contractInfo.CodeID) {
-		ctx.GasMeter().ConsumeGas(InstanceCost, "Loading CosmWasm module: query")
+		ctx.GasMeter().ConsumeGas(k.GetInstanceCost(ctx), "Loading CosmWasm module: query")
 	}
 
 	// prepare querier
-	querier := NewQueryHandler(ctx, k.wasmVMQueryHandler, contractAddr)
+	querier := NewQueryHandler(ctx, k.wasmVMQueryHandler, contractAddr, k.GetGasMultiplier(ctx))
 
 	env := types.NewEnv(ctx, contractAddr)
-	queryResult, gasUsed, qErr := k.wasmVM.Query(codeInfo.CodeHash, env, req, prefixStore, cosmwasmAPI, querier, gasMeter(ctx), gasForContract(ctx))
-	consumeGas(ctx, gasUsed)
+	queryResult, gasUsed, qErr := k.wasmVM.Query(codeInfo.CodeHash, env, req, prefixStore, k.cosmwasmAPI(ctx), querier, k.gasMeter(ctx), k.gasForContract(ctx))
+	k.consumeGas(ctx, gasUsed)
 	if qErr != nil {
 		return nil, sdkerrors.Wrap(types.ErrQueryFailed, qErr.Error())
 	}
@@ -615,25 +1040,23 @@ func (k Keeper) QueryRaw(ctx sdk.Context, contractAddress sdk.AccAddress, key []
 	return prefixStore.Get(key)
 }
 
-func (k Keeper) contractInstance(ctx sdk.Context, contractAddress sdk.AccAddress) (types.ContractInfo, types.CodeInfo, prefix.Store, error) {
+func (k Keeper) contractInstance(ctx sdk.Context, contractAddress sdk.AccAddress) (types.ContractInfo, types.CodeInfo, sdk.KVStore, error) {
 	store := ctx.KVStore(k.storeKey)
 
 	contractBz := store.Get(types.GetContractAddressKey(contractAddress))
 	if contractBz == nil {
-		return types.ContractInfo{}, types.CodeInfo{}, prefix.Store{}, sdkerrors.Wrap(types.ErrNotFound, "contract")
+		return types.ContractInfo{}, types.CodeInfo{}, nil, sdkerrors.Wrap(types.ErrNotFound, "contract")
 	}
 	var contractInfo types.ContractInfo
 	k.cdc.MustUnmarshalBinaryBare(contractBz, &contractInfo)
 
 	codeInfoBz := store.Get(types.GetCodeKey(contractInfo.CodeID))
 	if codeInfoBz == nil {
-		return contractInfo, types.CodeInfo{}, prefix.Store{}, sdkerrors.Wrap(types.ErrNotFound, "code info")
+		return contractInfo, types.CodeInfo{}, nil, sdkerrors.Wrap(types.ErrNotFound, "code info")
 	}
 	var codeInfo types.CodeInfo
 	k.cdc.MustUnmarshalBinaryBare(codeInfoBz, &codeInfo)
-	prefixStoreKey := types.GetContractStorePrefix(contractAddress)
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), prefixStoreKey)
-	return contractInfo, codeInfo, prefixStore, nil
+	return contractInfo, codeInfo, k.contractStore(ctx, contractAddress, contractInfo.CodeID), nil
 }
 
 func (k Keeper) GetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) *types.ContractInfo {
@@ -656,6 +1079,12 @@ func (k Keeper) storeContractInfo(ctx sdk.Context, contractAddress sdk.AccAddres
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.GetContractAddressKey(contractAddress), k.cdc.MustMarshalBinaryBare(contract))
 	store.Set(types.GetContractByCreatedSecondaryIndexKey(contractAddress, contract), []byte{})
+	if creator := contract.CreatorAddr(); creator != nil {
+		store.Set(types.GetContractByCreatorSecondaryIndexKey(creator, contractAddress, contract), []byte{})
+	}
+	if admin := contract.AdminAddr(); admin != nil {
+		store.Set(types.GetContractByAdminSecondaryIndexKey(admin, contractAddress, contract), []byte{})
+	}
 }
 
 func (k Keeper) IterateContractInfo(ctx sdk.Context, cb func(sdk.AccAddress, types.ContractInfo) bool) {
@@ -678,6 +1107,8 @@ func (k Keeper) GetContractState(ctx sdk.Context, contractAddress sdk.AccAddress
 }
 
 func (k Keeper) importContractState(ctx sdk.Context, contractAddress sdk.AccAddress, models []types.Model) error {
+	// keep the stats in sync with the store, also when the import fails
+	defer k.updateContractStorageStats(ctx, contractAddress)
 	prefixStoreKey := types.GetContractStorePrefix(contractAddress)
 	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), prefixStoreKey)
 	for _, model := range models {
@@ -734,10 +1165,22 @@ func (k Keeper) GetByteCode(ctx sdk.Context, codeID uint64) ([]byte, error) {
 
 // PinCode pins the wasm contract in wasmvm cache
 func (k Keeper) PinCode(ctx sdk.Context, codeID uint64) error {
+	return k.PinCodeWithActor(ctx, codeID, nil)
+}
+
+// PinCodeWithActor pins the wasm contract in wasmvm cache. The actor is passed to the authorization policy.
+func (k Keeper) PinCodeWithActor(ctx sdk.Context, codeID uint64, actor sdk.AccAddress) error {
+	return k.pinCode(ctx, codeID, actor, k.authZPolicy)
+}
+
+func (k Keeper) pinCode(ctx sdk.Context, codeID uint64, actor sdk.AccAddress, authZ AuthorizationPolicy) error {
 	codeInfo := k.GetCodeInfo(ctx, codeID)
 	if codeInfo == nil {
 		return sdkerrors.Wrap(types.ErrNotFound, "code info")
 	}
+	if !authZ.CanPinCode(ctx, codeID, actor) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not pin code")
+	}
 
 	if err := k.wasmVM.Pin(codeInfo.CodeHash); err != nil {
 		return sdkerrors.Wrap(types.ErrPinContractFailed, err.Error())
@@ -745,21 +1188,43 @@ func (k Keeper) PinCode(ctx sdk.Context, codeID uint64) error {
 	store := ctx.KVStore(k.storeKey)
 	// store 1 byte to not run into `nil` debugging issues
 	store.Set(types.GetPinnedCodeIndexPrefix(codeID), []byte{1})
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypePinCode,
+		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(codeID, 10)),
+	))
 	return nil
 }
 
 // UnpinCode removes the wasm contract from wasmvm cache
 func (k Keeper) UnpinCode(ctx sdk.Context, codeID uint64) error {
+	return k.UnpinCodeWithActor(ctx, codeID, nil)
+}
+
+// UnpinCodeWithActor removes the wasm contract from wasmvm cache. The actor is passed to the authorization policy.
+func (k Keeper) UnpinCodeWithActor(ctx sdk.Context, codeID uint64, actor sdk.AccAddress) error {
+	return k.unpinCode(ctx, codeID, actor, k.authZPolicy)
+}
+
+func (k Keeper) unpinCode(ctx sdk.Context, codeID uint64, actor sdk.AccAddress, authZ AuthorizationPolicy) error {
 	codeInfo := k.GetCodeInfo(ctx, codeID)
 	if codeInfo == nil {
 		return sdkerrors.Wrap(types.ErrNotFound, "code info")
 	}
+	if !authZ.CanPinCode(ctx, codeID, actor) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not unpin code")
+	}
 	if err := k.wasmVM.Unpin(codeInfo.CodeHash); err != nil {
 		return sdkerrors.Wrap(types.ErrUnpinContractFailed, err.Error())
 	}
 
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetPinnedCodeIndexPrefix(codeID))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUnpinCode,
+		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(codeID, 10)),
+	))
 	return nil
 }
 
@@ -769,12 +1234,150 @@ func (k Keeper) IsPinnedCode(ctx sdk.Context, codeID uint64) bool {
 	return store.Has(types.GetPinnedCodeIndexPrefix(codeID))
 }
 
-// InitializePinnedCodes updates wasmvm to pin to cache all contracts marked as pinned
+// DeleteCode removes the code info when the code is neither pinned nor used by any contract. Only the
+// creator of the code is authorized.
+func (k Keeper) DeleteCode(ctx sdk.Context, codeID uint64, caller sdk.AccAddress) error {
+	return k.deleteCode(ctx, codeID, caller, k.authZPolicy)
+}
+
+func (k Keeper) deleteCode(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, authZ AuthorizationPolicy) error {
+	codeInfo := k.GetCodeInfo(ctx, codeID)
+	if codeInfo == nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "code info")
+	}
+	creator, err := sdk.AccAddressFromBech32(codeInfo.Creator)
+	if err != nil {
+		return sdkerrors.Wrap(err, "creator")
+	}
+	if !authZ.CanDeleteCode(ctx, creator, caller) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not delete code")
+	}
+	if k.IsPinnedCode(ctx, codeID) {
+		return sdkerrors.Wrap(types.ErrCodeInUse, "pinned")
+	}
+	if k.hasContractsForCode(ctx, codeID) {
+		return sdkerrors.Wrap(types.ErrCodeInUse, "referenced by contracts")
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetCodeKey(codeID))
+	store.Delete(types.GetCodeByChecksumIndexKey(codeInfo.CodeHash, codeID))
+	// the wasmvm artifacts are dropped in the end blocker so that nothing is removed from disk for a
+	// reverted or simulated transaction
+	store.Set(types.GetPendingCodeRemovalKey(codeInfo.CodeHash), []byte{1})
+	return nil
+}
+
+// UpdateInstantiateConfig sets the instantiate permission of a code. Only the creator of the code is authorized.
+func (k Keeper) UpdateInstantiateConfig(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, newConfig types.AccessConfig) error {
+	return k.setInstantiateConfig(ctx, codeID, caller, newConfig, k.authZPolicy)
+}
+
+func (k Keeper) setInstantiateConfig(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, newConfig types.AccessConfig, authZ AuthorizationPolicy) error {
+	codeInfo := k.GetCodeInfo(ctx, codeID)
+	if codeInfo == nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "code info")
+	}
+	creator, err := sdk.AccAddressFromBech32(codeInfo.Creator)
+	if err != nil {
+		return sdkerrors.Wrap(err, "creator")
+	}
+	if !authZ.CanUpdateInstantiateConfig(ctx, creator, caller) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not update instantiate config")
+	}
+	if err := k.requireContractOfAccessConfig(ctx, newConfig); err != nil {
+		return err
+	}
+	codeInfo.InstantiateConfig = newConfig
+	k.storeCodeInfo(ctx, codeID, *codeInfo)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUpdateInstantiateConfig,
+		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(codeID, 10)),
+		sdk.NewAttribute(types.AttributeKeyInstantiatePermission, newConfig.String()),
+	))
+	return nil
+}
+
+func (k Keeper) hasContractsForCode(ctx sdk.Context, codeID uint64) bool {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetContractByCodeIDSecondaryIndexPrefix(codeID))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	return iter.Valid()
+}
+
+func (k Keeper) hasCodesForChecksum(ctx sdk.Context, checksum []byte) bool {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetCodeByChecksumIndexPrefix(checksum))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	return iter.Valid()
+}
+
+// RemoveDeletedCodes unpins and removes the wasmvm artifacts of the codes deleted within the current block.
+// Artifacts that are still used by another code id with the same checksum are kept.
+func (k Keeper) RemoveDeletedCodes(ctx sdk.Context) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingCodeRemovalPrefix)
+	var checksums [][]byte
+	iter := prefixStore.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		checksums = append(checksums, append([]byte{}, iter.Key()...))
+	}
+	iter.Close()
+	if len(checksums) == 0 {
+		return
+	}
+
+	for _, checksum := range checksums {
+		prefixStore.Delete(checksum)
+		if k.hasCodesForChecksum(ctx, checksum) {
+			continue
+		}
+		// failures are not fatal as the code is not referenced by the chain state anymore
+		if err := k.wasmVM.Unpin(checksum); err != nil {
+			k.Logger(ctx).Error("unpin deleted code", "checksum", fmt.Sprintf("%X", checksum), "err", err)
+		}
+		k.removeCode(ctx, checksum)
+	}
+}
+
+// removeCode deletes the code artifacts when the engine supports it. Any failure, including a panic,
+// is logged only so that the end blocker is not affected by the local file system.
+func (k Keeper) removeCode(ctx sdk.Context, checksum []byte) {
+	remover, ok := k.wasmVM.(types.CodeRemover)
+	if !ok {
+		return
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			k.Logger(ctx).Error("remove deleted code", "checksum", fmt.Sprintf("%X", checksum), "panic", r)
+		}
+	}()
+	if err := remover.RemoveCode(checksum); err != nil {
+		k.Logger(ctx).Error("remove deleted code", "checksum", fmt.Sprintf("%X", checksum), "err", err)
+	}
+}
+
+// memoryLimitSetter is implemented by wasm engines that can apply the contract memory limit param
+type memoryLimitSetter interface {
+	// MemoryLimit returns the memory limit of each contract execution (in MiB)
+	MemoryLimit() uint32
+	// SetMemoryLimit sets the memory limit of each contract execution (in MiB). The pinned codes must be pinned again.
+	SetMemoryLimit(memoryLimit uint32) error
+}
+
+// InitializePinnedCodes applies the contract memory limit from the params and updates wasmvm to pin to cache
+// all contracts marked as pinned. It is called on node start.
 func (k Keeper) InitializePinnedCodes(ctx sdk.Context) error {
+	if s, ok := k.wasmVM.(memoryLimitSetter); ok {
+		if err := s.SetMemoryLimit(k.GetContractMemoryLimit(ctx)); err != nil {
+			return sdkerrors.Wrap(types.ErrInvalid, err.Error())
+		}
+	}
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PinnedCodeIndexPrefix)
 	iter := store.Iterator(nil, nil)
+	defer iter.Close()
 	for ; iter.Valid(); iter.Next() {
-		codeInfo := k.GetCodeInfo(ctx, types.ParsePinnedCodeIndex(iter.Value()))
+		codeInfo := k.GetCodeInfo(ctx, types.ParsePinnedCodeIndex(iter.Key()))
 		if codeInfo == nil {
 			return sdkerrors.Wrap(types.ErrNotFound, "code info")
 		}
@@ -785,26 +1388,25 @@ func (k Keeper) InitializePinnedCodes(ctx sdk.Context) error {
 	return nil
 }
 
-func (k Keeper) dispatchAll(ctx sdk.Context, contractAddr sdk.AccAddress, ibcPort string, subMsgs []wasmvmtypes.SubMsg, msgs []wasmvmtypes.CosmosMsg) error {
-	// first dispatch all submessages (and the replies).
-	err := k.dispatchSubmessages(ctx, contractAddr, ibcPort, subMsgs)
-	if err != nil {
-		return err
+// UpdateContractMemoryLimit applies a changed contract memory limit param at the end of the block in which it was
+// changed, so that all nodes, including restarted ones, execute the next block with the same limit. The pinned
+// codes are pinned again as the VM cache is dropped.
+func (k Keeper) UpdateContractMemoryLimit(ctx sdk.Context) {
+	s, ok := k.wasmVM.(memoryLimitSetter)
+	if !ok || s.MemoryLimit() == k.GetContractMemoryLimit(ctx) {
+		return
+	}
+	if err := k.InitializePinnedCodes(ctx); err != nil {
+		panic(err)
 	}
-	// then dispatch all the normal messages
-	return k.dispatchMessages(ctx, contractAddr, ibcPort, msgs)
 }
 
-func (k Keeper) dispatchMessages(ctx sdk.Context, contractAddr sdk.AccAddress, ibcPort string, msgs []wasmvmtypes.CosmosMsg) error {
-	for _, msg := range msgs {
-		events, _, err := k.messenger.DispatchMsg(ctx, contractAddr, ibcPort, msg)
-		if err != nil {
-			return err
-		}
-		// redispatch all events, (type sdk.EventTypeMessage will be filtered out in the handler)
-		ctx.EventManager().EmitEvents(events)
-	}
-	return nil
+// dispatchAll dispatches the messages of the legacy response format, where all submessages (and their replies) are
+// executed before the normal messages.
+func (k Keeper) dispatchAll(ctx sdk.Context, contractAddr sdk.AccAddress, ibcPort string, subMsgs []wasmvmtypes.SubMsg, msgs []wasmvmtypes.CosmosMsg) error {
+	// the message responses can not be passed to the contract with the wasmvm version in use
+	_, err := k.dispatchSubmessages(ctx, contractAddr, ibcPort, types.NewOrderedMsgs(subMsgs, msgs))
+	return err
 }
 
 func (k Keeper) dispatchMsgWithGasLimit(ctx sdk.Context, contractAddr sdk.AccAddress, ibcPort string, msg wasmvmtypes.CosmosMsg, gasLimit uint64) (events []sdk.Event, data [][]byte, err error) {
@@ -833,10 +1435,16 @@ func (k Keeper) dispatchMsgWithGasLimit(ctx sdk.Context, contractAddr sdk.AccAdd
 	return events, data, err
 }
 
-// dispatchSubmessages builds a sandbox to execute these messages and returns the execution result to the contract
-// that dispatched them, both on success as well as failure
-func (k Keeper) dispatchSubmessages(ctx sdk.Context, contractAddr sdk.AccAddress, ibcPort string, msgs []wasmvmtypes.SubMsg) error {
+// dispatchSubmessages builds a sandbox to execute these messages strictly in the given order and returns the
+// execution result to the contract that dispatched them, depending on the reply mode of the submessage. Failures of
+// submessages without a reply on error abort the execution. The data of all successful message executions is
+// returned in order.
+func (k Keeper) dispatchSubmessages(ctx sdk.Context, contractAddr sdk.AccAddress, ibcPort string, msgs []types.SubMsg) ([][]byte, error) {
+	var msgResponses [][]byte
 	for _, msg := range msgs {
+		if err := msg.ReplyOn.ValidateBasic(); err != nil {
+			return nil, err
+		}
 		// first, we build a sub-context which we can use inside the submessages
 		subCtx, commit := ctx.CacheContext()
 
@@ -856,14 +1464,23 @@ func (k Keeper) dispatchSubmessages(ctx sdk.Context, contractAddr sdk.AccAddress
 		// if it succeeds, commit state changes from submessage, and pass on events to Event Manager
 		if err == nil {
 			commit()
+			// the sandbox has its own event manager, events can be emitted there or returned by the handler
+			events = append(subCtx.EventManager().Events(), events...)
+			// redispatch all events, (type sdk.EventTypeMessage will be filtered out in the handler)
 			ctx.EventManager().EmitEvents(events)
+			msgResponses = append(msgResponses, data...)
 		}
 		// on failure, revert state from sandbox, and ignore events (just skip doing the above)
 
 		var result wasmvmtypes.SubcallResult
-		if err == nil {
-			// just take the first one for now if there are multiple sub-sdk messages
-			// and safely return nothing if no data
+		switch {
+		case err != nil && !msg.ReplyOn.OnError():
+			return nil, err
+		case err == nil && !msg.ReplyOn.OnSuccess():
+			continue
+		case err == nil:
+			// the reply can hold a single data element only, so we pass the first one and safely return
+			// nothing if no data
 			var responseData []byte
 			if len(data) > 0 {
 				responseData = data[0]
@@ -874,9 +1491,9 @@ func (k Keeper) dispatchSubmessages(ctx sdk.Context, contractAddr sdk.AccAddress
 					Data:   responseData,
 				},
 			}
-		} else {
+		default:
 			result = wasmvmtypes.SubcallResult{
-				Err: err.Error(),
+				Err: redactError(err),
 			}
 		}
 
@@ -890,9 +1507,32 @@ func (k Keeper) dispatchSubmessages(ctx sdk.Context, contractAddr sdk.AccAddress
 		// and the events are already in the ctx.EventManager()
 		_, err = k.reply(ctx, contractAddr, reply)
 		if err != nil {
-			return err
+			return nil, sdkerrors.Wrapf(err, "sub message %d", msg.ID)
 		}
 	}
+	return msgResponses, nil
+}
+
+// redactError returns a representation of the error that is deterministic across nodes. Error messages can contain
+// node specific details like file paths or gas values so that only the ABCI codespace and code are kept.
+func redactError(err error) string {
+	codespace, code, _ := sdkerrors.ABCIInfo(err, false)
+	return fmt.Sprintf("codespace: %s, code: %d", codespace, code)
+}
+
+// emitContractEvents emits the `wasm` event with the contract attributes and a `wasm-<type>` event for each custom
+// event of the contract. The contract responses of the wasmvm version in use do not contain custom events yet.
+func emitContractEvents(ctx sdk.Context, contractAddr sdk.AccAddress, attrs []wasmvmtypes.EventAttribute, evts []wasmvmtypes.Event) error {
+	events, err := types.ParseEvents(attrs, contractAddr)
+	if err != nil {
+		return err
+	}
+	customEvents, err := types.NewCustomEvents(evts, contractAddr)
+	if err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvents(events)
+	ctx.EventManager().EmitEvents(customEvents)
 	return nil
 }
 
@@ -918,20 +1558,20 @@ func sdkAttributesToWasmVmAttributes(attrs []abci.EventAttribute) []wasmvmtypes.
 	return res
 }
 
-func gasForContract(ctx sdk.Context) uint64 {
+func (k Keeper) gasForContract(ctx sdk.Context) uint64 {
 	meter := ctx.GasMeter()
 	if meter.IsOutOfGas() {
 		return 0
 	}
-	remaining := (meter.Limit() - meter.GasConsumedToLimit()) * GasMultiplier
+	remaining := (meter.Limit() - meter.GasConsumedToLimit()) * k.GetGasMultiplier(ctx)
 	if remaining > MaxGas {
 		return MaxGas
 	}
 	return remaining
 }
 
-func consumeGas(ctx sdk.Context, gas uint64) {
-	consumed := gas / GasMultiplier
+func (k Keeper) consumeGas(ctx sdk.Context, gas uint64) {
+	consumed := gas / k.GetGasMultiplier(ctx)
 	ctx.GasMeter().ConsumeGas(consumed, "wasm contract")
 	// throw OutOfGas error if we ran out (got exactly to zero due to better limit enforcing)
 	if ctx.GasMeter().IsOutOfGas() {
@@ -939,6 +1579,30 @@ func consumeGas(ctx sdk.Context, gas uint64) {
 	}
 }
 
+// addressGenerator abstract address generator to be used for a single contract address
+type addressGenerator func(ctx sdk.Context, codeID uint64, checksum []byte) sdk.AccAddress
+
+// classicAddressGenerator generates a contract address using codeID and instanceID sequence
+func (k Keeper) classicAddressGenerator() addressGenerator {
+	return func(ctx sdk.Context, codeID uint64, _ []byte) sdk.AccAddress {
+		return k.generateContractAddress(ctx, codeID)
+	}
+}
+
+// predictableAddressGenerator generates a contract address from code checksum, creator and salt.
+// The sequence is not touched so that the address does not depend on chain state.
+func predictableAddressGenerator(creator sdk.AccAddress, salt []byte) addressGenerator {
+	return func(_ sdk.Context, _ uint64, checksum []byte) sdk.AccAddress {
+		return types.BuildContractAddressPredictable(checksum, creator, salt)
+	}
+}
+
+// isUnusedBaseAccount returns true for plain accounts without any signing history
+func isUnusedBaseAccount(acc authtypes.AccountI) bool {
+	baseAcc, ok := acc.(*authtypes.BaseAccount)
+	return ok && baseAcc.GetPubKey() == nil && baseAcc.GetSequence() == 0
+}
+
 // generates a contract address from codeID + instanceID
 func (k Keeper) generateContractAddress(ctx sdk.Context, codeID uint64) sdk.AccAddress {
 	instanceID := k.autoIncrementID(ctx, types.KeyLastInstanceID)
@@ -1020,20 +1684,22 @@ func addrFromUint64(id uint64) sdk.AccAddress {
 	return sdk.AccAddress(crypto.AddressHash(addr))
 }
 
-// MultipliedGasMeter wraps the GasMeter from context and multiplies all reads by out defined multiplier
+// MultipliedGasMeter wraps the GasMeter from context and multiplies all reads by the gas multiplier
 type MultipliedGasMeter struct {
 	originalMeter sdk.GasMeter
+	multiplier    uint64
 }
 
 var _ wasmvm.GasMeter = MultipliedGasMeter{}
 
 func (m MultipliedGasMeter) GasConsumed() sdk.Gas {
-	return m.originalMeter.GasConsumed() * GasMultiplier
+	return m.originalMeter.GasConsumed() * m.multiplier
 }
 
-func gasMeter(ctx sdk.Context) MultipliedGasMeter {
+func (k Keeper) gasMeter(ctx sdk.Context) MultipliedGasMeter {
 	return MultipliedGasMeter{
 		originalMeter: ctx.GasMeter(),
+		multiplier:    k.GetGasMultiplier(ctx),
 	}
 }
 
@@ -1069,3 +1735,16 @@ func (c CoinTransferrer) TransferCoins(ctx sdk.Context, fromAddr sdk.AccAddress,
 	}
 	return nil
 }
+
+// LockStorageDeposit moves the coins from the account to the wasm module account
+func (c CoinTransferrer) LockStorageDeposit(ctx sdk.Context, fromAddr sdk.AccAddress, amt sdk.Coins) error {
+	if c.keeper.BlockedAddr(fromAddr) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "blocked address can not be used")
+	}
+	return c.keeper.SendCoinsFromAccountToModule(ctx, fromAddr, types.ModuleName, amt)
+}
+
+// RefundStorageDeposit moves the coins from the wasm module account back to the account
+func (c CoinTransferrer) RefundStorageDeposit(ctx sdk.Context, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	return c.keeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, toAddr, amt)
+}
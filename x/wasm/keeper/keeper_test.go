@@ -9,6 +9,7 @@ import (
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"io/ioutil"
+	"strconv"
 	"testing"
 	"time"
 
@@ -16,6 +17,7 @@ import (
 	stypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -85,6 +87,13 @@ func TestCreateStoresInstantiatePermission(t *testing.T) {
 				CodeUploadAccess:             types.AllowEverybody,
 				InstantiateDefaultPermission: spec.srcPermission,
 				MaxWasmCodeSize:              types.DefaultMaxWasmCodeSize,
+				GasMultiplier:                types.DefaultGasMultiplier,
+				MaxScheduledCallbackGas:      types.DefaultMaxScheduledCallbackGas,
+				ContractMemoryLimit:          types.DefaultContractMemoryLimit,
+				InstanceCost:                 types.DefaultInstanceCost,
+				CompileCost:                  types.DefaultCompileCost,
+				HumanizeCost:                 types.DefaultHumanizeCost,
+				CanonicalizeCost:             types.DefaultCanonicalizeCost,
 			})
 			fundAccounts(t, ctx, accKeeper, bankKeeper, myAddr, deposit)
 
@@ -130,6 +139,13 @@ func TestCreateWithParamPermissions(t *testing.T) {
 			srcPermission: types.AccessTypeOnlyAddress.With(otherAddr),
 			expError:      sdkerrors.ErrUnauthorized,
 		},
+		"anyOfAddresses with matching address": {
+			srcPermission: types.AccessTypeAnyOfAddresses.With(otherAddr, creator),
+		},
+		"anyOfAddresses with non matching address": {
+			srcPermission: types.AccessTypeAnyOfAddresses.With(otherAddr),
+			expError:      sdkerrors.ErrUnauthorized,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -174,6 +190,52 @@ func TestCreateDuplicate(t *testing.T) {
 	require.Equal(t, wasmCode, storedCode)
 }
 
+func TestCreateDeduplicated(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures)
+	accKeeper, keeper, bankKeeper := keepers.AccountKeeper, keepers.WasmKeeper, keepers.BankKeeper
+
+	params := keeper.GetParams(ctx)
+	params.DeduplicateCodeUploads = true
+	keeper.setParams(ctx, params)
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator := createFakeFundedAccount(t, ctx, accKeeper, bankKeeper, deposit)
+	otherCreator := createFakeFundedAccount(t, ctx, accKeeper, bankKeeper, deposit)
+
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+
+	codeID, err := keeper.Create(ctx, creator, wasmCode, "", "", &types.AllowEverybody)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), codeID)
+
+	// same creator, code and permission
+	gasBefore := ctx.GasMeter().GasConsumed()
+	duplicateID, err := keeper.Create(ctx, creator, wasmCode, "", "", &types.AllowEverybody)
+	require.NoError(t, err)
+	assert.Equal(t, codeID, duplicateID)
+	// only the lookup is charged, not the compilation
+	assert.Less(t, ctx.GasMeter().GasConsumed()-gasBefore, keeper.GetCompileCost(ctx)*uint64(len(wasmCode)))
+
+	// same code and permission of another creator
+	otherCreatorID, err := keeper.Create(ctx, otherCreator, wasmCode, "", "", &types.AllowEverybody)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), otherCreatorID)
+
+	// same code with another permission
+	otherID, err := keeper.Create(ctx, creator, wasmCode, "", "", &types.AllowNobody)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), otherID)
+
+	codeInfo := keeper.GetCodeInfo(ctx, codeID)
+	require.NotNil(t, codeInfo)
+	assert.Equal(t, creator.String(), codeInfo.Creator)
+	otherCodeInfo := keeper.GetCodeInfo(ctx, otherCreatorID)
+	require.NotNil(t, otherCodeInfo)
+	assert.Equal(t, otherCreator.String(), otherCodeInfo.Creator)
+	assert.Equal(t, []uint64{1, 2, 3}, keeper.GetCodeIDsByChecksum(ctx, codeInfo.CodeHash))
+}
+
 func TestCreateWithSimulation(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, SupportedFeatures)
 	accKeeper, keeper, bankKeeper := keepers.AccountKeeper, keepers.WasmKeeper, keepers.BankKeeper
@@ -283,7 +345,7 @@ func TestInstantiate(t *testing.T) {
 
 	gasAfter := ctx.GasMeter().GasConsumed()
 	if types.EnableGasVerification {
-		require.Equal(t, uint64(0x11ca8), gasAfter-gasBefore)
+		require.Equal(t, uint64(0x14462), gasAfter-gasBefore)
 	}
 
 	// ensure it is stored properly
@@ -405,6 +467,15 @@ func TestInstantiateWithPermissions(t *testing.T) {
 			srcPermission: types.AccessTypeOnlyAddress.With(otherAddr),
 			expError:      sdkerrors.ErrUnauthorized,
 		},
+		"anyOfAddresses with matching address": {
+			srcPermission: types.AccessTypeAnyOfAddresses.With(otherAddr, myAddr),
+			srcActor:      myAddr,
+		},
+		"anyOfAddresses with non matching address": {
+			srcPermission: types.AccessTypeAnyOfAddresses.With(otherAddr),
+			srcActor:      myAddr,
+			expError:      sdkerrors.ErrUnauthorized,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -421,6 +492,36 @@ func TestInstantiateWithPermissions(t *testing.T) {
 	}
 }
 
+func TestInstantiateWithOnlyContractPermission(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures)
+	k := keepers.WasmKeeper
+	factory := InstantiateHackatomExampleContract(t, ctx, keepers)
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+
+	// the address must belong to a contract
+	nonContract := types.AccessTypeOnlyContract.With(factory.CreatorAddr)
+	_, err = k.Create(ctx, factory.CreatorAddr, wasmCode, "", "", &nonContract)
+	assert.True(t, types.ErrNotFound.Is(err), "got %+v", err)
+
+	onlyFactory := types.AccessTypeOnlyContract.With(factory.Contract)
+	codeID, err := k.Create(ctx, factory.CreatorAddr, wasmCode, "", "", &onlyFactory)
+	require.NoError(t, err)
+	initMsgBz := HackatomExampleInitMsg{Verifier: factory.VerifierAddr, Beneficiary: factory.BeneficiaryAddr}.GetBytes(t)
+
+	// when instantiated by others
+	_, _, err = k.Instantiate(ctx, codeID, factory.CreatorAddr, nil, initMsgBz, "label", nil)
+	assert.True(t, sdkerrors.ErrUnauthorized.Is(err), "got %+v", err)
+
+	// when instantiated by the contract
+	_, _, err = k.Instantiate(ctx, codeID, factory.Contract, nil, initMsgBz, "label", nil)
+	require.NoError(t, err)
+
+	// and the update to a non contract address is rejected
+	err = k.UpdateInstantiateConfig(ctx, codeID, factory.CreatorAddr, nonContract)
+	assert.True(t, types.ErrNotFound.Is(err), "got %+v", err)
+}
+
 func TestInstantiateWithNonExistingCodeID(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, SupportedFeatures)
 	accKeeper, keeper, bankKeeper := keepers.AccountKeeper, keepers.WasmKeeper, keepers.BankKeeper
@@ -455,6 +556,124 @@ func TestInstantiateWithContractDataResponse(t *testing.T) {
 	assert.Equal(t, []byte("my-response-data"), data)
 }
 
+func TestInstantiate2(t *testing.T) {
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+
+	_, _, bob := keyPubAddr()
+	_, _, fred := keyPubAddr()
+	initMsgBz, err := json.Marshal(HackatomExampleInitMsg{Verifier: fred, Beneficiary: bob})
+	require.NoError(t, err)
+
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures)
+	accKeeper, keeper, bankKeeper := keepers.AccountKeeper, keepers.WasmKeeper, keepers.BankKeeper
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator := createFakeFundedAccount(t, ctx, accKeeper, bankKeeper, deposit)
+	otherCreator := createFakeFundedAccount(t, ctx, accKeeper, bankKeeper, deposit)
+
+	codeID, err := keeper.Create(ctx, creator, wasmCode, "", "", nil)
+	require.NoError(t, err)
+	codeInfo := keeper.GetCodeInfo(ctx, codeID)
+	require.NotNil(t, codeInfo)
+	instanceSeqBefore := keeper.peekAutoIncrementID(ctx, types.KeyLastInstanceID)
+
+	// when
+	gotAddr, _, err := keeper.Instantiate2(ctx, codeID, creator, nil, initMsgBz, "my label", nil, []byte("my salt"))
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, types.BuildContractAddressPredictable(codeInfo.CodeHash, creator, []byte("my salt")), gotAddr)
+	assert.NotNil(t, keeper.GetContractInfo(ctx, gotAddr))
+	assert.Equal(t, instanceSeqBefore, keeper.peekAutoIncrementID(ctx, types.KeyLastInstanceID), "sequence must not be used")
+
+	specs := map[string]struct {
+		creator sdk.AccAddress
+		salt    []byte
+		expErr  *sdkerrors.Error
+	}{
+		"same creator and salt": {
+			creator: creator,
+			salt:    []byte("my salt"),
+			expErr:  types.ErrDuplicate,
+		},
+		"same creator, other salt": {
+			creator: creator,
+			salt:    []byte("other salt"),
+		},
+		"other creator, same salt": {
+			creator: otherCreator,
+			salt:    []byte("my salt"),
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			addr, _, err := keeper.Instantiate2(ctx, codeID, spec.creator, nil, initMsgBz, "my label", nil, spec.salt)
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(err), "got %+v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotEqual(t, gotAddr, addr)
+			assert.Equal(t, types.BuildContractAddressPredictable(codeInfo.CodeHash, spec.creator, spec.salt), addr)
+		})
+	}
+}
+
+func TestInstantiate2WithPrefundedAddress(t *testing.T) {
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+
+	_, _, bob := keyPubAddr()
+	_, _, fred := keyPubAddr()
+	initMsgBz, err := json.Marshal(HackatomExampleInitMsg{Verifier: fred, Beneficiary: bob})
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		setupAccount func(ctx sdk.Context, addr sdk.AccAddress, accKeeper authkeeper.AccountKeeper)
+		expErr       *sdkerrors.Error
+	}{
+		"unused base account": {
+			setupAccount: func(ctx sdk.Context, addr sdk.AccAddress, accKeeper authkeeper.AccountKeeper) {},
+		},
+		"base account with sequence": {
+			setupAccount: func(ctx sdk.Context, addr sdk.AccAddress, accKeeper authkeeper.AccountKeeper) {
+				acc := accKeeper.GetAccount(ctx, addr)
+				require.NoError(t, acc.SetSequence(1))
+				accKeeper.SetAccount(ctx, acc)
+			},
+			expErr: types.ErrAccountExists,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, SupportedFeatures)
+			accKeeper, keeper, bankKeeper := keepers.AccountKeeper, keepers.WasmKeeper, keepers.BankKeeper
+			deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100))
+			creator := createFakeFundedAccount(t, ctx, accKeeper, bankKeeper, deposit)
+			codeID, err := keeper.Create(ctx, creator, wasmCode, "", "", nil)
+			require.NoError(t, err)
+
+			salt := []byte("my salt")
+			expAddr := types.BuildContractAddressPredictable(keeper.GetCodeInfo(ctx, codeID).CodeHash, creator, salt)
+			fundAccounts(t, ctx, accKeeper, bankKeeper, expAddr, deposit)
+			spec.setupAccount(ctx, expAddr, accKeeper)
+
+			// when
+			gotAddr, _, err := keeper.Instantiate2(ctx, codeID, creator, nil, initMsgBz, "my label", nil, salt)
+
+			// then
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(err), "got %+v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, expAddr, gotAddr)
+			assert.Equal(t, deposit, bankKeeper.GetAllBalances(ctx, gotAddr))
+		})
+	}
+}
+
 func TestExecute(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, SupportedFeatures)
 	accKeeper, keeper, bankKeeper := keepers.AccountKeeper, keepers.WasmKeeper, keepers.BankKeeper
@@ -516,7 +735,7 @@ func TestExecute(t *testing.T) {
 	// make sure gas is properly deducted from ctx
 	gasAfter := ctx.GasMeter().GasConsumed()
 	if types.EnableGasVerification {
-		require.Equal(t, uint64(0x12963), gasAfter-gasBefore)
+		require.Equal(t, uint64(0x12af5), gasAfter-gasBefore)
 	}
 	// ensure bob now exists and got both payments released
 	bobAcct = accKeeper.GetAccount(ctx, bob)
@@ -1005,6 +1224,13 @@ func TestMigrateWithDispatchedMessage(t *testing.T) {
 	assert.Equal(t, "", res.Log)
 	type dict map[string]interface{}
 	expEvents := []dict{
+		{
+			"Type": "migrate",
+			"Attr": []dict{
+				{"_contract_address": contractAddr},
+				{"code_id": "2"},
+			},
+		},
 		{
 			"Type": "wasm",
 			"Attr": []dict{
@@ -1106,7 +1332,8 @@ func TestSudo(t *testing.T) {
 	sudoMsg, err := json.Marshal(msg)
 	require.NoError(t, err)
 
-	res, err := keeper.Sudo(ctx, addr, sudoMsg)
+	em := sdk.NewEventManager()
+	res, err := keeper.Sudo(ctx.WithEventManager(em), addr, sudoMsg)
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
@@ -1115,6 +1342,69 @@ func TestSudo(t *testing.T) {
 	require.NotNil(t, comAcct)
 	balance := bankKeeper.GetBalance(ctx, comAcct.GetAddress(), "denom")
 	assert.Equal(t, sdk.NewInt64Coin("denom", 76543), balance)
+	// and the keeper event is emitted
+	expEvent := sdk.NewEvent("sudo",
+		sdk.NewAttribute("_contract_address", addr.String()),
+		sdk.NewAttribute("code_id", strconv.FormatUint(contractID, 10)),
+	)
+	assert.Equal(t, expEvent, em.Events()[0])
+}
+
+func TestEmitContractEvents(t *testing.T) {
+	contractAddr := RandomAccountAddress(t)
+	specs := map[string]struct {
+		attrs     []wasmvmtypes.EventAttribute
+		evts      []wasmvmtypes.Event
+		expEvents sdk.Events
+		expErr    bool
+	}{
+		"attributes only": {
+			attrs: []wasmvmtypes.EventAttribute{{Key: "foo", Value: "bar"}},
+			expEvents: sdk.Events{sdk.NewEvent("wasm",
+				sdk.NewAttribute("contract_address", contractAddr.String()),
+				sdk.NewAttribute("foo", "bar"),
+			)},
+		},
+		"with custom events": {
+			attrs: []wasmvmtypes.EventAttribute{{Key: "foo", Value: "bar"}},
+			evts: []wasmvmtypes.Event{{
+				Type:       "transfer",
+				Attributes: []wasmvmtypes.EventAttribute{{Key: "token_id", Value: "1"}},
+			}},
+			expEvents: sdk.Events{
+				sdk.NewEvent("wasm",
+					sdk.NewAttribute("contract_address", contractAddr.String()),
+					sdk.NewAttribute("foo", "bar"),
+				),
+				sdk.NewEvent("wasm-transfer",
+					sdk.NewAttribute("_contract_address", contractAddr.String()),
+					sdk.NewAttribute("token_id", "1"),
+				),
+			},
+		},
+		"invalid custom event": {
+			evts:   []wasmvmtypes.Event{{Type: "transfer", Attributes: []wasmvmtypes.EventAttribute{{Key: "_foo", Value: "1"}}}},
+			expErr: true,
+		},
+		"reserved attribute key": {
+			attrs:  []wasmvmtypes.EventAttribute{{Key: "_foo", Value: "1"}},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			em := sdk.NewEventManager()
+			ctx := sdk.Context{}.WithEventManager(em)
+			gotErr := emitContractEvents(ctx, contractAddr, spec.attrs, spec.evts)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				assert.Empty(t, em.Events())
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expEvents, em.Events())
+		})
+	}
 }
 
 func prettyEvents(t *testing.T, events sdk.Events) string {
@@ -1202,13 +1492,20 @@ func TestUpdateContractAdmin(t *testing.T) {
 			if spec.overrideContractAddr != nil {
 				addr = spec.overrideContractAddr
 			}
-			err = keeper.UpdateContractAdmin(ctx, addr, spec.caller, spec.newAdmin)
+			em := sdk.NewEventManager()
+			err = keeper.UpdateContractAdmin(ctx.WithEventManager(em), addr, spec.caller, spec.newAdmin)
 			require.True(t, spec.expErr.Is(err), "expected %v but got %+v", spec.expErr, err)
 			if spec.expErr != nil {
 				return
 			}
 			cInfo := keeper.GetContractInfo(ctx, addr)
 			assert.Equal(t, spec.newAdmin.String(), cInfo.Admin)
+			expEvent := sdk.NewEvent("update_contract_admin",
+				sdk.NewAttribute("_contract_address", addr.String()),
+				sdk.NewAttribute("code_id", strconv.FormatUint(originalContractID, 10)),
+				sdk.NewAttribute("new_admin_address", spec.newAdmin.String()),
+			)
+			assert.Equal(t, sdk.Events{expEvent}, em.Events())
 		})
 	}
 }
@@ -1278,3 +1575,508 @@ func TestClearContractAdmin(t *testing.T) {
 		})
 	}
 }
+
+func TestTwoStepContractAdminTransfer(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	admin := example.CreatorAddr
+	require.Equal(t, admin, k.GetContractInfo(ctx, example.Contract).AdminAddr())
+	newAdmin, stranger := RandomAccountAddress(t), RandomAccountAddress(t)
+	querier := NewQuerier(k)
+
+	// only the admin can propose
+	err := k.ProposeContractAdmin(ctx, example.Contract, stranger, newAdmin)
+	assert.True(t, sdkerrors.ErrUnauthorized.Is(err), "got %+v", err)
+	require.NoError(t, k.ProposeContractAdmin(ctx, example.Contract, admin, newAdmin))
+
+	// the pending admin is visible while the admin stays in charge
+	res, err := querier.ContractInfo(sdk.WrapSDKContext(ctx), &types.QueryContractInfoRequest{Address: example.Contract.String()})
+	require.NoError(t, err)
+	assert.Equal(t, admin.String(), res.Admin)
+	assert.Equal(t, newAdmin.String(), res.PendingAdmin)
+
+	// the admin can cancel
+	require.NoError(t, k.CancelProposedContractAdmin(ctx, example.Contract, admin))
+	assert.Empty(t, k.GetContractInfo(ctx, example.Contract).PendingAdmin)
+	err = k.CancelProposedContractAdmin(ctx, example.Contract, admin)
+	assert.True(t, types.ErrNotFound.Is(err), "got %+v", err)
+	err = k.AcceptContractAdmin(ctx, example.Contract, newAdmin)
+	assert.True(t, sdkerrors.ErrUnauthorized.Is(err), "got %+v", err)
+
+	// only the pending admin can accept
+	require.NoError(t, k.ProposeContractAdmin(ctx, example.Contract, admin, newAdmin))
+	err = k.AcceptContractAdmin(ctx, example.Contract, stranger)
+	assert.True(t, sdkerrors.ErrUnauthorized.Is(err), "got %+v", err)
+	// not even with an authorization policy that grants any contract modification
+	permissiveKeeper := *k
+	WithAuthorizationPolicy(GovAuthorizationPolicy{}).apply(&permissiveKeeper)
+	err = permissiveKeeper.AcceptContractAdmin(ctx, example.Contract, stranger)
+	assert.True(t, sdkerrors.ErrUnauthorized.Is(err), "got %+v", err)
+	em := sdk.NewEventManager()
+	require.NoError(t, k.AcceptContractAdmin(ctx.WithEventManager(em), example.Contract, newAdmin))
+	info := k.GetContractInfo(ctx, example.Contract)
+	assert.Equal(t, newAdmin.String(), info.Admin)
+	assert.Empty(t, info.PendingAdmin)
+	require.Len(t, em.Events(), 1)
+	assert.Equal(t, types.EventTypeUpdateAdmin, em.Events()[0].Type)
+
+	// a direct admin update drops the pending admin
+	require.NoError(t, k.ProposeContractAdmin(ctx, example.Contract, newAdmin, stranger))
+	require.NoError(t, k.UpdateContractAdmin(ctx, example.Contract, newAdmin, admin))
+	assert.Empty(t, k.GetContractInfo(ctx, example.Contract).PendingAdmin)
+}
+
+func TestMigrationAllowlist(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	admin := example.CreatorAddr
+	byID := StoreHackatomExampleContract(t, ctx, keepers)
+	byCreator := StoreHackatomExampleContract(t, ctx, keepers)
+	notListed := StoreHackatomExampleContract(t, ctx, keepers)
+	migMsgBz, err := json.Marshal(map[string]sdk.AccAddress{"verifier": RandomAccountAddress(t)})
+	require.NoError(t, err)
+	migrate := func(codeID uint64, authZ AuthorizationPolicy) error {
+		cacheCtx, _ := ctx.CacheContext()
+		_, err := k.migrate(cacheCtx, example.Contract, admin, codeID, migMsgBz, authZ)
+		return err
+	}
+
+	// only the admin can set the allowlist
+	allowlist := types.MigrationAllowlist{CodeIDs: []uint64{byID.CodeID}, CodeCreators: []string{byCreator.CreatorAddr.String()}}
+	err = k.UpdateMigrationAllowlist(ctx, example.Contract, RandomAccountAddress(t), allowlist)
+	assert.True(t, sdkerrors.ErrUnauthorized.Is(err), "got %+v", err)
+	em := sdk.NewEventManager()
+	require.NoError(t, k.UpdateMigrationAllowlist(ctx.WithEventManager(em), example.Contract, admin, allowlist))
+	expEvt := sdk.NewEvent(types.EventTypeUpdateMigrationAllowlist,
+		sdk.NewAttribute(types.AttributeKeyContractAddrReserved, example.Contract.String()),
+		sdk.NewAttribute(types.AttributeKeyMigrationAllowlist, allowlist.String()),
+	)
+	assert.Equal(t, sdk.Events{expEvt}, em.Events())
+
+	// the allowlist is part of the contract info
+	res, err := NewQuerier(k).ContractInfo(sdk.WrapSDKContext(ctx), &types.QueryContractInfoRequest{Address: example.Contract.String()})
+	require.NoError(t, err)
+	assert.Equal(t, &allowlist, res.MigrationAllowlist)
+
+	// migrations are restricted to the listed codes and code creators unless done by governance
+	assert.NoError(t, migrate(byID.CodeID, k.authZPolicy))
+	assert.NoError(t, migrate(byCreator.CodeID, k.authZPolicy))
+	err = migrate(notListed.CodeID, k.authZPolicy)
+	assert.True(t, sdkerrors.ErrUnauthorized.Is(err), "got %+v", err)
+	assert.NoError(t, migrate(notListed.CodeID, GovAuthorizationPolicy{}))
+
+	// the admin can only remove entries
+	err = k.UpdateMigrationAllowlist(ctx, example.Contract, admin, types.MigrationAllowlist{CodeIDs: []uint64{byID.CodeID, notListed.CodeID}})
+	assert.True(t, sdkerrors.ErrUnauthorized.Is(err), "got %+v", err)
+	require.NoError(t, k.UpdateMigrationAllowlist(ctx, example.Contract, admin, types.MigrationAllowlist{CodeIDs: []uint64{byID.CodeID}}))
+	err = migrate(byCreator.CodeID, k.authZPolicy)
+	assert.True(t, sdkerrors.ErrUnauthorized.Is(err), "got %+v", err)
+
+	// governance can widen and remove the allowlist
+	require.NoError(t, k.setMigrationAllowlist(ctx, example.Contract, nil, &allowlist, GovAuthorizationPolicy{}))
+	assert.Equal(t, &allowlist, k.GetContractInfo(ctx, example.Contract).MigrationAllowlist)
+	require.NoError(t, k.setMigrationAllowlist(ctx, example.Contract, nil, nil, GovAuthorizationPolicy{}))
+	assert.Nil(t, k.GetContractInfo(ctx, example.Contract).MigrationAllowlist)
+	assert.NoError(t, migrate(notListed.CodeID, k.authZPolicy))
+}
+
+func TestDeleteCode(t *testing.T) {
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		pin         bool
+		instantiate bool
+		caller      func(creator sdk.AccAddress) sdk.AccAddress
+		codeID      func(codeID uint64) uint64
+		expErr      *sdkerrors.Error
+	}{
+		"unused code deleted by creator": {
+			caller: func(creator sdk.AccAddress) sdk.AccAddress { return creator },
+		},
+		"non creator": {
+			caller: func(_ sdk.AccAddress) sdk.AccAddress { return RandomAccountAddress(t) },
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"pinned code": {
+			pin:    true,
+			caller: func(creator sdk.AccAddress) sdk.AccAddress { return creator },
+			expErr: types.ErrCodeInUse,
+		},
+		"code referenced by contract": {
+			instantiate: true,
+			caller:      func(creator sdk.AccAddress) sdk.AccAddress { return creator },
+			expErr:      types.ErrCodeInUse,
+		},
+		"unknown code": {
+			caller: func(creator sdk.AccAddress) sdk.AccAddress { return creator },
+			codeID: func(codeID uint64) uint64 { return codeID + 1 },
+			expErr: types.ErrNotFound,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, SupportedFeatures)
+			accKeeper, keeper, bankKeeper := keepers.AccountKeeper, keepers.WasmKeeper, keepers.BankKeeper
+			creator := createFakeFundedAccount(t, ctx, accKeeper, bankKeeper, sdk.NewCoins(sdk.NewInt64Coin("denom", 100000)))
+			codeID, err := keeper.Create(ctx, creator, wasmCode, "", "", nil)
+			require.NoError(t, err)
+			checksum := keeper.GetCodeInfo(ctx, codeID).CodeHash
+			if spec.pin {
+				require.NoError(t, keeper.PinCode(ctx, codeID))
+			}
+			if spec.instantiate {
+				initMsgBz, err := json.Marshal(HackatomExampleInitMsg{Verifier: creator, Beneficiary: creator})
+				require.NoError(t, err)
+				_, _, err = keeper.Instantiate(ctx, codeID, creator, nil, initMsgBz, "demo contract", nil)
+				require.NoError(t, err)
+			}
+			deleteCodeID := codeID
+			if spec.codeID != nil {
+				deleteCodeID = spec.codeID(codeID)
+			}
+
+			// when
+			gotErr := keeper.DeleteCode(ctx, deleteCodeID, spec.caller(creator))
+
+			// then
+			pendingRemoval := ctx.KVStore(keeper.storeKey).Has(types.GetPendingCodeRemovalKey(checksum))
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(gotErr), "got %+v", gotErr)
+				assert.False(t, pendingRemoval)
+				assert.Equal(t, []uint64{codeID}, keeper.GetCodeIDsByChecksum(ctx, checksum))
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Nil(t, keeper.GetCodeInfo(ctx, codeID))
+			assert.True(t, pendingRemoval)
+			assert.Empty(t, keeper.GetCodeIDsByChecksum(ctx, checksum))
+		})
+	}
+}
+
+func TestUpdateInstantiateConfig(t *testing.T) {
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		caller func(creator sdk.AccAddress) sdk.AccAddress
+		codeID func(codeID uint64) uint64
+		expErr *sdkerrors.Error
+	}{
+		"updated by creator": {
+			caller: func(creator sdk.AccAddress) sdk.AccAddress { return creator },
+		},
+		"non creator": {
+			caller: func(_ sdk.AccAddress) sdk.AccAddress { return RandomAccountAddress(t) },
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"unknown code": {
+			caller: func(creator sdk.AccAddress) sdk.AccAddress { return creator },
+			codeID: func(codeID uint64) uint64 { return codeID + 1 },
+			expErr: types.ErrNotFound,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, SupportedFeatures)
+			accKeeper, keeper, bankKeeper := keepers.AccountKeeper, keepers.WasmKeeper, keepers.BankKeeper
+			creator := createFakeFundedAccount(t, ctx, accKeeper, bankKeeper, sdk.NewCoins(sdk.NewInt64Coin("denom", 100000)))
+			codeID, err := keeper.Create(ctx, creator, wasmCode, "", "", &types.AllowEverybody)
+			require.NoError(t, err)
+			updateCodeID := codeID
+			if spec.codeID != nil {
+				updateCodeID = spec.codeID(codeID)
+			}
+			newConfig := types.AccessTypeOnlyAddress.With(creator)
+			em := sdk.NewEventManager()
+
+			// when
+			gotErr := keeper.UpdateInstantiateConfig(ctx.WithEventManager(em), updateCodeID, spec.caller(creator), newConfig)
+
+			// then
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(gotErr), "got %+v", gotErr)
+				assert.Equal(t, types.AllowEverybody, keeper.GetCodeInfo(ctx, codeID).InstantiateConfig)
+				assert.Empty(t, em.Events())
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, newConfig, keeper.GetCodeInfo(ctx, codeID).InstantiateConfig)
+			expEvt := sdk.NewEvent(types.EventTypeUpdateInstantiateConfig,
+				sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(codeID, 10)),
+				sdk.NewAttribute(types.AttributeKeyInstantiatePermission, newConfig.String()),
+			)
+			assert.Equal(t, sdk.Events{expEvt}, em.Events())
+		})
+	}
+}
+
+func TestRemoveDeletedCodes(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures)
+	keeper := keepers.WasmKeeper
+
+	var removed, unpinned []wasmvm.Checksum
+	mock := wasmtesting.MockWasmer{
+		CreateFn: wasmtesting.HashOnlyCreateFn,
+		UnpinFn: func(checksum wasmvm.Checksum) error {
+			unpinned = append(unpinned, checksum)
+			return nil
+		},
+		RemoveCodeFn: func(checksum wasmvm.Checksum) error {
+			removed = append(removed, checksum)
+			return nil
+		},
+	}
+	keeper.wasmVM = &mock
+	creator := RandomAccountAddress(t)
+	codeID, err := keeper.Create(ctx, creator, append(wasmIdent, []byte("first")...), "", "", nil)
+	require.NoError(t, err)
+	sharedCodeID, err := keeper.Create(ctx, creator, append(wasmIdent, []byte("second")...), "", "", nil)
+	require.NoError(t, err)
+	// a second code id with the same checksum keeps the shared artifacts alive
+	sameCodeID, err := keeper.Create(ctx, creator, append(wasmIdent, []byte("second")...), "", "", nil)
+	require.NoError(t, err)
+	checksum := keeper.GetCodeInfo(ctx, codeID).CodeHash
+	sharedChecksum := keeper.GetCodeInfo(ctx, sharedCodeID).CodeHash
+
+	// when
+	require.NoError(t, keeper.DeleteCode(ctx, codeID, creator))
+	require.NoError(t, keeper.DeleteCode(ctx, sharedCodeID, creator))
+	keeper.RemoveDeletedCodes(ctx)
+
+	// then
+	assert.Equal(t, []wasmvm.Checksum{checksum}, removed)
+	assert.Equal(t, []wasmvm.Checksum{checksum}, unpinned)
+	assert.NotNil(t, keeper.GetCodeInfo(ctx, sameCodeID))
+	assert.False(t, ctx.KVStore(keeper.storeKey).Has(types.GetPendingCodeRemovalKey(checksum)))
+	assert.False(t, ctx.KVStore(keeper.storeKey).Has(types.GetPendingCodeRemovalKey(sharedChecksum)))
+
+	// and a second run is a no-op
+	removed = nil
+	keeper.RemoveDeletedCodes(ctx)
+	assert.Empty(t, removed)
+}
+
+func TestPauseContract(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, SupportedFeatures)
+	k := keepers.WasmKeeper
+
+	var m wasmtesting.MockWasmer
+	wasmtesting.MakeIBCInstantiable(&m)
+	m.ExecuteFn = func(wasmvm.Checksum, wasmvmtypes.Env, wasmvmtypes.MessageInfo, []byte, wasmvm.KVStore, wasmvm.GoAPI, wasmvm.Querier, wasmvm.GasMeter, uint64) (*wasmvmtypes.Response, uint64, error) {
+		return &wasmvmtypes.Response{}, 0, nil
+	}
+	m.SudoFn = func(wasmvm.Checksum, wasmvmtypes.Env, []byte, wasmvm.KVStore, wasmvm.GoAPI, wasmvm.Querier, wasmvm.GasMeter, uint64) (*wasmvmtypes.Response, uint64, error) {
+		return &wasmvmtypes.Response{}, 0, nil
+	}
+	m.MigrateFn = func(wasmvm.Checksum, wasmvmtypes.Env, []byte, wasmvm.KVStore, wasmvm.GoAPI, wasmvm.Querier, wasmvm.GasMeter, uint64) (*wasmvmtypes.Response, uint64, error) {
+		return &wasmvmtypes.Response{}, 0, nil
+	}
+	m.ReplyFn = func(wasmvm.Checksum, wasmvmtypes.Env, wasmvmtypes.Reply, wasmvm.KVStore, wasmvm.GoAPI, wasmvm.Querier, wasmvm.GasMeter, uint64) (*wasmvmtypes.Response, uint64, error) {
+		return &wasmvmtypes.Response{}, 0, nil
+	}
+	m.QueryFn = func(wasmvm.Checksum, wasmvmtypes.Env, []byte, wasmvm.KVStore, wasmvm.GoAPI, wasmvm.Querier, wasmvm.GasMeter, uint64) ([]byte, uint64, error) {
+		return []byte(`{}`), 0, nil
+	}
+	example := SeedNewContractInstance(t, parentCtx, keepers, &m)
+	newCodeID := StoreRandomContract(t, parentCtx, keepers, &m).CodeID
+
+	specs := map[string]struct {
+		call    func(ctx sdk.Context) error
+		allowed bool
+	}{
+		"execute": {
+			call: func(ctx sdk.Context) error {
+				_, err := k.Execute(ctx, example.Contract, example.CreatorAddr, []byte(`{}`), nil)
+				return err
+			},
+		},
+		"sudo": {
+			call: func(ctx sdk.Context) error {
+				_, err := k.Sudo(ctx, example.Contract, []byte(`{}`))
+				return err
+			},
+		},
+		"reply": {
+			call: func(ctx sdk.Context) error {
+				_, err := k.reply(ctx, example.Contract, wasmvmtypes.Reply{})
+				return err
+			},
+		},
+		"migrate by admin": {
+			call: func(ctx sdk.Context) error {
+				_, err := k.Migrate(ctx, example.Contract, example.CreatorAddr, newCodeID, []byte(`{}`))
+				return err
+			},
+		},
+		"migrate by gov": {
+			call: func(ctx sdk.Context) error {
+				_, err := k.migrate(ctx, example.Contract, nil, newCodeID, []byte(`{}`), GovAuthorizationPolicy{})
+				return err
+			},
+			allowed: true,
+		},
+		"query smart": {
+			call: func(ctx sdk.Context) error {
+				_, err := k.QuerySmart(ctx, example.Contract, []byte(`{}`))
+				return err
+			},
+			allowed: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			require.NoError(t, spec.call(ctx))
+
+			// when
+			require.NoError(t, k.PauseContract(ctx, example.Contract))
+			// then
+			gotErr := spec.call(ctx)
+			if spec.allowed {
+				require.NoError(t, gotErr)
+			} else {
+				assert.True(t, types.ErrContractPaused.Is(gotErr), "got %+v", gotErr)
+			}
+
+			// and when unpaused
+			require.NoError(t, k.UnpauseContract(ctx, example.Contract))
+			require.NoError(t, spec.call(ctx))
+		})
+	}
+}
+
+func TestPauseContractWithNonExistingAddress(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures)
+	k := keepers.WasmKeeper
+
+	gotErr := k.PauseContract(ctx, RandomAccountAddress(t))
+	assert.True(t, types.ErrNotFound.Is(gotErr))
+	gotErr = k.UnpauseContract(ctx, RandomAccountAddress(t))
+	assert.True(t, types.ErrNotFound.Is(gotErr))
+}
+
+func TestPauseUnpauseContractEvents(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+
+	// when paused
+	em := sdk.NewEventManager()
+	require.NoError(t, k.PauseContract(ctx.WithEventManager(em), example.Contract))
+	// then
+	exp := sdk.NewEvent(
+		"pause_contract",
+		sdk.NewAttribute("module", "wasm"),
+		sdk.NewAttribute("contract_address", example.Contract.String()),
+	)
+	assert.Equal(t, sdk.Events{exp}, em.Events())
+
+	// and when unpaused
+	em = sdk.NewEventManager()
+	require.NoError(t, k.UnpauseContract(ctx.WithEventManager(em), example.Contract))
+	// then
+	exp = sdk.NewEvent(
+		"unpause_contract",
+		sdk.NewAttribute("module", "wasm"),
+		sdk.NewAttribute("contract_address", example.Contract.String()),
+	)
+	assert.Equal(t, sdk.Events{exp}, em.Events())
+}
+
+func TestPinUnpinCodeEvents(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures)
+	keeper := keepers.WasmKeeper
+	codeID := StoreHackatomExampleContract(t, ctx, keepers).CodeID
+
+	em := sdk.NewEventManager()
+	require.NoError(t, keeper.PinCode(ctx.WithEventManager(em), codeID))
+	exp := sdk.Events{sdk.NewEvent("pin_code", sdk.NewAttribute("code_id", strconv.FormatUint(codeID, 10)))}
+	assert.Equal(t, exp, em.Events())
+
+	em = sdk.NewEventManager()
+	require.NoError(t, keeper.UnpinCode(ctx.WithEventManager(em), codeID))
+	exp = sdk.Events{sdk.NewEvent("unpin_code", sdk.NewAttribute("code_id", strconv.FormatUint(codeID, 10)))}
+	assert.Equal(t, exp, em.Events())
+}
+
+func TestRemoveDeletedCodesIgnoresRemovalFailures(t *testing.T) {
+	specs := map[string]func(checksum wasmvm.Checksum) error{
+		"error": func(checksum wasmvm.Checksum) error {
+			return errors.New("testing")
+		},
+		"panic": func(checksum wasmvm.Checksum) error {
+			panic("testing")
+		},
+	}
+	for msg, removeFn := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, SupportedFeatures)
+			keeper := keepers.WasmKeeper
+			keeper.wasmVM = &wasmtesting.MockWasmer{
+				CreateFn:     wasmtesting.HashOnlyCreateFn,
+				UnpinFn:      func(checksum wasmvm.Checksum) error { return nil },
+				RemoveCodeFn: removeFn,
+			}
+			creator := RandomAccountAddress(t)
+			codeID, err := keeper.Create(ctx, creator, wasmIdent, "", "", nil)
+			require.NoError(t, err)
+			checksum := keeper.GetCodeInfo(ctx, codeID).CodeHash
+			require.NoError(t, keeper.DeleteCode(ctx, codeID, creator))
+
+			// when
+			require.NotPanics(t, func() { keeper.RemoveDeletedCodes(ctx) })
+
+			// then
+			assert.False(t, ctx.KVStore(keeper.storeKey).Has(types.GetPendingCodeRemovalKey(checksum)))
+		})
+	}
+}
+
+type memoryLimitWasmerMock struct {
+	*wasmtesting.MockWasmer
+	memoryLimit uint32
+}
+
+func (m *memoryLimitWasmerMock) MemoryLimit() uint32 {
+	return m.memoryLimit
+}
+
+func (m *memoryLimitWasmerMock) SetMemoryLimit(memoryLimit uint32) error {
+	m.memoryLimit = memoryLimit
+	return nil
+}
+
+func TestUpdateContractMemoryLimit(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures)
+	k := keepers.WasmKeeper
+	example := StoreHackatomExampleContract(t, ctx, keepers)
+	require.NoError(t, k.PinCode(ctx, example.CodeID))
+
+	var gotPinned []wasmvm.Checksum
+	mock := &memoryLimitWasmerMock{
+		MockWasmer: &wasmtesting.MockWasmer{PinFn: func(checksum wasmvm.Checksum) error {
+			gotPinned = append(gotPinned, checksum)
+			return nil
+		}},
+		memoryLimit: types.DefaultContractMemoryLimit,
+	}
+	k.wasmVM = mock
+
+	// an unchanged param keeps the vm
+	k.UpdateContractMemoryLimit(ctx)
+	assert.Empty(t, gotPinned)
+
+	// when
+	params := k.GetParams(ctx)
+	params.ContractMemoryLimit = 2 * types.DefaultContractMemoryLimit
+	k.setParams(ctx, params)
+	k.UpdateContractMemoryLimit(ctx)
+
+	// then
+	assert.Equal(t, 2*types.DefaultContractMemoryLimit, mock.memoryLimit)
+	assert.Equal(t, []wasmvm.Checksum{k.GetCodeInfo(ctx, example.CodeID).CodeHash}, gotPinned)
+}
@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StorageGasConfig defines the gas that is charged for contract storage access on top of the flat costs of the
+// sdk KV store. This makes storage heavy contracts pay in proportion to the data they read and write.
+type StorageGasConfig struct {
+	// ReadCostPerByte is charged for every byte of the key and value that is read
+	ReadCostPerByte sdk.Gas
+	// WriteCostPerByte is charged for every byte of the key and value that is written
+	WriteCostPerByte sdk.Gas
+	// IterNextCost is charged for every step of an iterator, in addition to the read costs of the entry
+	IterNextCost sdk.Gas
+	// DeleteCost is charged for every delete
+	DeleteCost sdk.Gas
+}
+
+// GetStorageGasConfig returns the contract storage costs that are set via params. Chains that did not set the params
+// yet are charged the default costs.
+func (k Keeper) GetStorageGasConfig(ctx sdk.Context) StorageGasConfig {
+	return StorageGasConfig{
+		ReadCostPerByte:  k.getGasParam(ctx, types.ParamStoreKeyStorageReadCostPerByte, types.DefaultStorageReadCostPerByte),
+		WriteCostPerByte: k.getGasParam(ctx, types.ParamStoreKeyStorageWriteCostPerByte, types.DefaultStorageWriteCostPerByte),
+		IterNextCost:     k.getGasParam(ctx, types.ParamStoreKeyStorageIterNextCost, types.DefaultStorageIterNextCost),
+		DeleteCost:       k.getGasParam(ctx, types.ParamStoreKeyStorageDeleteCost, types.DefaultStorageDeleteCost),
+	}
+}
+
+var _ sdk.KVStore = &meteredStore{}
+
+// meteredStore charges the StorageGasConfig costs to the gas meter of the contract invocation
+type meteredStore struct {
+	sdk.KVStore
+	gasMeter  sdk.GasMeter
+	gasConfig StorageGasConfig
+}
+
+func newMeteredStore(parent sdk.KVStore, gasMeter sdk.GasMeter, gasConfig StorageGasConfig) *meteredStore {
+	return &meteredStore{KVStore: parent, gasMeter: gasMeter, gasConfig: gasConfig}
+}
+
+func (s *meteredStore) Get(key []byte) []byte {
+	value := s.KVStore.Get(key)
+	s.gasMeter.ConsumeGas(s.gasConfig.ReadCostPerByte*sdk.Gas(len(key)+len(value)), "contract storage read per byte")
+	return value
+}
+
+func (s *meteredStore) Has(key []byte) bool {
+	s.gasMeter.ConsumeGas(s.gasConfig.ReadCostPerByte*sdk.Gas(len(key)), "contract storage has per byte")
+	return s.KVStore.Has(key)
+}
+
+func (s *meteredStore) Set(key, value []byte) {
+	s.gasMeter.ConsumeGas(s.gasConfig.WriteCostPerByte*sdk.Gas(len(key)+len(value)), "contract storage write per byte")
+	s.KVStore.Set(key, value)
+}
+
+func (s *meteredStore) Delete(key []byte) {
+	s.gasMeter.ConsumeGas(s.gasConfig.DeleteCost, "contract storage delete")
+	s.KVStore.Delete(key)
+}
+
+func (s *meteredStore) Iterator(start, end []byte) sdk.Iterator {
+	return newMeteredIterator(s.KVStore.Iterator(start, end), s.gasMeter, s.gasConfig)
+}
+
+func (s *meteredStore) ReverseIterator(start, end []byte) sdk.Iterator {
+	return newMeteredIterator(s.KVStore.ReverseIterator(start, end), s.gasMeter, s.gasConfig)
+}
+
+// meteredIterator charges every entry that the iterator moves to
+type meteredIterator struct {
+	sdk.Iterator
+	gasMeter  sdk.GasMeter
+	gasConfig StorageGasConfig
+}
+
+func newMeteredIterator(parent sdk.Iterator, gasMeter sdk.GasMeter, gasConfig StorageGasConfig) sdk.Iterator {
+	i := &meteredIterator{Iterator: parent, gasMeter: gasMeter, gasConfig: gasConfig}
+	i.consumeStepGas()
+	return i
+}
+
+func (i *meteredIterator) Next() {
+	i.Iterator.Next()
+	i.consumeStepGas()
+}
+
+func (i *meteredIterator) consumeStepGas() {
+	if !i.Iterator.Valid() {
+		return
+	}
+	i.gasMeter.ConsumeGas(i.gasConfig.IterNextCost, "contract storage iterator step")
+	entrySize := len(i.Iterator.Key()) + len(i.Iterator.Value())
+	i.gasMeter.ConsumeGas(i.gasConfig.ReadCostPerByte*sdk.Gas(entrySize), "contract storage iterator read per byte")
+}
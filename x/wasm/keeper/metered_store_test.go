@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestMeteredStore(t *testing.T) {
+	gasConfig := StorageGasConfig{
+		ReadCostPerByte:  1,
+		WriteCostPerByte: 10,
+		IterNextCost:     100,
+		DeleteCost:       1000,
+	}
+	specs := map[string]struct {
+		setup  func(store sdk.KVStore)
+		exec   func(store sdk.KVStore)
+		expGas sdk.Gas
+	}{
+		"set": {
+			exec:   func(store sdk.KVStore) { store.Set([]byte("foo"), []byte("bar1")) },
+			expGas: 7 * 10,
+		},
+		"get existing": {
+			setup:  func(store sdk.KVStore) { store.Set([]byte("foo"), []byte("bar1")) },
+			exec:   func(store sdk.KVStore) { store.Get([]byte("foo")) },
+			expGas: 7,
+		},
+		"get non existing": {
+			exec:   func(store sdk.KVStore) { store.Get([]byte("foo")) },
+			expGas: 3,
+		},
+		"has": {
+			setup:  func(store sdk.KVStore) { store.Set([]byte("foo"), []byte("bar1")) },
+			exec:   func(store sdk.KVStore) { store.Has([]byte("foo")) },
+			expGas: 3,
+		},
+		"delete": {
+			setup:  func(store sdk.KVStore) { store.Set([]byte("foo"), []byte("bar1")) },
+			exec:   func(store sdk.KVStore) { store.Delete([]byte("foo")) },
+			expGas: 1000,
+		},
+		"iterate": {
+			setup: func(store sdk.KVStore) {
+				store.Set([]byte("a"), []byte("1"))
+				store.Set([]byte("bb"), []byte("22"))
+			},
+			exec: func(store sdk.KVStore) {
+				iter := store.Iterator(nil, nil)
+				for ; iter.Valid(); iter.Next() {
+				}
+				require.NoError(t, iter.Close())
+			},
+			expGas: 2*100 + 2 + 4,
+		},
+		"reverse iterate with break": {
+			setup: func(store sdk.KVStore) {
+				store.Set([]byte("a"), []byte("1"))
+				store.Set([]byte("bb"), []byte("22"))
+			},
+			exec: func(store sdk.KVStore) {
+				iter := store.ReverseIterator(nil, nil)
+				require.NoError(t, iter.Close())
+			},
+			expGas: 100 + 4,
+		},
+		"iterate empty": {
+			exec: func(store sdk.KVStore) {
+				iter := store.Iterator(nil, nil)
+				require.NoError(t, iter.Close())
+			},
+			expGas: 0,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			parent := dbadapter.Store{DB: dbm.NewMemDB()}
+			if spec.setup != nil {
+				spec.setup(parent)
+			}
+			gasMeter := sdk.NewInfiniteGasMeter()
+			spec.exec(newMeteredStore(parent, gasMeter, gasConfig))
+			assert.Equal(t, spec.expGas, gasMeter.GasConsumed())
+		})
+	}
+}
+
+func TestGetStorageGasConfig(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures)
+	keeper := keepers.WasmKeeper
+	assert.Equal(t, StorageGasConfig{
+		ReadCostPerByte:  types.DefaultStorageReadCostPerByte,
+		WriteCostPerByte: types.DefaultStorageWriteCostPerByte,
+		IterNextCost:     types.DefaultStorageIterNextCost,
+		DeleteCost:       types.DefaultStorageDeleteCost,
+	}, keeper.GetStorageGasConfig(ctx))
+
+	// costs are updated via params
+	params := types.DefaultParams()
+	params.StorageReadCostPerByte = 1
+	params.StorageWriteCostPerByte = 2
+	params.StorageIterNextCost = 3
+	params.StorageDeleteCost = 4
+	keeper.setParams(ctx, params)
+	assert.Equal(t, StorageGasConfig{ReadCostPerByte: 1, WriteCostPerByte: 2, IterNextCost: 3, DeleteCost: 4}, keeper.GetStorageGasConfig(ctx))
+
+	// chains that did not set the params yet are charged the default costs
+	legacyKeeper := *keeper
+	legacyKeeper.paramSpace = keepers.ParamsKeeper.Subspace("legacywasm").WithKeyTable(types.ParamKeyTable())
+	assert.Equal(t, types.DefaultStorageDeleteCost, legacyKeeper.GetStorageGasConfig(ctx).DeleteCost)
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is used by chain upgrade handlers to migrate the module state.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 stores the default values for all params that are not set in the param store, yet.
+// Chains that started with the initial param set keep their gas costs as the defaults equal the
+// former constants.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	defaults := types.DefaultParams()
+	for _, pair := range defaults.ParamSetPairs() {
+		if m.keeper.paramSpace.Has(ctx, pair.Key) {
+			continue
+		}
+		m.keeper.paramSpace.Set(ctx, pair.Key, pair.Value)
+	}
+	return nil
+}
+
+// Migrate2to3 counts the keys and bytes in the store of all existing contracts for the storage stats.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	var contracts []sdk.AccAddress
+	m.keeper.IterateContractInfo(ctx, func(contractAddr sdk.AccAddress, _ types.ContractInfo) bool {
+		contracts = append(contracts, contractAddr)
+		return false
+	})
+	for _, contractAddr := range contracts {
+		m.keeper.updateContractStorageStats(ctx, contractAddr)
+	}
+	return nil
+}
+
+// Migrate3to4 fills the creator and admin indexes for all existing contracts.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	var contracts []sdk.AccAddress
+	m.keeper.IterateContractInfo(ctx, func(contractAddr sdk.AccAddress, _ types.ContractInfo) bool {
+		contracts = append(contracts, contractAddr)
+		return false
+	})
+	for _, contractAddr := range contracts {
+		m.keeper.storeContractInfo(ctx, contractAddr, m.keeper.GetContractInfo(ctx, contractAddr))
+	}
+	return nil
+}
+
+// Migrate4to5 fills the checksum index for all stored codes.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	store := ctx.KVStore(m.keeper.storeKey)
+	var keys [][]byte
+	m.keeper.IterateCodeInfos(ctx, func(codeID uint64, info types.CodeInfo) bool {
+		keys = append(keys, types.GetCodeByChecksumIndexKey(info.CodeHash, codeID))
+		return false
+	})
+	for _, key := range keys {
+		store.Set(key, []byte{})
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures)
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+
+	// a param store with the params of the initial release only
+	legacyKeeper := *keepers.WasmKeeper
+	legacyKeeper.paramSpace = keepers.ParamsKeeper.Subspace("legacywasm").WithKeyTable(types.ParamKeyTable())
+	defaults := types.DefaultParams()
+	legacyKeeper.paramSpace.Set(ctx, types.ParamStoreKeyUploadAccess, defaults.CodeUploadAccess)
+	legacyKeeper.paramSpace.Set(ctx, types.ParamStoreKeyInstantiateAccess, defaults.InstantiateDefaultPermission)
+	legacyKeeper.paramSpace.Set(ctx, types.ParamStoreKeyMaxWasmCodeSize, defaults.MaxWasmCodeSize)
+	require.False(t, legacyKeeper.paramSpace.Has(ctx, types.ParamStoreKeyGasMultiplier))
+
+	// the former constants are used as long as the params are not set
+	assert.Equal(t, uint64(100), legacyKeeper.GetGasMultiplier(ctx))
+	assert.Equal(t, uint64(40_000), legacyKeeper.GetInstanceCost(ctx))
+	assert.Equal(t, uint64(2), legacyKeeper.GetCompileCost(ctx))
+	assert.Equal(t, uint64(5), legacyKeeper.GetHumanizeCost(ctx))
+	assert.Equal(t, uint64(4), legacyKeeper.GetCanonicalizeCost(ctx))
+	assert.Equal(t, uint32(32), legacyKeeper.GetContractMemoryLimit(ctx))
+	assert.Equal(t, defaults, legacyKeeper.GetParams(ctx))
+
+	executeGas := func(k Keeper) sdk.Gas {
+		cacheCtx, _ := ctx.CacheContext()
+		cacheCtx = cacheCtx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		_, err := k.Execute(cacheCtx, example.Contract, example.VerifierAddr, []byte(`{"release":{}}`), nil)
+		require.NoError(t, err)
+		return cacheCtx.GasMeter().GasConsumed()
+	}
+	expGas := executeGas(*keepers.WasmKeeper)
+	assert.Equal(t, expGas, executeGas(legacyKeeper))
+
+	// when
+	err := NewMigrator(legacyKeeper).Migrate1to2(ctx)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, defaults, legacyKeeper.GetParams(ctx))
+	assert.Equal(t, expGas, executeGas(legacyKeeper))
+}
+
+func TestGasParams(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+
+	executeGas := func() sdk.Gas {
+		cacheCtx, _ := ctx.CacheContext()
+		cacheCtx = cacheCtx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		_, err := k.Execute(cacheCtx, example.Contract, example.VerifierAddr, []byte(`{"release":{}}`), nil)
+		require.NoError(t, err)
+		return cacheCtx.GasMeter().GasConsumed()
+	}
+	defaultGas := executeGas()
+
+	params := k.GetParams(ctx)
+	params.InstanceCost += 1_000
+	k.setParams(ctx, params)
+	assert.Equal(t, defaultGas+1_000, executeGas())
+
+	params.InstanceCost = types.DefaultInstanceCost
+	params.GasMultiplier = 2 * types.DefaultGasMultiplier
+	k.setParams(ctx, params)
+	assert.Less(t, executeGas(), defaultGas)
+}
+
+func TestMigrate2to3(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	expStats := k.GetContractStorageStats(ctx, example.Contract)
+	require.NotZero(t, expStats.KeyCount)
+	// a contract that was created without storage stats
+	ctx.KVStore(k.storeKey).Delete(types.GetContractStorageStatsKey(example.Contract))
+	require.Equal(t, types.ContractStorageStats{}, k.GetContractStorageStats(ctx, example.Contract))
+
+	// when
+	err := NewMigrator(*k).Migrate2to3(ctx)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, expStats, k.GetContractStorageStats(ctx, example.Contract))
+}
+
+func TestMigrate3to4(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	info := k.GetContractInfo(ctx, example.Contract)
+	require.NotNil(t, info.AdminAddr())
+	// a contract that was created without the creator and admin indexes
+	store := ctx.KVStore(k.storeKey)
+	creatorKey := types.GetContractByCreatorSecondaryIndexKey(info.CreatorAddr(), example.Contract, info)
+	adminKey := types.GetContractByAdminSecondaryIndexKey(info.AdminAddr(), example.Contract, info)
+	store.Delete(creatorKey)
+	store.Delete(adminKey)
+
+	// when
+	err := NewMigrator(*k).Migrate3to4(ctx)
+
+	// then
+	require.NoError(t, err)
+	assert.True(t, store.Has(creatorKey))
+	assert.True(t, store.Has(adminKey))
+}
+
+func TestMigrate4to5(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures)
+	k := keepers.WasmKeeper
+	example := StoreHackatomExampleContract(t, ctx, keepers)
+	codeInfo := k.GetCodeInfo(ctx, example.CodeID)
+	// a code that was stored without the checksum index
+	ctx.KVStore(k.storeKey).Delete(types.GetCodeByChecksumIndexKey(codeInfo.CodeHash, example.CodeID))
+	require.Empty(t, k.GetCodeIDsByChecksum(ctx, codeInfo.CodeHash))
+
+	// when
+	err := NewMigrator(*k).Migrate4to5(ctx)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{example.CodeID}, k.GetCodeIDsByChecksum(ctx, codeInfo.CodeHash))
+}
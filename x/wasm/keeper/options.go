@@ -74,3 +74,19 @@ func WithCoinTransferrer(x coinTransferrer) Option {
 		k.bank = x
 	})
 }
+
+// WithAcceptedStargateQueries is an optional constructor parameter to replace the response types of the stargate
+// query paths. Only the paths that are also listed in the `AcceptedStargateQueries` param can be queried.
+func WithAcceptedStargateQueries(x AcceptedStargateQueries) Option {
+	return optsFn(func(k *Keeper) {
+		k.acceptedStargateQueries = x
+	})
+}
+
+// WithAuthorizationPolicy is an optional constructor parameter to replace the default authorization policy,
+// for example to restrict the contract calls to registered accounts. Governance proposals are not affected.
+func WithAuthorizationPolicy(x AuthorizationPolicy) Option {
+	return optsFn(func(k *Keeper) {
+		k.authZPolicy = x
+	})
+}
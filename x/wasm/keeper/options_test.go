@@ -40,6 +40,18 @@ func TestConstructorOptions(t *testing.T) {
 				assert.IsType(t, k.bank, &wasmtesting.MockCoinTransferrer{})
 			},
 		},
+		"authorization policy": {
+			srcOpt: WithAuthorizationPolicy(GovAuthorizationPolicy{}),
+			verify: func(k Keeper) {
+				assert.IsType(t, GovAuthorizationPolicy{}, k.authZPolicy)
+			},
+		},
+		"accepted stargate queries": {
+			srcOpt: WithAcceptedStargateQueries(AcceptedStargateQueries{"/foo": &types.QueryCodeResponse{}}),
+			verify: func(k Keeper) {
+				assert.Equal(t, AcceptedStargateQueries{"/foo": &types.QueryCodeResponse{}}, k.acceptedStargateQueries)
+			},
+		},
 	}
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
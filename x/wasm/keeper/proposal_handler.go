@@ -14,11 +14,20 @@ import (
 // governing contains a subset of the wasm keeper used by gov processes
 type governing interface {
 	create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, source string, builder string, instantiateAccess *types.AccessConfig, authZ AuthorizationPolicy) (codeID uint64, err error)
-	instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins, authZ AuthorizationPolicy) (sdk.AccAddress, []byte, error)
+	instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins, addressGenerator addressGenerator, authZ AuthorizationPolicy) (sdk.AccAddress, []byte, error)
+	classicAddressGenerator() addressGenerator
 	migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, newCodeID uint64, msg []byte, authZ AuthorizationPolicy) (*sdk.Result, error)
 	setContractAdmin(ctx sdk.Context, contractAddress, caller, newAdmin sdk.AccAddress, authZ AuthorizationPolicy) error
-	PinCode(ctx sdk.Context, codeID uint64) error
-	UnpinCode(ctx sdk.Context, codeID uint64) error
+	pinCode(ctx sdk.Context, codeID uint64, actor sdk.AccAddress, authZ AuthorizationPolicy) error
+	unpinCode(ctx sdk.Context, codeID uint64, actor sdk.AccAddress, authZ AuthorizationPolicy) error
+	deleteCode(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, authZ AuthorizationPolicy) error
+	setInstantiateConfig(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, newConfig types.AccessConfig, authZ AuthorizationPolicy) error
+	setMigrationAllowlist(ctx sdk.Context, contractAddress, caller sdk.AccAddress, allowlist *types.MigrationAllowlist, authZ AuthorizationPolicy) error
+	PauseContract(ctx sdk.Context, contractAddress sdk.AccAddress) error
+	UnpauseContract(ctx sdk.Context, contractAddress sdk.AccAddress) error
+	execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins, authZ AuthorizationPolicy) (*sdk.Result, error)
+	sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte, authZ AuthorizationPolicy) (*sdk.Result, error)
+	fundFromCommunityPool(ctx sdk.Context, receiver sdk.AccAddress, amount sdk.Coins) error
 }
 
 // NewWasmProposalHandler creates a new governance Handler for wasm proposals
@@ -49,6 +58,22 @@ func NewWasmProposalHandler(k governing, enabledProposalTypes []types.ProposalTy
 			return handlePinCodesProposal(ctx, k, *c)
 		case *types.UnpinCodesProposal:
 			return handleUnpinCodesProposal(ctx, k, *c)
+		case *types.DeleteCodesProposal:
+			return handleDeleteCodesProposal(ctx, k, *c)
+		case *types.PauseContractProposal:
+			return handlePauseContractProposal(ctx, k, *c)
+		case *types.UnpauseContractProposal:
+			return handleUnpauseContractProposal(ctx, k, *c)
+		case *types.ExecuteContractProposal:
+			return handleExecuteProposal(ctx, k, *c)
+		case *types.SudoContractProposal:
+			return handleSudoProposal(ctx, k, *c)
+		case *types.StoreAndInstantiateContractProposal:
+			return handleStoreAndInstantiateContractProposal(ctx, k, *c)
+		case *types.UpdateInstantiateConfigProposal:
+			return handleUpdateInstantiateConfigProposal(ctx, k, *c)
+		case *types.UpdateMigrationAllowlistProposal:
+			return handleUpdateMigrationAllowlistProposal(ctx, k, *c)
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized wasm proposal content type: %T", c)
 		}
@@ -91,7 +116,7 @@ func handleInstantiateProposal(ctx sdk.Context, k governing, p types.Instantiate
 		return sdkerrors.Wrap(err, "admin")
 	}
 
-	contractAddr, _, err := k.instantiate(ctx, p.CodeID, runAsAddr, adminAddr, p.InitMsg, p.Label, p.Funds, GovAuthorizationPolicy{})
+	contractAddr, _, err := k.instantiate(ctx, p.CodeID, runAsAddr, adminAddr, p.InitMsg, p.Label, p.Funds, k.classicAddressGenerator(), GovAuthorizationPolicy{})
 	if err != nil {
 		return err
 	}
@@ -130,14 +155,7 @@ func handleMigrateProposal(ctx sdk.Context, k governing, p types.MigrateContract
 		sdk.NewAttribute(types.AttributeKeyContract, p.Contract),
 	)
 	ctx.EventManager().EmitEvent(ourEvent)
-
-	for _, e := range res.Events {
-		attr := make([]sdk.Attribute, len(e.Attributes))
-		for i, a := range e.Attributes {
-			attr[i] = sdk.NewAttribute(string(a.Key), string(a.Value))
-		}
-		ctx.EventManager().EmitEvent(sdk.NewEvent(e.Type, attr...))
-	}
+	emitResultEvents(ctx, res)
 	return nil
 }
 
@@ -192,8 +210,34 @@ func handlePinCodesProposal(ctx sdk.Context, k governing, p types.PinCodesPropos
 	if err := p.ValidateBasic(); err != nil {
 		return err
 	}
+	// the keeper emits an event for each code
 	for _, v := range p.CodeIDs {
-		if err := k.PinCode(ctx, v); err != nil {
+		if err := k.pinCode(ctx, v, nil, GovAuthorizationPolicy{}); err != nil {
+			return sdkerrors.Wrapf(err, "code id: %d", v)
+		}
+	}
+	return nil
+}
+
+func handleUnpinCodesProposal(ctx sdk.Context, k governing, p types.UnpinCodesProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+	// the keeper emits an event for each code
+	for _, v := range p.CodeIDs {
+		if err := k.unpinCode(ctx, v, nil, GovAuthorizationPolicy{}); err != nil {
+			return sdkerrors.Wrapf(err, "code id: %d", v)
+		}
+	}
+	return nil
+}
+
+func handleDeleteCodesProposal(ctx sdk.Context, k governing, p types.DeleteCodesProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+	for _, v := range p.CodeIDs {
+		if err := k.deleteCode(ctx, v, nil, GovAuthorizationPolicy{}); err != nil {
 			return sdkerrors.Wrapf(err, "code id: %d", v)
 		}
 	}
@@ -202,7 +246,7 @@ func handlePinCodesProposal(ctx sdk.Context, k governing, p types.PinCodesPropos
 		s[i] = strconv.FormatUint(v, 10)
 	}
 	ourEvent := sdk.NewEvent(
-		types.EventTypePinCode,
+		types.EventTypeDeleteCode,
 		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
 		sdk.NewAttribute(types.AttributeKeyCodeIDs, strings.Join(s, ",")),
 	)
@@ -211,25 +255,147 @@ func handlePinCodesProposal(ctx sdk.Context, k governing, p types.PinCodesPropos
 	return nil
 }
 
-func handleUnpinCodesProposal(ctx sdk.Context, k governing, p types.UnpinCodesProposal) error {
+func handlePauseContractProposal(ctx sdk.Context, k governing, p types.PauseContractProposal) error {
 	if err := p.ValidateBasic(); err != nil {
 		return err
 	}
-	for _, v := range p.CodeIDs {
-		if err := k.UnpinCode(ctx, v); err != nil {
-			return sdkerrors.Wrapf(err, "code id: %d", v)
+	contractAddr, err := sdk.AccAddressFromBech32(p.Contract)
+	if err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return k.PauseContract(ctx, contractAddr)
+}
+
+func handleUnpauseContractProposal(ctx sdk.Context, k governing, p types.UnpauseContractProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(p.Contract)
+	if err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return k.UnpauseContract(ctx, contractAddr)
+}
+
+func handleExecuteProposal(ctx sdk.Context, k governing, p types.ExecuteContractProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(p.Contract)
+	if err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	runAsAddr, err := sdk.AccAddressFromBech32(p.RunAs)
+	if err != nil {
+		return sdkerrors.Wrap(err, "run as address")
+	}
+	if p.FromCommunityPool {
+		if err := k.fundFromCommunityPool(ctx, runAsAddr, p.Funds); err != nil {
+			return sdkerrors.Wrap(err, "community pool")
 		}
 	}
-	s := make([]string, len(p.CodeIDs))
-	for i, v := range p.CodeIDs {
-		s[i] = strconv.FormatUint(v, 10)
+	res, err := k.execute(ctx, contractAddr, runAsAddr, p.Msg, p.Funds, GovAuthorizationPolicy{})
+	if err != nil {
+		return err
 	}
+
 	ourEvent := sdk.NewEvent(
-		types.EventTypeUnpinCode,
+		sdk.EventTypeMessage,
 		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-		sdk.NewAttribute(types.AttributeKeyCodeIDs, strings.Join(s, ",")),
+		sdk.NewAttribute(types.AttributeKeyContract, p.Contract),
+	)
+	ctx.EventManager().EmitEvent(ourEvent)
+	emitResultEvents(ctx, res)
+	return nil
+}
+
+func handleSudoProposal(ctx sdk.Context, k governing, p types.SudoContractProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(p.Contract)
+	if err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	res, err := k.sudo(ctx, contractAddr, p.Msg, GovAuthorizationPolicy{})
+	if err != nil {
+		return err
+	}
+
+	ourEvent := sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyContract, p.Contract),
+	)
+	ctx.EventManager().EmitEvent(ourEvent)
+	emitResultEvents(ctx, res)
+	return nil
+}
+
+func handleStoreAndInstantiateContractProposal(ctx sdk.Context, k governing, p types.StoreAndInstantiateContractProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+	runAsAddr, err := sdk.AccAddressFromBech32(p.RunAs)
+	if err != nil {
+		return sdkerrors.Wrap(err, "run as address")
+	}
+	var adminAddr sdk.AccAddress
+	if p.Admin != "" {
+		if adminAddr, err = sdk.AccAddressFromBech32(p.Admin); err != nil {
+			return sdkerrors.Wrap(err, "admin")
+		}
+	}
+
+	codeID, err := k.create(ctx, runAsAddr, p.WASMByteCode, p.Source, p.Builder, p.InstantiatePermission, GovAuthorizationPolicy{})
+	if err != nil {
+		return err
+	}
+	contractAddr, _, err := k.instantiate(ctx, codeID, runAsAddr, adminAddr, p.InitMsg, p.Label, p.Funds, k.classicAddressGenerator(), GovAuthorizationPolicy{})
+	if err != nil {
+		return err
+	}
+
+	ourEvent := sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyCodeID, fmt.Sprintf("%d", codeID)),
+		sdk.NewAttribute(types.AttributeKeyContract, contractAddr.String()),
 	)
 	ctx.EventManager().EmitEvent(ourEvent)
+	return nil
+}
 
+func handleUpdateInstantiateConfigProposal(ctx sdk.Context, k governing, p types.UpdateInstantiateConfigProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+	for _, update := range p.AccessConfigUpdates {
+		if err := k.setInstantiateConfig(ctx, update.CodeID, nil, update.InstantiatePermission, GovAuthorizationPolicy{}); err != nil {
+			return sdkerrors.Wrapf(err, "code id: %d", update.CodeID)
+		}
+	}
 	return nil
 }
+
+func handleUpdateMigrationAllowlistProposal(ctx sdk.Context, k governing, p types.UpdateMigrationAllowlistProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(p.Contract)
+	if err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return k.setMigrationAllowlist(ctx, contractAddr, nil, p.Allowlist, GovAuthorizationPolicy{})
+}
+
+// emitResultEvents forwards the events of a contract call result to the event manager
+func emitResultEvents(ctx sdk.Context, res *sdk.Result) {
+	for _, e := range res.Events {
+		attr := make([]sdk.Attribute, len(e.Attributes))
+		for i, a := range e.Attributes {
+			attr[i] = sdk.NewAttribute(string(a.Key), string(a.Value))
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(e.Type, attr...))
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"github.com/CosmWasm/wasmd/x/wasm/keeper/wasmtesting"
 	wasmvm "github.com/CosmWasm/wasmvm"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 	"io/ioutil"
 	"testing"
 
@@ -25,6 +26,13 @@ func TestStoreCodeProposal(t *testing.T) {
 		CodeUploadAccess:             types.AllowNobody,
 		InstantiateDefaultPermission: types.AccessTypeNobody,
 		MaxWasmCodeSize:              types.DefaultMaxWasmCodeSize,
+		GasMultiplier:                types.DefaultGasMultiplier,
+		MaxScheduledCallbackGas:      types.DefaultMaxScheduledCallbackGas,
+		ContractMemoryLimit:          types.DefaultContractMemoryLimit,
+		InstanceCost:                 types.DefaultInstanceCost,
+		CompileCost:                  types.DefaultCompileCost,
+		HumanizeCost:                 types.DefaultHumanizeCost,
+		CanonicalizeCost:             types.DefaultCanonicalizeCost,
 	})
 	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
 	require.NoError(t, err)
@@ -66,6 +74,13 @@ func TestInstantiateProposal(t *testing.T) {
 		CodeUploadAccess:             types.AllowNobody,
 		InstantiateDefaultPermission: types.AccessTypeNobody,
 		MaxWasmCodeSize:              types.DefaultMaxWasmCodeSize,
+		GasMultiplier:                types.DefaultGasMultiplier,
+		MaxScheduledCallbackGas:      types.DefaultMaxScheduledCallbackGas,
+		ContractMemoryLimit:          types.DefaultContractMemoryLimit,
+		InstanceCost:                 types.DefaultInstanceCost,
+		CompileCost:                  types.DefaultCompileCost,
+		HumanizeCost:                 types.DefaultHumanizeCost,
+		CanonicalizeCost:             types.DefaultCanonicalizeCost,
 	})
 
 	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
@@ -115,6 +130,70 @@ func TestInstantiateProposal(t *testing.T) {
 	assert.Equal(t, expHistory, wasmKeeper.GetContractHistory(ctx, contractAddr))
 }
 
+func TestStoreAndInstantiateContractProposal(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, "staking")
+	govKeeper, wasmKeeper := keepers.GovKeeper, keepers.WasmKeeper
+	wasmKeeper.setParams(ctx, types.Params{
+		CodeUploadAccess:             types.AllowNobody,
+		InstantiateDefaultPermission: types.AccessTypeNobody,
+		MaxWasmCodeSize:              types.DefaultMaxWasmCodeSize,
+		GasMultiplier:                types.DefaultGasMultiplier,
+		MaxScheduledCallbackGas:      types.DefaultMaxScheduledCallbackGas,
+		ContractMemoryLimit:          types.DefaultContractMemoryLimit,
+		InstanceCost:                 types.DefaultInstanceCost,
+		CompileCost:                  types.DefaultCompileCost,
+		HumanizeCost:                 types.DefaultHumanizeCost,
+		CanonicalizeCost:             types.DefaultCanonicalizeCost,
+	})
+
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+
+	var (
+		oneAddress   sdk.AccAddress = bytes.Repeat([]byte{0x1}, sdk.AddrLen)
+		otherAddress sdk.AccAddress = bytes.Repeat([]byte{0x2}, sdk.AddrLen)
+	)
+	src := types.StoreAndInstantiateContractProposalFixture(func(p *types.StoreAndInstantiateContractProposal) {
+		p.WASMByteCode = wasmCode
+		p.InstantiatePermission = &types.AllowNobody
+		p.RunAs = oneAddress.String()
+		p.Admin = otherAddress.String()
+		p.Label = "testing"
+	})
+
+	// when stored
+	storedProposal, err := govKeeper.SubmitProposal(ctx, src)
+	require.NoError(t, err)
+
+	// and proposal execute
+	handler := govKeeper.Router().GetRoute(storedProposal.ProposalRoute())
+	err = handler(ctx, storedProposal.GetContent())
+	require.NoError(t, err)
+
+	// then
+	cInfo := wasmKeeper.GetCodeInfo(ctx, firstCodeID)
+	require.NotNil(t, cInfo)
+	assert.Equal(t, oneAddress.String(), cInfo.Creator)
+	assert.Equal(t, types.AllowNobody, cInfo.InstantiateConfig)
+
+	contractAddr, err := sdk.AccAddressFromBech32("cosmos18vd8fpwxzck93qlwghaj6arh4p7c5n89uzcee5")
+	require.NoError(t, err)
+
+	contractInfo := wasmKeeper.GetContractInfo(ctx, contractAddr)
+	require.NotNil(t, contractInfo)
+	assert.Equal(t, uint64(firstCodeID), contractInfo.CodeID)
+	assert.Equal(t, oneAddress.String(), contractInfo.Creator)
+	assert.Equal(t, otherAddress.String(), contractInfo.Admin)
+	assert.Equal(t, "testing", contractInfo.Label)
+	expHistory := []types.ContractCodeHistoryEntry{{
+		Operation: types.ContractCodeHistoryOperationTypeInit,
+		CodeID:    firstCodeID,
+		Updated:   types.NewAbsoluteTxPosition(ctx),
+		Msg:       src.InitMsg,
+	}}
+	assert.Equal(t, expHistory, wasmKeeper.GetContractHistory(ctx, contractAddr))
+}
+
 func TestMigrateProposal(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, "staking")
 	govKeeper, wasmKeeper := keepers.GovKeeper, keepers.WasmKeeper
@@ -122,6 +201,13 @@ func TestMigrateProposal(t *testing.T) {
 		CodeUploadAccess:             types.AllowNobody,
 		InstantiateDefaultPermission: types.AccessTypeNobody,
 		MaxWasmCodeSize:              types.DefaultMaxWasmCodeSize,
+		GasMultiplier:                types.DefaultGasMultiplier,
+		MaxScheduledCallbackGas:      types.DefaultMaxScheduledCallbackGas,
+		ContractMemoryLimit:          types.DefaultContractMemoryLimit,
+		InstanceCost:                 types.DefaultInstanceCost,
+		CompileCost:                  types.DefaultCompileCost,
+		HumanizeCost:                 types.DefaultHumanizeCost,
+		CanonicalizeCost:             types.DefaultCanonicalizeCost,
 	})
 
 	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
@@ -255,6 +341,13 @@ func TestAdminProposals(t *testing.T) {
 				CodeUploadAccess:             types.AllowNobody,
 				InstantiateDefaultPermission: types.AccessTypeNobody,
 				MaxWasmCodeSize:              types.DefaultMaxWasmCodeSize,
+				GasMultiplier:                types.DefaultGasMultiplier,
+				MaxScheduledCallbackGas:      types.DefaultMaxScheduledCallbackGas,
+				ContractMemoryLimit:          types.DefaultContractMemoryLimit,
+				InstanceCost:                 types.DefaultInstanceCost,
+				CompileCost:                  types.DefaultCompileCost,
+				HumanizeCost:                 types.DefaultHumanizeCost,
+				CanonicalizeCost:             types.DefaultCanonicalizeCost,
 			})
 
 			codeInfoFixture := types.CodeInfoFixture(types.WithSHA256CodeHash(wasmCode))
@@ -524,3 +617,368 @@ func TestUnpinCodesProposal(t *testing.T) {
 		})
 	}
 }
+
+func TestDeleteCodesProposal(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, "staking")
+	govKeeper, wasmKeeper := keepers.GovKeeper, keepers.WasmKeeper
+
+	var (
+		unused      = StoreHackatomExampleContract(t, ctx, keepers)
+		otherUnused = StoreHackatomExampleContract(t, ctx, keepers)
+		pinned      = StoreHackatomExampleContract(t, ctx, keepers)
+	)
+	require.NoError(t, wasmKeeper.PinCode(ctx, pinned.CodeID))
+
+	specs := map[string]struct {
+		srcCodeIDs []uint64
+		expErr     bool
+	}{
+		"delete one": {
+			srcCodeIDs: []uint64{unused.CodeID},
+		},
+		"delete multiple": {
+			srcCodeIDs: []uint64{unused.CodeID, otherUnused.CodeID},
+		},
+		"delete pinned code": {
+			srcCodeIDs: []uint64{unused.CodeID, pinned.CodeID},
+			expErr:     true,
+		},
+		"delete non existing code id": {
+			srcCodeIDs: []uint64{999},
+			expErr:     true,
+		},
+	}
+	parentCtx := ctx
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			proposal := types.DeleteCodesProposal{
+				Title:       "Foo",
+				Description: "Bar",
+				CodeIDs:     spec.srcCodeIDs,
+			}
+
+			// when stored
+			storedProposal, gotErr := govKeeper.SubmitProposal(ctx, &proposal)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+
+			// and proposal execute
+			handler := govKeeper.Router().GetRoute(storedProposal.ProposalRoute())
+			gotErr = handler(ctx, storedProposal.GetContent())
+			require.NoError(t, gotErr)
+
+			// then
+			for _, codeID := range spec.srcCodeIDs {
+				assert.Nil(t, wasmKeeper.GetCodeInfo(ctx, codeID))
+			}
+		})
+	}
+}
+
+func TestUpdateInstantiateConfigProposal(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, "staking")
+	govKeeper, wasmKeeper := keepers.GovKeeper, keepers.WasmKeeper
+
+	var (
+		example      = StoreHackatomExampleContract(t, ctx, keepers)
+		otherExample = StoreHackatomExampleContract(t, ctx, keepers)
+		anyAddress   = RandomAccountAddress(t)
+	)
+
+	specs := map[string]struct {
+		srcUpdates []types.AccessConfigUpdate
+		expErr     bool
+	}{
+		"update one": {
+			srcUpdates: []types.AccessConfigUpdate{
+				{CodeID: example.CodeID, InstantiatePermission: types.AllowNobody},
+			},
+		},
+		"update multiple": {
+			srcUpdates: []types.AccessConfigUpdate{
+				{CodeID: example.CodeID, InstantiatePermission: types.AllowNobody},
+				{CodeID: otherExample.CodeID, InstantiatePermission: types.AccessTypeOnlyAddress.With(anyAddress)},
+			},
+		},
+		"update non existing code id": {
+			srcUpdates: []types.AccessConfigUpdate{
+				{CodeID: example.CodeID, InstantiatePermission: types.AllowNobody},
+				{CodeID: 999, InstantiatePermission: types.AllowNobody},
+			},
+			expErr: true,
+		},
+	}
+	parentCtx := ctx
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			proposal := types.UpdateInstantiateConfigProposal{
+				Title:               "Foo",
+				Description:         "Bar",
+				AccessConfigUpdates: spec.srcUpdates,
+			}
+
+			// when stored
+			storedProposal, gotErr := govKeeper.SubmitProposal(ctx, &proposal)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+
+			// and proposal execute
+			handler := govKeeper.Router().GetRoute(storedProposal.ProposalRoute())
+			gotErr = handler(ctx, storedProposal.GetContent())
+			require.NoError(t, gotErr)
+
+			// then
+			for _, u := range spec.srcUpdates {
+				assert.Equal(t, u.InstantiatePermission, wasmKeeper.GetCodeInfo(ctx, u.CodeID).InstantiateConfig)
+			}
+		})
+	}
+}
+
+func TestUpdateMigrationAllowlistProposal(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, "staking")
+	govKeeper, wasmKeeper := keepers.GovKeeper, keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	adminAllowlist := types.MigrationAllowlist{CodeIDs: []uint64{example.CodeID}}
+	require.NoError(t, wasmKeeper.UpdateMigrationAllowlist(ctx, example.Contract, example.CreatorAddr, adminAllowlist))
+
+	specs := map[string]struct {
+		srcAllowlist *types.MigrationAllowlist
+		srcContract  string
+		expErr       bool
+	}{
+		"widen allowlist": {
+			srcAllowlist: &types.MigrationAllowlist{CodeIDs: []uint64{example.CodeID, 999}, CodeCreators: []string{RandomBech32AccountAddress(t)}},
+		},
+		"remove allowlist": {},
+		"unknown contract": {
+			srcContract: RandomBech32AccountAddress(t),
+			expErr:      true,
+		},
+	}
+	parentCtx := ctx
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			contract := example.Contract.String()
+			if spec.srcContract != "" {
+				contract = spec.srcContract
+			}
+			proposal := types.UpdateMigrationAllowlistProposal{
+				Title:       "Foo",
+				Description: "Bar",
+				Contract:    contract,
+				Allowlist:   spec.srcAllowlist,
+			}
+
+			// when stored
+			storedProposal, gotErr := govKeeper.SubmitProposal(ctx, &proposal)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				assert.Equal(t, &adminAllowlist, wasmKeeper.GetContractInfo(ctx, example.Contract).MigrationAllowlist)
+				return
+			}
+			require.NoError(t, gotErr)
+
+			// and proposal execute
+			handler := govKeeper.Router().GetRoute(storedProposal.ProposalRoute())
+			gotErr = handler(ctx, storedProposal.GetContent())
+			require.NoError(t, gotErr)
+
+			// then
+			assert.Equal(t, spec.srcAllowlist, wasmKeeper.GetContractInfo(ctx, example.Contract).MigrationAllowlist)
+		})
+	}
+}
+
+func TestPauseContractProposals(t *testing.T) {
+	contractAddr := contractAddress(1, 1)
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		state       types.ContractInfo
+		srcProposal govtypes.Content
+		expPaused   bool
+	}{
+		"pause": {
+			state: types.ContractInfoFixture(),
+			srcProposal: &types.PauseContractProposal{
+				Title:       "Foo",
+				Description: "Bar",
+				Contract:    contractAddr.String(),
+			},
+			expPaused: true,
+		},
+		"pause already paused": {
+			state: types.ContractInfoFixture(func(info *types.ContractInfo) {
+				info.Paused = true
+			}),
+			srcProposal: &types.PauseContractProposal{
+				Title:       "Foo",
+				Description: "Bar",
+				Contract:    contractAddr.String(),
+			},
+			expPaused: true,
+		},
+		"unpause": {
+			state: types.ContractInfoFixture(func(info *types.ContractInfo) {
+				info.Paused = true
+			}),
+			srcProposal: &types.UnpauseContractProposal{
+				Title:       "Foo",
+				Description: "Bar",
+				Contract:    contractAddr.String(),
+			},
+			expPaused: false,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, "staking")
+			govKeeper, wasmKeeper := keepers.GovKeeper, keepers.WasmKeeper
+
+			codeInfoFixture := types.CodeInfoFixture(types.WithSHA256CodeHash(wasmCode))
+			require.NoError(t, wasmKeeper.importCode(ctx, 1, codeInfoFixture, wasmCode))
+			require.NoError(t, wasmKeeper.importContract(ctx, contractAddr, &spec.state, []types.Model{}))
+
+			// when stored
+			storedProposal, err := govKeeper.SubmitProposal(ctx, spec.srcProposal)
+			require.NoError(t, err)
+
+			// and execute proposal
+			handler := govKeeper.Router().GetRoute(storedProposal.ProposalRoute())
+			err = handler(ctx, storedProposal.GetContent())
+			require.NoError(t, err)
+
+			// then
+			cInfo := wasmKeeper.GetContractInfo(ctx, contractAddr)
+			require.NotNil(t, cInfo)
+			assert.Equal(t, spec.expPaused, cInfo.Paused)
+		})
+	}
+}
+
+func TestExecuteProposal(t *testing.T) {
+	specs := map[string]struct {
+		runAsVerifier     bool
+		funds             sdk.Coins
+		fromCommunityPool bool
+		expBalance        sdk.Coins
+		expErr            bool
+	}{
+		"run as verifier": {
+			runAsVerifier: true,
+			expBalance:    sdk.NewCoins(sdk.NewInt64Coin("denom", 100)),
+		},
+		"funds not from community pool": {
+			runAsVerifier: true,
+			funds:         sdk.NewCoins(sdk.NewInt64Coin("denom", 10)),
+			expErr:        true,
+		},
+		"funds paid by community pool": {
+			runAsVerifier:     true,
+			funds:             sdk.NewCoins(sdk.NewInt64Coin("denom", 10)),
+			fromCommunityPool: true,
+			expBalance:        sdk.NewCoins(sdk.NewInt64Coin("denom", 110)),
+		},
+		"community pool too low": {
+			runAsVerifier:     true,
+			funds:             sdk.NewCoins(sdk.NewInt64Coin("denom", 1000)),
+			fromCommunityPool: true,
+			expErr:            true,
+		},
+		"unauthorized run as": {
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, SupportedFeatures)
+			govKeeper, distKeeper := keepers.GovKeeper, keepers.DistKeeper
+			example := InstantiateHackatomExampleContract(t, ctx, keepers)
+
+			poolFunder := createFakeFundedAccount(t, ctx, keepers.AccountKeeper, keepers.BankKeeper, sdk.NewCoins(sdk.NewInt64Coin("denom", 100)))
+			require.NoError(t, distKeeper.FundCommunityPool(ctx, sdk.NewCoins(sdk.NewInt64Coin("denom", 100)), poolFunder))
+			poolBefore := distKeeper.GetFeePoolCommunityCoins(ctx)
+			runAsBefore := keepers.BankKeeper.GetAllBalances(ctx, example.VerifierAddr)
+
+			runAs := example.VerifierAddr
+			if !spec.runAsVerifier {
+				runAs = example.BeneficiaryAddr
+			}
+			src := &types.ExecuteContractProposal{
+				Title:             "Foo",
+				Description:       "Bar",
+				Contract:          example.Contract.String(),
+				RunAs:             runAs.String(),
+				Msg:               []byte(`{"release":{}}`),
+				Funds:             spec.funds,
+				FromCommunityPool: spec.fromCommunityPool,
+			}
+
+			// when stored
+			storedProposal, err := govKeeper.SubmitProposal(ctx, src)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			// and proposal execute
+			handler := govKeeper.Router().GetRoute(storedProposal.ProposalRoute())
+			err = handler(ctx, storedProposal.GetContent())
+			require.NoError(t, err)
+
+			// then
+			assert.Equal(t, spec.expBalance, keepers.BankKeeper.GetAllBalances(ctx, example.BeneficiaryAddr))
+			assert.Equal(t, poolBefore.Sub(sdk.NewDecCoinsFromCoins(spec.funds...)), distKeeper.GetFeePoolCommunityCoins(ctx))
+			// the run as account never pays
+			assert.Equal(t, runAsBefore, keepers.BankKeeper.GetAllBalances(ctx, example.VerifierAddr))
+		})
+	}
+}
+
+func TestSudoProposal(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures)
+	govKeeper := keepers.GovKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+
+	_, _, community := keyPubAddr()
+	msg := sudoMsg{
+		StealFunds: stealFundsMsg{
+			Recipient: community.String(),
+			Amount:    wasmvmtypes.Coins{wasmvmtypes.NewCoin(76, "denom")},
+		},
+	}
+	msgBz, err := json.Marshal(msg)
+	require.NoError(t, err)
+
+	src := &types.SudoContractProposal{
+		Title:       "Foo",
+		Description: "Bar",
+		Contract:    example.Contract.String(),
+		Msg:         msgBz,
+	}
+
+	// when stored
+	storedProposal, err := govKeeper.SubmitProposal(ctx, src)
+	require.NoError(t, err)
+
+	// and proposal execute
+	handler := govKeeper.Router().GetRoute(storedProposal.ProposalRoute())
+	err = handler(ctx, storedProposal.GetContent())
+	require.NoError(t, err)
+
+	// then
+	balance := keepers.BankKeeper.GetBalance(ctx, community, "denom")
+	assert.Equal(t, sdk.NewInt64Coin("denom", 76), balance)
+}
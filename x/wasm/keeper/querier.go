@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"encoding/binary"
+	"encoding/hex"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"runtime/debug"
@@ -86,10 +87,61 @@ func (q grpcQuerier) ContractsByCode(c context.Context, req *types.QueryContract
 		return nil, sdkerrors.Wrap(types.ErrInvalid, "code id")
 	}
 	ctx := sdk.UnwrapSDKContext(c)
-	r := make([]types.ContractInfoWithAddress, 0)
-
 	prefixStore := prefix.NewStore(ctx.KVStore(q.keeper.storeKey), types.GetContractByCodeIDSecondaryIndexPrefix(req.CodeId))
-	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+	r, pageRes, err := q.contractsBySecondaryIndex(ctx, prefixStore, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryContractsByCodeResponse{
+		ContractInfos: r,
+		Pagination:    pageRes,
+	}, nil
+}
+
+func (q grpcQuerier) ContractsByCreator(c context.Context, req *types.QueryContractsByCreatorRequest) (*types.QueryContractsByCreatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	creatorAddr, err := sdk.AccAddressFromBech32(req.CreatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	prefixStore := prefix.NewStore(ctx.KVStore(q.keeper.storeKey), types.GetContractByCreatorSecondaryIndexPrefix(creatorAddr))
+	r, pageRes, err := q.contractsBySecondaryIndex(ctx, prefixStore, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryContractsByCreatorResponse{
+		ContractInfos: r,
+		Pagination:    pageRes,
+	}, nil
+}
+
+func (q grpcQuerier) ContractsByAdmin(c context.Context, req *types.QueryContractsByAdminRequest) (*types.QueryContractsByAdminResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	adminAddr, err := sdk.AccAddressFromBech32(req.AdminAddress)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	prefixStore := prefix.NewStore(ctx.KVStore(q.keeper.storeKey), types.GetContractByAdminSecondaryIndexPrefix(adminAddr))
+	r, pageRes, err := q.contractsBySecondaryIndex(ctx, prefixStore, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryContractsByAdminResponse{
+		ContractInfos: r,
+		Pagination:    pageRes,
+	}, nil
+}
+
+// contractsBySecondaryIndex loads the contracts of an index with `<created><contractAddr>` keys
+func (q grpcQuerier) contractsBySecondaryIndex(ctx sdk.Context, prefixStore prefix.Store, pageReq *query.PageRequest) ([]types.ContractInfoWithAddress, *query.PageResponse, error) {
+	r := make([]types.ContractInfoWithAddress, 0)
+	pageRes, err := query.FilteredPaginate(prefixStore, pageReq, func(key []byte, value []byte, accumulate bool) (bool, error) {
 		var contractAddr sdk.AccAddress = key[types.AbsoluteTxPositionLen:]
 		c := q.keeper.GetContractInfo(ctx, contractAddr)
 		if c == nil {
@@ -104,13 +156,7 @@ func (q grpcQuerier) ContractsByCode(c context.Context, req *types.QueryContract
 		}
 		return true, nil
 	})
-	if err != nil {
-		return nil, err
-	}
-	return &types.QueryContractsByCodeResponse{
-		ContractInfos: r,
-		Pagination:    pageRes,
-	}, nil
+	return r, pageRes, err
 }
 
 func (q grpcQuerier) AllContractState(c context.Context, req *types.QueryAllContractStateRequest) (*types.QueryAllContractStateResponse, error) {
@@ -254,6 +300,99 @@ func (q grpcQuerier) Codes(c context.Context, req *types.QueryCodesRequest) (*ty
 	return &types.QueryCodesResponse{CodeInfos: r, Pagination: pageRes}, nil
 }
 
+func (q grpcQuerier) CodeByChecksum(c context.Context, req *types.QueryCodeByChecksumRequest) (*types.QueryCodeByChecksumResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	checksum, err := hex.DecodeString(req.Checksum)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalid, "checksum")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	r := make([]types.CodeInfoResponse, 0)
+	for _, codeID := range q.keeper.GetCodeIDsByChecksum(ctx, checksum) {
+		c := q.keeper.GetCodeInfo(ctx, codeID)
+		if c == nil {
+			return nil, types.ErrNotFound
+		}
+		r = append(r, types.CodeInfoResponse{
+			CodeID:   codeID,
+			Creator:  c.Creator,
+			DataHash: c.CodeHash,
+			Source:   c.Source,
+			Builder:  c.Builder,
+		})
+	}
+	return &types.QueryCodeByChecksumResponse{CodeInfos: r}, nil
+}
+
+func (q grpcQuerier) ScheduledCallbacks(c context.Context, req *types.QueryScheduledCallbacksRequest) (*types.QueryScheduledCallbacksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Contract != "" {
+		if _, err := sdk.AccAddressFromBech32(req.Contract); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	r := make([]types.ScheduledCallback, 0)
+	prefixStore := prefix.NewStore(ctx.KVStore(q.keeper.storeKey), types.ScheduledCallbackPrefix)
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var callback types.ScheduledCallback
+		if err := q.keeper.cdc.UnmarshalBinaryBare(value, &callback); err != nil {
+			return false, err
+		}
+		if req.Contract != "" && req.Contract != callback.Contract {
+			return false, nil
+		}
+		if accumulate {
+			r = append(r, callback)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryScheduledCallbacksResponse{Callbacks: r, Pagination: pageRes}, nil
+}
+
+func (q grpcQuerier) AcceptedStargateQueries(c context.Context, req *types.QueryAcceptedStargateQueriesRequest) (*types.QueryAcceptedStargateQueriesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	paths := q.keeper.AcceptedStargateQueryPaths(sdk.UnwrapSDKContext(c))
+	return &types.QueryAcceptedStargateQueriesResponse{Paths: paths}, nil
+}
+
+func (q grpcQuerier) StargateMsgs(c context.Context, req *types.QueryStargateMsgsRequest) (*types.QueryStargateMsgsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	accepted, denied := q.keeper.GetStargateMsgs(sdk.UnwrapSDKContext(c))
+	return &types.QueryStargateMsgsResponse{Accepted: accepted, Denied: denied}, nil
+}
+
+func (q grpcQuerier) ContractStorageStats(c context.Context, req *types.QueryContractStorageStatsRequest) (*types.QueryContractStorageStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	info := q.keeper.GetContractInfo(ctx, contractAddr)
+	if info == nil {
+		return nil, types.ErrNotFound
+	}
+	return &types.QueryContractStorageStatsResponse{
+		Stats:          q.keeper.GetContractStorageStats(ctx, contractAddr),
+		MaxBytes:       q.keeper.GetContractStorageLimit(ctx, info.CodeID),
+		StorageDeposit: q.keeper.GetStorageDeposit(ctx, contractAddr),
+	}, nil
+}
+
 func queryContractInfo(ctx sdk.Context, addr sdk.AccAddress, keeper Keeper) (*types.ContractInfoWithAddress, error) {
 	info := keeper.GetContractInfo(ctx, addr)
 	if info == nil {
@@ -1,11 +1,14 @@
 package keeper
 
 import (
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"github.com/CosmWasm/wasmd/x/wasm/keeper/wasmtesting"
 	"io/ioutil"
+	"strings"
 	"testing"
 
 	"github.com/CosmWasm/wasmd/x/wasm/types"
@@ -307,6 +310,110 @@ func TestQueryContractListByCodeOrdering(t *testing.T) {
 	}
 }
 
+func TestQueryContractsByCreatorAndAdmin(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures)
+	k := keepers.WasmKeeper
+	example := StoreHackatomExampleContract(t, ctx, keepers)
+	_, _, alice := keyPubAddr()
+	_, _, bob := keyPubAddr()
+	_, _, fred := keyPubAddr()
+	initMsg := HackatomExampleInitMsg{Verifier: fred, Beneficiary: bob}.GetBytes(t)
+
+	var contracts []sdk.AccAddress
+	for i, admin := range []sdk.AccAddress{alice, alice, bob} {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		contractAddr, _, err := k.Instantiate(ctx, example.CodeID, example.CreatorAddr, admin, initMsg, fmt.Sprintf("contract %d", i), nil)
+		require.NoError(t, err)
+		contracts = append(contracts, contractAddr)
+	}
+	// admin changes are indexed
+	require.NoError(t, k.UpdateContractAdmin(ctx, contracts[1], alice, bob))
+	require.NoError(t, k.ClearContractAdmin(ctx, contracts[2], bob))
+
+	q := NewQuerier(k)
+	addresses := func(infos []types.ContractInfoWithAddress) []string {
+		r := make([]string, len(infos))
+		for i, v := range infos {
+			assert.Nil(t, v.Created)
+			r[i] = v.Address
+		}
+		return r
+	}
+
+	specs := map[string]struct {
+		query  func() ([]types.ContractInfoWithAddress, error)
+		expRes []sdk.AccAddress
+	}{
+		"by creator": {
+			query: func() ([]types.ContractInfoWithAddress, error) {
+				res, err := q.ContractsByCreator(sdk.WrapSDKContext(ctx), &types.QueryContractsByCreatorRequest{CreatorAddress: example.CreatorAddr.String()})
+				if err != nil {
+					return nil, err
+				}
+				return res.ContractInfos, nil
+			},
+			expRes: contracts,
+		},
+		"by creator with pagination": {
+			query: func() ([]types.ContractInfoWithAddress, error) {
+				res, err := q.ContractsByCreator(sdk.WrapSDKContext(ctx), &types.QueryContractsByCreatorRequest{
+					CreatorAddress: example.CreatorAddr.String(),
+					Pagination:     &query.PageRequest{Offset: 1, Limit: 1},
+				})
+				if err != nil {
+					return nil, err
+				}
+				return res.ContractInfos, nil
+			},
+			expRes: contracts[1:2],
+		},
+		"by other creator": {
+			query: func() ([]types.ContractInfoWithAddress, error) {
+				res, err := q.ContractsByCreator(sdk.WrapSDKContext(ctx), &types.QueryContractsByCreatorRequest{CreatorAddress: alice.String()})
+				if err != nil {
+					return nil, err
+				}
+				return res.ContractInfos, nil
+			},
+		},
+		"by admin": {
+			query: func() ([]types.ContractInfoWithAddress, error) {
+				res, err := q.ContractsByAdmin(sdk.WrapSDKContext(ctx), &types.QueryContractsByAdminRequest{AdminAddress: alice.String()})
+				if err != nil {
+					return nil, err
+				}
+				return res.ContractInfos, nil
+			},
+			expRes: contracts[0:1],
+		},
+		"by new admin": {
+			query: func() ([]types.ContractInfoWithAddress, error) {
+				res, err := q.ContractsByAdmin(sdk.WrapSDKContext(ctx), &types.QueryContractsByAdminRequest{AdminAddress: bob.String()})
+				if err != nil {
+					return nil, err
+				}
+				return res.ContractInfos, nil
+			},
+			expRes: contracts[1:2],
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			got, err := spec.query()
+			require.NoError(t, err)
+			exp := make([]string, len(spec.expRes))
+			for i, v := range spec.expRes {
+				exp[i] = v.String()
+			}
+			assert.Equal(t, exp, addresses(got))
+		})
+	}
+
+	// invalid address
+	_, err := q.ContractsByAdmin(sdk.WrapSDKContext(ctx), &types.QueryContractsByAdminRequest{AdminAddress: "invalid"})
+	require.Error(t, err)
+}
+
 func TestQueryContractHistory(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, SupportedFeatures)
 	keeper := keepers.WasmKeeper
@@ -523,6 +630,56 @@ func TestQueryCodeList(t *testing.T) {
 	}
 }
 
+func TestQueryCodeByChecksum(t *testing.T) {
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+	checksum := sha256.Sum256(wasmCode)
+
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures)
+	keeper := keepers.WasmKeeper
+	for _, codeID := range []uint64{1, 3} {
+		require.NoError(t, keeper.importCode(ctx, codeID, types.CodeInfoFixture(types.WithSHA256CodeHash(wasmCode)), wasmCode))
+	}
+	q := NewQuerier(keeper)
+
+	specs := map[string]struct {
+		checksum   string
+		expCodeIDs []uint64
+		expErr     bool
+	}{
+		"all codes with checksum": {
+			checksum:   hex.EncodeToString(checksum[:]),
+			expCodeIDs: []uint64{1, 3},
+		},
+		"upper case": {
+			checksum:   strings.ToUpper(hex.EncodeToString(checksum[:])),
+			expCodeIDs: []uint64{1, 3},
+		},
+		"unknown checksum": {
+			checksum: hex.EncodeToString(make([]byte, 32)),
+		},
+		"invalid hex": {
+			checksum: "not hex",
+			expErr:   true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			got, err := q.CodeByChecksum(sdk.WrapSDKContext(ctx), &types.QueryCodeByChecksumRequest{Checksum: spec.checksum})
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, got.CodeInfos, len(spec.expCodeIDs))
+			for i, exp := range spec.expCodeIDs {
+				assert.Equal(t, exp, got.CodeInfos[i].CodeID)
+				assert.Equal(t, checksum[:], []byte(got.CodeInfos[i].DataHash))
+			}
+		})
+	}
+}
+
 func fromBase64(s string) []byte {
 	r, err := base64.StdEncoding.DecodeString(s)
 	if err != nil {
@@ -530,3 +687,110 @@ func fromBase64(s string) []byte {
 	}
 	return r
 }
+
+func TestQueryAcceptedStargateQueries(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures)
+	keeper := keepers.WasmKeeper
+
+	specs := map[string]struct {
+		srcAccepted []string
+		expPaths    []string
+	}{
+		"defaults": {
+			srcAccepted: types.DefaultAcceptedStargateQueries,
+			expPaths:    types.DefaultAcceptedStargateQueries,
+		},
+		"sorted": {
+			srcAccepted: []string{"/cosmos.bank.v1beta1.Query/TotalSupply", "/cosmos.bank.v1beta1.Query/Balance"},
+			expPaths:    []string{"/cosmos.bank.v1beta1.Query/Balance", "/cosmos.bank.v1beta1.Query/TotalSupply"},
+		},
+		"without registered response type": {
+			srcAccepted: []string{"/cosmos.bank.v1beta1.Query/Params", "/cosmos.bank.v1beta1.Query/Balance"},
+			expPaths:    []string{"/cosmos.bank.v1beta1.Query/Balance"},
+		},
+		"none": {
+			expPaths: []string{},
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			xCtx, _ := ctx.CacheContext()
+			params := types.DefaultParams()
+			params.AcceptedStargateQueries = spec.srcAccepted
+			keeper.setParams(xCtx, params)
+
+			q := NewQuerier(keeper)
+			got, err := q.AcceptedStargateQueries(sdk.WrapSDKContext(xCtx), &types.QueryAcceptedStargateQueriesRequest{})
+			require.NoError(t, err)
+			assert.Equal(t, spec.expPaths, got.Paths)
+		})
+	}
+}
+
+func TestQueryAcceptedStargateQueriesWithoutParam(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures)
+	// a param store without the param, as on chains that upgrade from a version without it
+	keeper := *keepers.WasmKeeper
+	keeper.paramSpace = keepers.ParamsKeeper.Subspace("legacywasm").WithKeyTable(types.ParamKeyTable())
+	require.False(t, keeper.paramSpace.Has(ctx, types.ParamStoreKeyAcceptedStargateQueries))
+
+	q := NewQuerier(&keeper)
+	got, err := q.AcceptedStargateQueries(sdk.WrapSDKContext(ctx), &types.QueryAcceptedStargateQueriesRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, types.DefaultAcceptedStargateQueries, got.Paths)
+	_, ok := keeper.AcceptedStargateQuery(ctx, "/cosmos.bank.v1beta1.Query/Balance")
+	assert.True(t, ok)
+}
+
+func TestStargateAcceptListsAreNotCharged(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures)
+	keeper := keepers.WasmKeeper
+	ctx = ctx.WithGasMeter(sdk.NewGasMeter(1_000_000))
+
+	_, ok := keeper.AcceptedStargateQuery(ctx, "/cosmos.bank.v1beta1.Query/Balance")
+	assert.True(t, ok)
+	assert.True(t, keeper.AcceptedStargateMsg(ctx, "/cosmwasm.wasm.v1beta1.MsgExecuteContract"))
+	assert.Equal(t, uint64(0), ctx.GasMeter().GasConsumed())
+}
+
+func TestQueryStargateMsgs(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures)
+	keeper := keepers.WasmKeeper
+
+	params := types.DefaultParams()
+	params.AcceptedStargateMsgs = []string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.gov.v1beta1.MsgVote"}
+	params.DeniedStargateMsgs = []string{"/cosmos.gov.v1beta1.MsgVote"}
+	keeper.setParams(ctx, params)
+
+	q := NewQuerier(keeper)
+	got, err := q.StargateMsgs(sdk.WrapSDKContext(ctx), &types.QueryStargateMsgsRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, params.AcceptedStargateMsgs, got.Accepted)
+	assert.Equal(t, params.DeniedStargateMsgs, got.Denied)
+
+	assert.True(t, keeper.AcceptedStargateMsg(ctx, "/cosmos.bank.v1beta1.MsgSend"))
+	// the deny list takes precedence
+	assert.False(t, keeper.AcceptedStargateMsg(ctx, "/cosmos.gov.v1beta1.MsgVote"))
+	assert.False(t, keeper.AcceptedStargateMsg(ctx, "/cosmos.staking.v1beta1.MsgDelegate"))
+}
+
+func TestQueryContractStorageStats(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures)
+	keeper := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+
+	params := keeper.GetParams(ctx)
+	params.MaxContractStorageBytes = 1024
+	params.CodeStorageLimits = []types.CodeStorageLimit{{CodeID: example.CodeID, MaxBytes: 2048}}
+	keeper.setParams(ctx, params)
+
+	q := NewQuerier(keeper)
+	got, err := q.ContractStorageStats(sdk.WrapSDKContext(ctx), &types.QueryContractStorageStatsRequest{Address: example.Contract.String()})
+	require.NoError(t, err)
+	assert.Equal(t, keeper.GetContractStorageStats(ctx, example.Contract), got.Stats)
+	assert.NotZero(t, got.Stats.ByteCount)
+	assert.Equal(t, uint64(2048), got.MaxBytes)
+
+	_, err = q.ContractStorageStats(sdk.WrapSDKContext(ctx), &types.QueryContractStorageStatsRequest{Address: RandomBech32AccountAddress(t)})
+	assert.True(t, types.ErrNotFound.Is(err))
+}
@@ -1,15 +1,22 @@
 package keeper
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"github.com/CosmWasm/wasmd/x/wasm/types"
 	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+	"strings"
 
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	ibctransfertypes "github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
@@ -18,13 +25,16 @@ type QueryHandler struct {
 	Ctx     sdk.Context
 	Plugins wasmVMQueryHandler
 	Caller  sdk.AccAddress
+	// gasMultiplier converts the wasmvm gas limit of a query into sdk gas
+	gasMultiplier uint64
 }
 
-func NewQueryHandler(ctx sdk.Context, vmQueryHandler wasmVMQueryHandler, caller sdk.AccAddress) QueryHandler {
+func NewQueryHandler(ctx sdk.Context, vmQueryHandler wasmVMQueryHandler, caller sdk.AccAddress, gasMultiplier uint64) QueryHandler {
 	return QueryHandler{
-		Ctx:     ctx,
-		Plugins: vmQueryHandler,
-		Caller:  caller,
+		Ctx:           ctx,
+		Plugins:       vmQueryHandler,
+		Caller:        caller,
+		gasMultiplier: gasMultiplier,
 	}
 }
 
@@ -44,7 +54,11 @@ var _ wasmvmtypes.Querier = QueryHandler{}
 
 func (q QueryHandler) Query(request wasmvmtypes.QueryRequest, gasLimit uint64) ([]byte, error) {
 	// set a limit for a subctx
-	sdkGas := gasLimit / GasMultiplier
+	gasMultiplier := q.gasMultiplier
+	if gasMultiplier == 0 {
+		gasMultiplier = types.DefaultGasMultiplier
+	}
+	sdkGas := gasLimit / gasMultiplier
 	subctx := q.Ctx.WithGasMeter(sdk.NewGasMeter(sdkGas))
 
 	// make sure we charge the higher level context even on panic
@@ -73,8 +87,15 @@ type contractMetaDataSource interface {
 	GetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) *types.ContractInfo
 }
 
-type wasmQueryKeeper interface {
+type wasmInfoSource interface {
 	contractMetaDataSource
+	GetCodeInfo(ctx sdk.Context, codeID uint64) *types.CodeInfo
+	IsPinnedCode(ctx sdk.Context, codeID uint64) bool
+}
+
+type wasmQueryKeeper interface {
+	wasmInfoSource
+	stargateAcceptList
 	QueryRaw(ctx sdk.Context, contractAddress sdk.AccAddress, key []byte) []byte
 	QuerySmart(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error)
 }
@@ -92,7 +113,7 @@ func DefaultQueryPlugins(
 		Custom:   NoCustomQuerier,
 		IBC:      IBCQuerier(wasm, channelKeeper),
 		Staking:  StakingQuerier(staking, distKeeper),
-		Stargate: StargateQuerier(queryRouter),
+		Stargate: StargateQuerier(queryRouter, wasm),
 		Wasm:     WasmQuerier(wasm),
 	}
 }
@@ -183,6 +204,36 @@ func NoCustomQuerier(sdk.Context, json.RawMessage) ([]byte, error) {
 	return nil, wasmvmtypes.UnsupportedRequest{Kind: "custom"}
 }
 
+// ExtensionQuerier returns an opt-in custom querier for the distribution, gov, mint, ibc transfer and wasm info queries
+// that contracts send within the custom variant, as the wasmvm QueryRequest type in use has no variants for them. All
+// other custom queries are passed to the fallback querier. Chains whose own custom queries use one of these top level
+// keys must not use it.
+func ExtensionQuerier(queryRouter GRPCQueryRouter, wasm wasmInfoSource, fallback CustomQuerier) CustomQuerier {
+	distribution := DistributionQuerier(queryRouter)
+	gov := GovQuerier(queryRouter)
+	mint := MintQuerier(queryRouter)
+	ibcTransfer := IBCTransferQuerier(queryRouter)
+	wasmInfo := WasmInfoQuerier(wasm)
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var ext types.ExtensionQuery
+		if err := json.Unmarshal(request, &ext); err == nil {
+			switch {
+			case ext.Distribution != nil:
+				return distribution(ctx, ext.Distribution)
+			case ext.Gov != nil:
+				return gov(ctx, ext.Gov)
+			case ext.Mint != nil:
+				return mint(ctx, ext.Mint)
+			case ext.IBCTransfer != nil:
+				return ibcTransfer(ctx, ext.IBCTransfer)
+			case ext.Wasm != nil:
+				return wasmInfo(ctx, ext.Wasm)
+			}
+		}
+		return fallback(ctx, request)
+	}
+}
+
 func IBCQuerier(wasm contractMetaDataSource, channelKeeper types.ChannelKeeper) func(ctx sdk.Context, caller sdk.AccAddress, request *wasmvmtypes.IBCQuery) ([]byte, error) {
 	return func(ctx sdk.Context, caller sdk.AccAddress, request *wasmvmtypes.IBCQuery) ([]byte, error) {
 		if request.PortID != nil {
@@ -253,8 +304,33 @@ func IBCQuerier(wasm contractMetaDataSource, channelKeeper types.ChannelKeeper)
 	}
 }
 
-func StargateQuerier(queryRouter GRPCQueryRouter) func(ctx sdk.Context, request *wasmvmtypes.StargateQuery) ([]byte, error) {
+// AcceptedStargateQueries maps the gRPC query paths that contracts can call to their response types
+type AcceptedStargateQueries map[string]codec.ProtoMarshaler
+
+// DefaultAcceptedStargateQueries returns the response types of the query paths in
+// types.DefaultAcceptedStargateQueries
+func DefaultAcceptedStargateQueries() AcceptedStargateQueries {
+	return AcceptedStargateQueries{
+		"/cosmos.bank.v1beta1.Query/AllBalances": &banktypes.QueryAllBalancesResponse{},
+		"/cosmos.bank.v1beta1.Query/Balance":     &banktypes.QueryBalanceResponse{},
+		"/cosmos.bank.v1beta1.Query/SupplyOf":    &banktypes.QuerySupplyOfResponse{},
+		"/cosmos.bank.v1beta1.Query/TotalSupply": &banktypes.QueryTotalSupplyResponse{},
+	}
+}
+
+type stargateAcceptList interface {
+	// AcceptedStargateQuery returns a new response instance for accepted paths
+	AcceptedStargateQuery(ctx sdk.Context, path string) (codec.ProtoMarshaler, bool)
+}
+
+// StargateQuerier dispatches the accepted queries to the gRPC router. The result is decoded into the registered
+// response type and encoded again so that the contract gets a deterministic result.
+func StargateQuerier(queryRouter GRPCQueryRouter, acceptList stargateAcceptList) func(ctx sdk.Context, request *wasmvmtypes.StargateQuery) ([]byte, error) {
 	return func(ctx sdk.Context, msg *wasmvmtypes.StargateQuery) ([]byte, error) {
+		protoResponse, accepted := acceptList.AcceptedStargateQuery(ctx, msg.Path)
+		if !accepted {
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: fmt.Sprintf("'%s' path is not allowed from the contract", msg.Path)}
+		}
 		route := queryRouter.Route(msg.Path)
 		if route == nil {
 			return nil, wasmvmtypes.UnsupportedRequest{Kind: fmt.Sprintf("No route to query '%s'", msg.Path)}
@@ -267,8 +343,152 @@ func StargateQuerier(queryRouter GRPCQueryRouter) func(ctx sdk.Context, request
 		if err != nil {
 			return nil, err
 		}
-		return res.Value, nil
+		if err := protoResponse.Unmarshal(res.Value); err != nil {
+			return nil, sdkerrors.Wrap(err, "decode response")
+		}
+		return protoResponse.Marshal()
+	}
+}
+
+// DistributionQuerier returns the typed distribution queries. The module is queried via the gRPC router with the
+// gas meter of the contract query.
+func DistributionQuerier(queryRouter GRPCQueryRouter) func(ctx sdk.Context, request *types.DistributionQuery) ([]byte, error) {
+	return func(ctx sdk.Context, request *types.DistributionQuery) ([]byte, error) {
+		switch {
+		case request.DelegatorRewards != nil:
+			if _, err := sdk.AccAddressFromBech32(request.DelegatorRewards.Delegator); err != nil {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, request.DelegatorRewards.Delegator)
+			}
+			var qres distributiontypes.QueryDelegationTotalRewardsResponse
+			req := &distributiontypes.QueryDelegationTotalRewardsRequest{DelegatorAddress: request.DelegatorRewards.Delegator}
+			if err := grpcQuery(ctx, queryRouter, "/cosmos.distribution.v1beta1.Query/DelegationTotalRewards", req, &qres); err != nil {
+				return nil, err
+			}
+			res := types.DelegatorRewardsResponse{
+				Rewards: make([]types.ValidatorRewards, len(qres.Rewards)),
+				Total:   convertSdkDecCoinsToWasmCoins(qres.Total),
+			}
+			for i, r := range qres.Rewards {
+				res.Rewards[i] = types.ValidatorRewards{
+					Validator: r.ValidatorAddress,
+					Reward:    convertSdkDecCoinsToWasmCoins(r.Reward),
+				}
+			}
+			return json.Marshal(res)
+		case request.CommunityPool != nil:
+			var qres distributiontypes.QueryCommunityPoolResponse
+			if err := grpcQuery(ctx, queryRouter, "/cosmos.distribution.v1beta1.Query/CommunityPool", &distributiontypes.QueryCommunityPoolRequest{}, &qres); err != nil {
+				return nil, err
+			}
+			return json.Marshal(types.CommunityPoolResponse{Pool: convertSdkDecCoinsToWasmCoins(qres.Pool)})
+		}
+		return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown DistributionQuery variant"}
+	}
+}
+
+// GovQuerier returns the typed gov queries. The module is queried via the gRPC router with the gas meter of the
+// contract query.
+func GovQuerier(queryRouter GRPCQueryRouter) func(ctx sdk.Context, request *types.GovQuery) ([]byte, error) {
+	return func(ctx sdk.Context, request *types.GovQuery) ([]byte, error) {
+		if request.Proposal != nil {
+			var proposalRes govtypes.QueryProposalResponse
+			if err := grpcQuery(ctx, queryRouter, "/cosmos.gov.v1beta1.Query/Proposal", &govtypes.QueryProposalRequest{ProposalId: request.Proposal.ProposalID}, &proposalRes); err != nil {
+				return nil, err
+			}
+			// the tally is the final one for finished proposals and the current one during the voting period
+			var tallyRes govtypes.QueryTallyResultResponse
+			if err := grpcQuery(ctx, queryRouter, "/cosmos.gov.v1beta1.Query/TallyResult", &govtypes.QueryTallyResultRequest{ProposalId: request.Proposal.ProposalID}, &tallyRes); err != nil {
+				return nil, err
+			}
+			status, err := convertProposalStatus(proposalRes.Proposal.Status)
+			if err != nil {
+				return nil, err
+			}
+			res := types.ProposalResponse{
+				ProposalID: proposalRes.Proposal.ProposalId,
+				Status:     status,
+				Tally: types.TallyResult{
+					Yes:        tallyRes.Tally.Yes.String(),
+					No:         tallyRes.Tally.No.String(),
+					Abstain:    tallyRes.Tally.Abstain.String(),
+					NoWithVeto: tallyRes.Tally.NoWithVeto.String(),
+				},
+			}
+			return json.Marshal(res)
+		}
+		return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown GovQuery variant"}
+	}
+}
+
+func convertProposalStatus(s govtypes.ProposalStatus) (string, error) {
+	switch s {
+	case govtypes.StatusDepositPeriod:
+		return "deposit_period", nil
+	case govtypes.StatusVotingPeriod:
+		return "voting_period", nil
+	case govtypes.StatusPassed:
+		return "passed", nil
+	case govtypes.StatusRejected:
+		return "rejected", nil
+	case govtypes.StatusFailed:
+		return "failed", nil
+	default:
+		return "", sdkerrors.Wrapf(types.ErrInvalid, "proposal status: %s", s)
+	}
+}
+
+// MintQuerier returns the typed mint queries. The module is queried via the gRPC router with the gas meter of the
+// contract query.
+func MintQuerier(queryRouter GRPCQueryRouter) func(ctx sdk.Context, request *types.MintQuery) ([]byte, error) {
+	return func(ctx sdk.Context, request *types.MintQuery) ([]byte, error) {
+		if request.Inflation != nil {
+			var qres minttypes.QueryInflationResponse
+			if err := grpcQuery(ctx, queryRouter, "/cosmos.mint.v1beta1.Query/Inflation", &minttypes.QueryInflationRequest{}, &qres); err != nil {
+				return nil, err
+			}
+			return json.Marshal(types.InflationResponse{Inflation: qres.Inflation.String()})
+		}
+		return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown MintQuery variant"}
+	}
+}
+
+// IBCTransferQuerier returns the typed ics20 queries. The module is queried via the gRPC router with the gas meter
+// of the contract query.
+func IBCTransferQuerier(queryRouter GRPCQueryRouter) func(ctx sdk.Context, request *types.IBCTransferQuery) ([]byte, error) {
+	return func(ctx sdk.Context, request *types.IBCTransferQuery) ([]byte, error) {
+		if request.DenomTrace != nil {
+			hash := strings.TrimPrefix(request.DenomTrace.Denom, ibctransfertypes.DenomPrefix+"/")
+			if _, err := ibctransfertypes.ParseHexHash(hash); err != nil {
+				return nil, sdkerrors.Wrap(ibctransfertypes.ErrInvalidDenomForTransfer, request.DenomTrace.Denom)
+			}
+			var qres ibctransfertypes.QueryDenomTraceResponse
+			if err := grpcQuery(ctx, queryRouter, "/ibc.applications.transfer.v1.Query/DenomTrace", &ibctransfertypes.QueryDenomTraceRequest{Hash: hash}, &qres); err != nil {
+				return nil, err
+			}
+			return json.Marshal(types.DenomTraceResponse{
+				Path:      qres.DenomTrace.Path,
+				BaseDenom: qres.DenomTrace.BaseDenom,
+			})
+		}
+		return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown IBCTransferQuery variant"}
+	}
+}
+
+// grpcQuery runs a typed query against a module via the gRPC router
+func grpcQuery(ctx sdk.Context, queryRouter GRPCQueryRouter, path string, req, res codec.ProtoMarshaler) error {
+	route := queryRouter.Route(path)
+	if route == nil {
+		return wasmvmtypes.UnsupportedRequest{Kind: fmt.Sprintf("No route to query '%s'", path)}
+	}
+	bz, err := req.Marshal()
+	if err != nil {
+		return err
 	}
+	qres, err := route(ctx, abci.RequestQuery{Data: bz, Path: path})
+	if err != nil {
+		return err
+	}
+	return res.Unmarshal(qres.Value)
 }
 
 func StakingQuerier(keeper types.StakingKeeper, distKeeper types.DistributionKeeper) func(ctx sdk.Context, request *wasmvmtypes.StakingQuery) ([]byte, error) {
@@ -458,6 +678,61 @@ func WasmQuerier(wasm wasmQueryKeeper) func(ctx sdk.Context, request *wasmvmtype
 	}
 }
 
+const (
+	// ContractInfoQueryCost is the flat gas cost of a contract info query
+	ContractInfoQueryCost uint64 = 3_000
+	// CodeInfoQueryCost is the flat gas cost of a code info query
+	CodeInfoQueryCost uint64 = 2_000
+)
+
+// WasmInfoQuerier returns the metadata of other contracts and codes. The queries charge a flat gas cost, the store
+// reads are not metered so that the cost does not depend on the size of the stored metadata.
+func WasmInfoQuerier(wasm wasmInfoSource) func(ctx sdk.Context, request *types.WasmQuery) ([]byte, error) {
+	return func(ctx sdk.Context, request *types.WasmQuery) ([]byte, error) {
+		switch {
+		case request.ContractInfo != nil:
+			addr, err := sdk.AccAddressFromBech32(request.ContractInfo.ContractAddr)
+			if err != nil {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, request.ContractInfo.ContractAddr)
+			}
+			ctx.GasMeter().ConsumeGas(ContractInfoQueryCost, "contract info query")
+			infoCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+			info := wasm.GetContractInfo(infoCtx, addr)
+			if info == nil {
+				return nil, wasmvmtypes.NoSuchContract{Addr: request.ContractInfo.ContractAddr}
+			}
+			return json.Marshal(types.ContractInfoQueryResponse{
+				CodeID:  info.CodeID,
+				Creator: info.Creator,
+				Admin:   info.Admin,
+				Label:   info.Label,
+				Pinned:  wasm.IsPinnedCode(infoCtx, info.CodeID),
+				Paused:  info.Paused,
+				IBCPort: info.IBCPortID,
+			})
+		case request.CodeInfo != nil:
+			ctx.GasMeter().ConsumeGas(CodeInfoQueryCost, "code info query")
+			infoCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+			info := wasm.GetCodeInfo(infoCtx, request.CodeInfo.CodeID)
+			if info == nil {
+				return nil, sdkerrors.Wrapf(types.ErrNotFound, "code id %d", request.CodeInfo.CodeID)
+			}
+			return json.Marshal(types.CodeInfoQueryResponse{
+				CodeID:   request.CodeInfo.CodeID,
+				Creator:  info.Creator,
+				Checksum: hex.EncodeToString(info.CodeHash),
+				Pinned:   wasm.IsPinnedCode(infoCtx, request.CodeInfo.CodeID),
+				InstantiateConfig: types.AccessConfigResponse{
+					Permission: info.InstantiateConfig.Permission.String(),
+					Address:    info.InstantiateConfig.Address,
+					Addresses:  info.InstantiateConfig.Addresses,
+				},
+			})
+		}
+		return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown WasmQuery variant"}
+	}
+}
+
 func convertSdkCoinsToWasmCoins(coins []sdk.Coin) wasmvmtypes.Coins {
 	converted := make(wasmvmtypes.Coins, len(coins))
 	for i, c := range coins {
@@ -466,6 +741,12 @@ func convertSdkCoinsToWasmCoins(coins []sdk.Coin) wasmvmtypes.Coins {
 	return converted
 }
 
+func convertSdkDecCoinsToWasmCoins(coins sdk.DecCoins) wasmvmtypes.Coins {
+	// truncate the decimal amounts, as it is done for the staking rewards
+	truncated, _ := coins.TruncateDecimal()
+	return convertSdkCoinsToWasmCoins(truncated)
+}
+
 func convertSdkCoinToWasmCoin(coin sdk.Coin) wasmvmtypes.Coin {
 	return wasmvmtypes.Coin{
 		Denom:  coin.Denom,
@@ -1,14 +1,25 @@
 package keeper
 
 import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
 	"github.com/CosmWasm/wasmd/x/wasm/keeper/wasmtesting"
 	"github.com/CosmWasm/wasmd/x/wasm/types"
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	ibctransfertypes "github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
 	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
 	"testing"
 )
 
@@ -241,6 +252,8 @@ func TestIBCQuerier(t *testing.T) {
 
 type wasmKeeperMock struct {
 	GetContractInfoFn func(ctx sdk.Context, contractAddress sdk.AccAddress) *types.ContractInfo
+	GetCodeInfoFn     func(ctx sdk.Context, codeID uint64) *types.CodeInfo
+	IsPinnedCodeFn    func(ctx sdk.Context, codeID uint64) bool
 }
 
 func newWasmKeeperMock(f func(ctx sdk.Context, contractAddress sdk.AccAddress) *types.ContractInfo) *wasmKeeperMock {
@@ -253,3 +266,397 @@ func (m wasmKeeperMock) GetContractInfo(ctx sdk.Context, contractAddress sdk.Acc
 	}
 	return m.GetContractInfoFn(ctx, contractAddress)
 }
+
+func TestStargateQuerier(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures)
+	creator := createFakeFundedAccount(t, ctx, keepers.AccountKeeper, keepers.BankKeeper, sdk.NewCoins(sdk.NewInt64Coin("denom", 100)))
+	router := baseapp.NewGRPCQueryRouter()
+	router.SetInterfaceRegistry(MakeEncodingConfig(t).InterfaceRegistry)
+	banktypes.RegisterQueryServer(router, keepers.BankKeeper)
+
+	balanceQuery, err := (&banktypes.QueryBalanceRequest{Address: creator.String(), Denom: "denom"}).Marshal()
+	require.NoError(t, err)
+	specs := map[string]struct {
+		srcPath     string
+		srcAccepted []string
+		expErr      bool
+	}{
+		"accepted": {
+			srcPath:     "/cosmos.bank.v1beta1.Query/Balance",
+			srcAccepted: types.DefaultAcceptedStargateQueries,
+		},
+		"not in params": {
+			srcPath:     "/cosmos.bank.v1beta1.Query/Balance",
+			srcAccepted: []string{"/cosmos.bank.v1beta1.Query/AllBalances"},
+			expErr:      true,
+		},
+		"no registered response type": {
+			srcPath:     "/cosmos.bank.v1beta1.Query/Params",
+			srcAccepted: []string{"/cosmos.bank.v1beta1.Query/Params"},
+			expErr:      true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.AcceptedStargateQueries = spec.srcAccepted
+			keepers.WasmKeeper.setParams(ctx, params)
+
+			h := StargateQuerier(router, keepers.WasmKeeper)
+			gotResult, gotErr := h(ctx, &wasmvmtypes.StargateQuery{Path: spec.srcPath, Data: balanceQuery})
+			if spec.expErr {
+				assert.IsType(t, wasmvmtypes.UnsupportedRequest{}, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			var res banktypes.QueryBalanceResponse
+			require.NoError(t, res.Unmarshal(gotResult))
+			assert.Equal(t, sdk.NewInt64Coin("denom", 100), *res.Balance)
+		})
+	}
+}
+
+func TestStargateQuerierReencodesResponse(t *testing.T) {
+	const path = "/cosmos.bank.v1beta1.Query/Balance"
+	balance := sdk.NewInt64Coin("denom", 1)
+	bz, err := (&banktypes.QueryBalanceResponse{Balance: &balance}).Marshal()
+	require.NoError(t, err)
+	// an unknown field is dropped when the response is encoded again
+	unknownField := []byte{0x10, 0x01}
+	router := mockGRPCQueryRouter{
+		path: func(ctx sdk.Context, req abci.RequestQuery) (abci.ResponseQuery, error) {
+			return abci.ResponseQuery{Value: append(bz, unknownField...)}, nil
+		},
+	}
+	acceptList := stargateAcceptListFn(func(ctx sdk.Context, p string) (codec.ProtoMarshaler, bool) {
+		return &banktypes.QueryBalanceResponse{}, p == path
+	})
+	gotResult, gotErr := StargateQuerier(router, acceptList)(sdk.Context{}, &wasmvmtypes.StargateQuery{Path: path})
+	require.NoError(t, gotErr)
+	assert.Equal(t, bz, gotResult)
+}
+
+type stargateAcceptListFn func(ctx sdk.Context, path string) (codec.ProtoMarshaler, bool)
+
+func (f stargateAcceptListFn) AcceptedStargateQuery(ctx sdk.Context, path string) (codec.ProtoMarshaler, bool) {
+	return f(ctx, path)
+}
+
+func TestMintQuerier(t *testing.T) {
+	router := mockGRPCQueryRouter{
+		"/cosmos.mint.v1beta1.Query/Inflation": mockGRPCResponse(t, &minttypes.QueryInflationResponse{Inflation: sdk.NewDecWithPrec(13, 2)}),
+	}
+	h := MintQuerier(router)
+	gotResult, gotErr := h(sdk.Context{}, &types.MintQuery{Inflation: &types.InflationQuery{}})
+	require.NoError(t, gotErr)
+	assert.JSONEq(t, `{"inflation":"0.130000000000000000"}`, string(gotResult))
+
+	// unknown variant
+	_, gotErr = h(sdk.Context{}, &types.MintQuery{})
+	assert.IsType(t, wasmvmtypes.UnsupportedRequest{}, gotErr)
+	// module not available
+	_, gotErr = MintQuerier(mockGRPCQueryRouter{})(sdk.Context{}, &types.MintQuery{Inflation: &types.InflationQuery{}})
+	assert.IsType(t, wasmvmtypes.UnsupportedRequest{}, gotErr)
+}
+
+func TestIBCTransferQuerier(t *testing.T) {
+	trace := ibctransfertypes.DenomTrace{Path: "transfer/channel-0", BaseDenom: "uatom"}
+	router := mockGRPCQueryRouter{
+		"/ibc.applications.transfer.v1.Query/DenomTrace": func(ctx sdk.Context, req abci.RequestQuery) (abci.ResponseQuery, error) {
+			var q ibctransfertypes.QueryDenomTraceRequest
+			require.NoError(t, q.Unmarshal(req.Data))
+			require.Equal(t, trace.Hash().String(), q.Hash)
+			bz, err := (&ibctransfertypes.QueryDenomTraceResponse{DenomTrace: &trace}).Marshal()
+			require.NoError(t, err)
+			return abci.ResponseQuery{Value: bz}, nil
+		},
+	}
+	specs := map[string]struct {
+		srcDenom  string
+		expResult string
+		expErr    *sdkerrors.Error
+	}{
+		"ibc denom": {
+			srcDenom:  trace.IBCDenom(),
+			expResult: `{"path":"transfer/channel-0","base_denom":"uatom"}`,
+		},
+		"hash only": {
+			srcDenom:  trace.Hash().String(),
+			expResult: `{"path":"transfer/channel-0","base_denom":"uatom"}`,
+		},
+		"invalid hash": {
+			srcDenom: "ibc/foo",
+			expErr:   ibctransfertypes.ErrInvalidDenomForTransfer,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			h := IBCTransferQuerier(router)
+			gotResult, gotErr := h(sdk.Context{}, &types.IBCTransferQuery{DenomTrace: &types.DenomTraceQuery{Denom: spec.srcDenom}})
+			require.True(t, spec.expErr.Is(gotErr), "exp %v but got %#+v", spec.expErr, gotErr)
+			if spec.expErr != nil {
+				return
+			}
+			assert.JSONEq(t, spec.expResult, string(gotResult), string(gotResult))
+		})
+	}
+}
+
+func TestDistributionQuerier(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures)
+	router := baseapp.NewGRPCQueryRouter()
+	router.SetInterfaceRegistry(MakeEncodingConfig(t).InterfaceRegistry)
+	distributiontypes.RegisterQueryServer(router, keepers.DistKeeper)
+
+	funder := createFakeFundedAccount(t, ctx, keepers.AccountKeeper, keepers.BankKeeper, sdk.NewCoins(sdk.NewInt64Coin("denom", 100)))
+	require.NoError(t, keepers.DistKeeper.FundCommunityPool(ctx, sdk.NewCoins(sdk.NewInt64Coin("denom", 100)), funder))
+
+	h := DistributionQuerier(router)
+	gotResult, gotErr := h(ctx, &types.DistributionQuery{CommunityPool: &types.CommunityPoolQuery{}})
+	require.NoError(t, gotErr)
+	assert.JSONEq(t, `{"pool":[{"denom":"denom","amount":"100"}]}`, string(gotResult))
+
+	gotResult, gotErr = h(ctx, &types.DistributionQuery{DelegatorRewards: &types.DelegatorRewardsQuery{Delegator: funder.String()}})
+	require.NoError(t, gotErr)
+	assert.JSONEq(t, `{"rewards":[],"total":[]}`, string(gotResult))
+
+	_, gotErr = h(ctx, &types.DistributionQuery{DelegatorRewards: &types.DelegatorRewardsQuery{Delegator: "invalid"}})
+	assert.True(t, sdkerrors.ErrInvalidAddress.Is(gotErr))
+}
+
+func TestGovQuerier(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures)
+	govKeeper := keepers.GovKeeper
+	router := baseapp.NewGRPCQueryRouter()
+	router.SetInterfaceRegistry(MakeEncodingConfig(t).InterfaceRegistry)
+	govtypes.RegisterQueryServer(router, govKeeper)
+
+	proposal, err := govKeeper.SubmitProposal(ctx, govtypes.NewTextProposal("foo", "bar"))
+	require.NoError(t, err)
+
+	h := GovQuerier(router)
+	gotResult, gotErr := h(ctx, &types.GovQuery{Proposal: &types.ProposalQuery{ProposalID: proposal.ProposalId}})
+	require.NoError(t, gotErr)
+	var res types.ProposalResponse
+	require.NoError(t, json.Unmarshal(gotResult, &res))
+	assert.Equal(t, types.ProposalResponse{
+		ProposalID: proposal.ProposalId,
+		Status:     "deposit_period",
+		Tally:      types.TallyResult{Yes: "0", No: "0", Abstain: "0", NoWithVeto: "0"},
+	}, res)
+
+	// unknown proposal
+	_, gotErr = h(ctx, &types.GovQuery{Proposal: &types.ProposalQuery{ProposalID: proposal.ProposalId + 1}})
+	assert.Error(t, gotErr)
+}
+
+func TestExtensionQuerier(t *testing.T) {
+	router := mockGRPCQueryRouter{
+		"/cosmos.mint.v1beta1.Query/Inflation": mockGRPCResponse(t, &minttypes.QueryInflationResponse{Inflation: sdk.NewDecWithPrec(13, 2)}),
+	}
+	fallback := func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		return []byte(`"custom"`), nil
+	}
+	plugins := QueryPlugins{Custom: ExtensionQuerier(router, wasmKeeperMock{}, fallback)}
+	gotResult, gotErr := plugins.HandleQuery(sdk.Context{}, RandomAccountAddress(t), wasmvmtypes.QueryRequest{Custom: []byte(`{"mint":{"inflation":{}}}`)})
+	require.NoError(t, gotErr)
+	assert.JSONEq(t, `{"inflation":"0.130000000000000000"}`, string(gotResult))
+
+	// other custom queries are passed through
+	gotResult, gotErr = plugins.HandleQuery(sdk.Context{}, RandomAccountAddress(t), wasmvmtypes.QueryRequest{Custom: []byte(`{"foo":{}}`)})
+	require.NoError(t, gotErr)
+	assert.Equal(t, `"custom"`, string(gotResult))
+}
+
+func TestHandleCustomQueryIsChainSpecific(t *testing.T) {
+	var gotQuery json.RawMessage
+	plugins := QueryPlugins{
+		Custom: func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+			gotQuery = request
+			return []byte(`"custom"`), nil
+		},
+	}
+	// without the opt-in extension querier a custom query with a known extension key goes to the chain querier
+	gotResult, gotErr := plugins.HandleQuery(sdk.Context{}, RandomAccountAddress(t), wasmvmtypes.QueryRequest{Custom: []byte(`{"gov":{"proposal":{"proposal_id":1}}}`)})
+	require.NoError(t, gotErr)
+	assert.Equal(t, `"custom"`, string(gotResult))
+	assert.Equal(t, json.RawMessage(`{"gov":{"proposal":{"proposal_id":1}}}`), gotQuery)
+}
+
+func TestQueryHandlerZeroGasMultiplier(t *testing.T) {
+	ctx := sdk.Context{}.WithGasMeter(sdk.NewInfiniteGasMeter())
+	var gotLimit uint64
+	plugins := QueryPlugins{
+		Custom: func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+			gotLimit = ctx.GasMeter().Limit()
+			return []byte(`{}`), nil
+		},
+	}
+	// a zero multiplier falls back to the default instead of dividing by zero
+	q := NewQueryHandler(ctx, plugins, RandomAccountAddress(t), 0)
+	_, err := q.Query(wasmvmtypes.QueryRequest{Custom: []byte(`{}`)}, 1000*types.DefaultGasMultiplier)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1000), gotLimit)
+}
+
+// mockGRPCQueryRouter maps query paths to handlers
+type mockGRPCQueryRouter map[string]baseapp.GRPCQueryHandler
+
+func (m mockGRPCQueryRouter) Route(path string) baseapp.GRPCQueryHandler {
+	return m[path]
+}
+
+func mockGRPCResponse(t *testing.T, res codec.ProtoMarshaler) baseapp.GRPCQueryHandler {
+	return func(ctx sdk.Context, req abci.RequestQuery) (abci.ResponseQuery, error) {
+		bz, err := res.Marshal()
+		require.NoError(t, err)
+		return abci.ResponseQuery{Value: bz}, nil
+	}
+}
+
+func (m wasmKeeperMock) GetCodeInfo(ctx sdk.Context, codeID uint64) *types.CodeInfo {
+	if m.GetCodeInfoFn == nil {
+		panic("not expected to be called")
+	}
+	return m.GetCodeInfoFn(ctx, codeID)
+}
+
+func (m wasmKeeperMock) IsPinnedCode(ctx sdk.Context, codeID uint64) bool {
+	if m.IsPinnedCodeFn == nil {
+		panic("not expected to be called")
+	}
+	return m.IsPinnedCodeFn(ctx, codeID)
+}
+
+func TestWasmInfoQuerier(t *testing.T) {
+	myAddr := RandomBech32AccountAddress(t)
+	myCreator := RandomBech32AccountAddress(t)
+	myAdmin := RandomBech32AccountAddress(t)
+	specs := map[string]struct {
+		srcQuery      *types.WasmQuery
+		wasmKeeper    *wasmKeeperMock
+		expJsonResult string
+		expErr        error
+		expGas        uint64
+	}{
+		"contract info": {
+			srcQuery: &types.WasmQuery{ContractInfo: &types.ContractInfoQuery{ContractAddr: myAddr}},
+			wasmKeeper: &wasmKeeperMock{
+				GetContractInfoFn: func(ctx sdk.Context, contractAddress sdk.AccAddress) *types.ContractInfo {
+					ctx.GasMeter().ConsumeGas(100, "not charged")
+					return &types.ContractInfo{CodeID: 1, Creator: myCreator, Admin: myAdmin, Label: "my label", IBCPortID: "myPort"}
+				},
+				IsPinnedCodeFn: func(ctx sdk.Context, codeID uint64) bool { return codeID == 1 },
+			},
+			expJsonResult: fmt.Sprintf(`{"code_id":1,"creator":%q,"admin":%q,"label":"my label","pinned":true,"paused":false,"ibc_port":"myPort"}`, myCreator, myAdmin),
+			expGas:        ContractInfoQueryCost,
+		},
+		"contract info without admin and port": {
+			srcQuery: &types.WasmQuery{ContractInfo: &types.ContractInfoQuery{ContractAddr: myAddr}},
+			wasmKeeper: &wasmKeeperMock{
+				GetContractInfoFn: func(ctx sdk.Context, contractAddress sdk.AccAddress) *types.ContractInfo {
+					return &types.ContractInfo{CodeID: 2, Creator: myCreator, Label: "my label", Paused: true}
+				},
+				IsPinnedCodeFn: func(ctx sdk.Context, codeID uint64) bool { return false },
+			},
+			expJsonResult: fmt.Sprintf(`{"code_id":2,"creator":%q,"label":"my label","pinned":false,"paused":true}`, myCreator),
+			expGas:        ContractInfoQueryCost,
+		},
+		"contract info - unknown contract": {
+			srcQuery: &types.WasmQuery{ContractInfo: &types.ContractInfoQuery{ContractAddr: myAddr}},
+			wasmKeeper: &wasmKeeperMock{
+				GetContractInfoFn: func(ctx sdk.Context, contractAddress sdk.AccAddress) *types.ContractInfo {
+					return nil
+				},
+			},
+			expErr: wasmvmtypes.NoSuchContract{Addr: myAddr},
+			expGas: ContractInfoQueryCost,
+		},
+		"contract info - invalid address": {
+			srcQuery:   &types.WasmQuery{ContractInfo: &types.ContractInfoQuery{ContractAddr: "invalid"}},
+			wasmKeeper: &wasmKeeperMock{},
+			expErr:     sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid"),
+		},
+		"code info": {
+			srcQuery: &types.WasmQuery{CodeInfo: &types.CodeInfoQuery{CodeID: 1}},
+			wasmKeeper: &wasmKeeperMock{
+				GetCodeInfoFn: func(ctx sdk.Context, codeID uint64) *types.CodeInfo {
+					ctx.GasMeter().ConsumeGas(100, "not charged")
+					return &types.CodeInfo{CodeHash: []byte{0xab, 0xcd}, Creator: myCreator, InstantiateConfig: types.AllowEverybody}
+				},
+				IsPinnedCodeFn: func(ctx sdk.Context, codeID uint64) bool {
+					ctx.GasMeter().ConsumeGas(100, "not charged")
+					return true
+				},
+			},
+			expJsonResult: fmt.Sprintf(`{"code_id":1,"creator":%q,"checksum":"abcd","pinned":true,"instantiate_config":{"permission":"Everybody"}}`, myCreator),
+			expGas:        CodeInfoQueryCost,
+		},
+		"code info - restricted instantiation": {
+			srcQuery: &types.WasmQuery{CodeInfo: &types.CodeInfoQuery{CodeID: 1}},
+			wasmKeeper: &wasmKeeperMock{
+				GetCodeInfoFn: func(ctx sdk.Context, codeID uint64) *types.CodeInfo {
+					return &types.CodeInfo{CodeHash: []byte{0xab, 0xcd}, Creator: myCreator, InstantiateConfig: types.AccessConfig{Permission: types.AccessTypeOnlyAddress, Address: myAddr}}
+				},
+				IsPinnedCodeFn: func(ctx sdk.Context, codeID uint64) bool { return false },
+			},
+			expJsonResult: fmt.Sprintf(`{"code_id":1,"creator":%q,"checksum":"abcd","pinned":false,"instantiate_config":{"permission":"OnlyAddress","address":%q}}`, myCreator, myAddr),
+			expGas:        CodeInfoQueryCost,
+		},
+		"code info - unknown code": {
+			srcQuery: &types.WasmQuery{CodeInfo: &types.CodeInfoQuery{CodeID: 1}},
+			wasmKeeper: &wasmKeeperMock{
+				GetCodeInfoFn: func(ctx sdk.Context, codeID uint64) *types.CodeInfo {
+					return nil
+				},
+			},
+			expErr: sdkerrors.Wrap(types.ErrNotFound, "code id 1"),
+			expGas: CodeInfoQueryCost,
+		},
+		"unknown variant": {
+			srcQuery:   &types.WasmQuery{},
+			wasmKeeper: &wasmKeeperMock{},
+			expErr:     wasmvmtypes.UnsupportedRequest{Kind: "unknown WasmQuery variant"},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx := sdk.Context{}.WithGasMeter(sdk.NewInfiniteGasMeter())
+			h := WasmInfoQuerier(spec.wasmKeeper)
+			gotResult, gotErr := h(ctx, spec.srcQuery)
+			assert.Equal(t, spec.expGas, ctx.GasMeter().GasConsumed())
+			if spec.expErr != nil {
+				require.Error(t, gotErr)
+				assert.Equal(t, spec.expErr.Error(), gotErr.Error())
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.JSONEq(t, spec.expJsonResult, string(gotResult), string(gotResult))
+		})
+	}
+}
+
+func TestWasmInfoQuerierIntegration(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures)
+	keeper := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	require.NoError(t, keeper.PinCode(ctx, example.CodeID))
+
+	h := WasmInfoQuerier(keeper)
+	gotResult, gotErr := h(ctx, &types.WasmQuery{ContractInfo: &types.ContractInfoQuery{ContractAddr: example.Contract.String()}})
+	require.NoError(t, gotErr)
+	var contractRes types.ContractInfoQueryResponse
+	require.NoError(t, json.Unmarshal(gotResult, &contractRes))
+	assert.Equal(t, example.CodeID, contractRes.CodeID)
+	assert.Equal(t, example.CreatorAddr.String(), contractRes.Creator)
+	assert.True(t, contractRes.Pinned)
+
+	gotResult, gotErr = h(ctx, &types.WasmQuery{CodeInfo: &types.CodeInfoQuery{CodeID: example.CodeID}})
+	require.NoError(t, gotErr)
+	var codeRes types.CodeInfoQueryResponse
+	require.NoError(t, json.Unmarshal(gotResult, &codeRes))
+	assert.Equal(t, example.CodeID, codeRes.CodeID)
+	assert.Equal(t, hex.EncodeToString(keeper.GetCodeInfo(ctx, example.CodeID).CodeHash), codeRes.Checksum)
+	assert.True(t, codeRes.Pinned)
+	assert.Equal(t, types.AccessTypeEverybody.String(), codeRes.InstantiateConfig.Permission)
+}
@@ -164,6 +164,57 @@ func TestReflectContractSend(t *testing.T) {
 
 }
 
+func TestReflectInstantiate2ViaStargateMsg(t *testing.T) {
+	cdc := MakeEncodingConfig(t).Marshaler
+	ctx, keepers := CreateTestInput(t, false, ReflectFeatures, WithMessageEncoders(reflectEncoders(cdc)))
+	accKeeper, keeper, bankKeeper := keepers.AccountKeeper, keepers.WasmKeeper, keepers.BankKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator := createFakeFundedAccount(t, ctx, accKeeper, bankKeeper, deposit)
+
+	reflectCode, err := ioutil.ReadFile("./testdata/reflect.wasm")
+	require.NoError(t, err)
+	reflectID, err := keeper.Create(ctx, creator, reflectCode, "", "", nil)
+	require.NoError(t, err)
+	reflectAddr, _, err := keeper.Instantiate(ctx, reflectID, creator, nil, []byte("{}"), "reflect contract", nil)
+	require.NoError(t, err)
+
+	// the factory contract precomputes the address of the contract it creates
+	salt := []byte("my salt")
+	expAddr := types.BuildContractAddressPredictable(keeper.GetCodeInfo(ctx, reflectID).CodeHash, reflectAddr, salt)
+
+	instantiateMsg := &types.MsgInstantiateContract2{
+		Sender:  reflectAddr.String(),
+		CodeID:  reflectID,
+		Label:   "child contract",
+		InitMsg: []byte("{}"),
+		Salt:    salt,
+	}
+	protoBz, err := cdc.MarshalBinaryBare(instantiateMsg)
+	require.NoError(t, err)
+	reflectSendBz, err := json.Marshal(ReflectHandleMsg{
+		Reflect: &reflectPayload{
+			Msgs: []wasmvmtypes.CosmosMsg{{
+				Stargate: &wasmvmtypes.StargateMsg{
+					TypeURL: "/cosmwasm.wasm.v1beta1.MsgInstantiateContract2",
+					Value:   protoBz,
+				},
+			}},
+		},
+	})
+	require.NoError(t, err)
+
+	// when
+	_, err = keeper.Execute(ctx, reflectAddr, creator, reflectSendBz, nil)
+
+	// then
+	require.NoError(t, err)
+	info := keeper.GetContractInfo(ctx, expAddr)
+	require.NotNil(t, info)
+	assert.Equal(t, reflectAddr.String(), info.Creator)
+	assert.Equal(t, "child contract", info.Label)
+}
+
 func TestReflectCustomMsg(t *testing.T) {
 	cdc := MakeEncodingConfig(t).Marshaler
 	ctx, keepers := CreateTestInput(t, false, ReflectFeatures, WithMessageEncoders(reflectEncoders(cdc)), WithQueryPlugins(reflectPlugins()))
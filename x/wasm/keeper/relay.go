@@ -21,20 +21,29 @@ func (k Keeper) OnOpenChannel(
 ) error {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-open-channel")
 
-	_, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
+	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
 	if err != nil {
 		return err
 	}
+	if contractInfo.Paused {
+		return types.ErrContractPaused
+	}
 
 	env := types.NewEnv(ctx, contractAddr)
-	querier := NewQueryHandler(ctx, k.wasmVMQueryHandler, contractAddr)
+	querier := NewQueryHandler(ctx, k.wasmVMQueryHandler, contractAddr, k.GetGasMultiplier(ctx))
 
-	gas := gasForContract(ctx)
-	gasUsed, execErr := k.wasmVM.IBCChannelOpen(codeInfo.CodeHash, env, channel, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gas)
-	consumeGas(ctx, gasUsed)
+	gas := k.gasForContract(ctx)
+	gasUsed, execErr := k.wasmVM.IBCChannelOpen(codeInfo.CodeHash, env, channel, prefixStore, k.cosmwasmAPI(ctx), querier, ctx.GasMeter(), gas)
+	k.consumeGas(ctx, gasUsed)
+	if storeErr := contractStoreErr(prefixStore); storeErr != nil {
+		return storeErr
+	}
 	if execErr != nil {
 		return sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
+	if err := k.settleStorageDeposit(ctx, contractAddr, prefixStore, nil); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -56,20 +65,30 @@ func (k Keeper) OnConnectChannel(
 	if err != nil {
 		return err
 	}
+	if contractInfo.Paused {
+		return types.ErrContractPaused
+	}
 
 	env := types.NewEnv(ctx, contractAddr)
-	querier := NewQueryHandler(ctx, k.wasmVMQueryHandler, contractAddr)
+	querier := NewQueryHandler(ctx, k.wasmVMQueryHandler, contractAddr, k.GetGasMultiplier(ctx))
 
-	gas := gasForContract(ctx)
-	res, gasUsed, execErr := k.wasmVM.IBCChannelConnect(codeInfo.CodeHash, env, channel, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gas)
-	consumeGas(ctx, gasUsed)
+	gas := k.gasForContract(ctx)
+	res, gasUsed, execErr := k.wasmVM.IBCChannelConnect(codeInfo.CodeHash, env, channel, prefixStore, k.cosmwasmAPI(ctx), querier, ctx.GasMeter(), gas)
+	k.consumeGas(ctx, gasUsed)
+	if storeErr := contractStoreErr(prefixStore); storeErr != nil {
+		return storeErr
+	}
 	if execErr != nil {
 		return sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
+	if err := k.settleStorageDeposit(ctx, contractAddr, prefixStore, nil); err != nil {
+		return err
+	}
 
 	// emit all events from this contract itself
-	events := types.ParseEvents(res.Attributes, contractAddr)
-	ctx.EventManager().EmitEvents(events)
+	if err := emitContractEvents(ctx, contractAddr, res.Attributes, nil); err != nil {
+		return err
+	}
 
 	if err := k.dispatchAll(ctx, contractAddr, contractInfo.IBCPortID, res.Submessages, res.Messages); err != nil {
 		return err
@@ -94,20 +113,30 @@ func (k Keeper) OnCloseChannel(
 	if err != nil {
 		return err
 	}
+	if contractInfo.Paused {
+		return types.ErrContractPaused
+	}
 
 	params := types.NewEnv(ctx, contractAddr)
-	querier := NewQueryHandler(ctx, k.wasmVMQueryHandler, contractAddr)
+	querier := NewQueryHandler(ctx, k.wasmVMQueryHandler, contractAddr, k.GetGasMultiplier(ctx))
 
-	gas := gasForContract(ctx)
-	res, gasUsed, execErr := k.wasmVM.IBCChannelClose(codeInfo.CodeHash, params, channel, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gas)
-	consumeGas(ctx, gasUsed)
+	gas := k.gasForContract(ctx)
+	res, gasUsed, execErr := k.wasmVM.IBCChannelClose(codeInfo.CodeHash, params, channel, prefixStore, k.cosmwasmAPI(ctx), querier, ctx.GasMeter(), gas)
+	k.consumeGas(ctx, gasUsed)
+	if storeErr := contractStoreErr(prefixStore); storeErr != nil {
+		return storeErr
+	}
 	if execErr != nil {
 		return sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
+	if err := k.settleStorageDeposit(ctx, contractAddr, prefixStore, nil); err != nil {
+		return err
+	}
 
 	// emit all events from this contract itself
-	events := types.ParseEvents(res.Attributes, contractAddr)
-	ctx.EventManager().EmitEvents(events)
+	if err := emitContractEvents(ctx, contractAddr, res.Attributes, nil); err != nil {
+		return err
+	}
 
 	if err := k.dispatchAll(ctx, contractAddr, contractInfo.IBCPortID, res.Submessages, res.Messages); err != nil {
 		return err
@@ -131,20 +160,30 @@ func (k Keeper) OnRecvPacket(
 	if err != nil {
 		return nil, err
 	}
+	if contractInfo.Paused {
+		return nil, types.ErrContractPaused
+	}
 
 	env := types.NewEnv(ctx, contractAddr)
-	querier := NewQueryHandler(ctx, k.wasmVMQueryHandler, contractAddr)
+	querier := NewQueryHandler(ctx, k.wasmVMQueryHandler, contractAddr, k.GetGasMultiplier(ctx))
 
-	gas := gasForContract(ctx)
-	res, gasUsed, execErr := k.wasmVM.IBCPacketReceive(codeInfo.CodeHash, env, packet, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gas)
-	consumeGas(ctx, gasUsed)
+	gas := k.gasForContract(ctx)
+	res, gasUsed, execErr := k.wasmVM.IBCPacketReceive(codeInfo.CodeHash, env, packet, prefixStore, k.cosmwasmAPI(ctx), querier, ctx.GasMeter(), gas)
+	k.consumeGas(ctx, gasUsed)
+	if storeErr := contractStoreErr(prefixStore); storeErr != nil {
+		return nil, storeErr
+	}
 	if execErr != nil {
 		return nil, sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
+	if err := k.settleStorageDeposit(ctx, contractAddr, prefixStore, nil); err != nil {
+		return nil, err
+	}
 
 	// emit all events from this contract itself
-	events := types.ParseEvents(res.Attributes, contractAddr)
-	ctx.EventManager().EmitEvents(events)
+	if err := emitContractEvents(ctx, contractAddr, res.Attributes, nil); err != nil {
+		return nil, err
+	}
 
 	if err := k.dispatchAll(ctx, contractAddr, contractInfo.IBCPortID, res.Submessages, res.Messages); err != nil {
 		return nil, err
@@ -169,20 +208,30 @@ func (k Keeper) OnAckPacket(
 	if err != nil {
 		return err
 	}
+	if contractInfo.Paused {
+		return types.ErrContractPaused
+	}
 
 	env := types.NewEnv(ctx, contractAddr)
-	querier := NewQueryHandler(ctx, k.wasmVMQueryHandler, contractAddr)
+	querier := NewQueryHandler(ctx, k.wasmVMQueryHandler, contractAddr, k.GetGasMultiplier(ctx))
 
-	gas := gasForContract(ctx)
-	res, gasUsed, execErr := k.wasmVM.IBCPacketAck(codeInfo.CodeHash, env, acknowledgement, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gas)
-	consumeGas(ctx, gasUsed)
+	gas := k.gasForContract(ctx)
+	res, gasUsed, execErr := k.wasmVM.IBCPacketAck(codeInfo.CodeHash, env, acknowledgement, prefixStore, k.cosmwasmAPI(ctx), querier, ctx.GasMeter(), gas)
+	k.consumeGas(ctx, gasUsed)
+	if storeErr := contractStoreErr(prefixStore); storeErr != nil {
+		return storeErr
+	}
 	if execErr != nil {
 		return sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
+	if err := k.settleStorageDeposit(ctx, contractAddr, prefixStore, nil); err != nil {
+		return err
+	}
 
 	// emit all events from this contract itself
-	events := types.ParseEvents(res.Attributes, contractAddr)
-	ctx.EventManager().EmitEvents(events)
+	if err := emitContractEvents(ctx, contractAddr, res.Attributes, nil); err != nil {
+		return err
+	}
 
 	if err := k.dispatchAll(ctx, contractAddr, contractInfo.IBCPortID, res.Submessages, res.Messages); err != nil {
 		return err
@@ -204,20 +253,30 @@ func (k Keeper) OnTimeoutPacket(
 	if err != nil {
 		return err
 	}
+	if contractInfo.Paused {
+		return types.ErrContractPaused
+	}
 
 	env := types.NewEnv(ctx, contractAddr)
-	querier := NewQueryHandler(ctx, k.wasmVMQueryHandler, contractAddr)
+	querier := NewQueryHandler(ctx, k.wasmVMQueryHandler, contractAddr, k.GetGasMultiplier(ctx))
 
-	gas := gasForContract(ctx)
-	res, gasUsed, execErr := k.wasmVM.IBCPacketTimeout(codeInfo.CodeHash, env, packet, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gas)
-	consumeGas(ctx, gasUsed)
+	gas := k.gasForContract(ctx)
+	res, gasUsed, execErr := k.wasmVM.IBCPacketTimeout(codeInfo.CodeHash, env, packet, prefixStore, k.cosmwasmAPI(ctx), querier, ctx.GasMeter(), gas)
+	k.consumeGas(ctx, gasUsed)
+	if storeErr := contractStoreErr(prefixStore); storeErr != nil {
+		return storeErr
+	}
 	if execErr != nil {
 		return sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
+	if err := k.settleStorageDeposit(ctx, contractAddr, prefixStore, nil); err != nil {
+		return err
+	}
 
 	// emit all events from this contract itself
-	events := types.ParseEvents(res.Attributes, contractAddr)
-	ctx.EventManager().EmitEvents(events)
+	if err := emitContractEvents(ctx, contractAddr, res.Attributes, nil); err != nil {
+		return err
+	}
 
 	if err := k.dispatchAll(ctx, contractAddr, contractInfo.IBCPortID, res.Submessages, res.Messages); err != nil {
 		return err
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"github.com/CosmWasm/wasmd/x/wasm/keeper/wasmtesting"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
 	wasmvm "github.com/CosmWasm/wasmvm"
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -636,3 +637,42 @@ func TestOnTimeoutPacket(t *testing.T) {
 		})
 	}
 }
+
+func TestIBCCallbacksOnPausedContract(t *testing.T) {
+	var m wasmtesting.MockWasmer
+	wasmtesting.MakeIBCInstantiable(&m)
+	parentCtx, keepers := CreateTestInput(t, false, SupportedFeatures)
+	example := SeedNewContractInstance(t, parentCtx, keepers, &m)
+	k := keepers.WasmKeeper
+	require.NoError(t, k.PauseContract(parentCtx, example.Contract))
+
+	specs := map[string]func(ctx sdk.Context) error{
+		"open channel": func(ctx sdk.Context) error {
+			return k.OnOpenChannel(ctx, example.Contract, wasmvmtypes.IBCChannel{})
+		},
+		"connect channel": func(ctx sdk.Context) error {
+			return k.OnConnectChannel(ctx, example.Contract, wasmvmtypes.IBCChannel{})
+		},
+		"close channel": func(ctx sdk.Context) error {
+			return k.OnCloseChannel(ctx, example.Contract, wasmvmtypes.IBCChannel{})
+		},
+		"receive packet": func(ctx sdk.Context) error {
+			_, err := k.OnRecvPacket(ctx, example.Contract, wasmvmtypes.IBCPacket{})
+			return err
+		},
+		"acknowledge packet": func(ctx sdk.Context) error {
+			return k.OnAckPacket(ctx, example.Contract, wasmvmtypes.IBCAcknowledgement{})
+		},
+		"timeout packet": func(ctx sdk.Context) error {
+			return k.OnTimeoutPacket(ctx, example.Contract, wasmvmtypes.IBCPacket{})
+		},
+	}
+	for name, call := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			// the mock has no callbacks set so it would panic when the contract was called
+			gotErr := call(ctx)
+			assert.True(t, types.ErrContractPaused.Is(gotErr), "got %+v", gotErr)
+		})
+	}
+}
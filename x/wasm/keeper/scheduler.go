@@ -0,0 +1,293 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// ScheduleCallback registers a sudo call into the contract that is executed at the end of the given block height and
+// then every `interval` blocks when interval is not zero. Only the contract itself or its admin can schedule callbacks.
+// The fee for an execution is paid by the sender when the execution is scheduled and is not refunded. It must not
+// be zero and must cover the min callback fee that is set in the params.
+func (k Keeper) ScheduleCallback(ctx sdk.Context, sender, contractAddress sdk.AccAddress, msg []byte, height, interval, gasLimit uint64, fee sdk.Coins) (uint64, error) {
+	contractInfo := k.GetContractInfo(ctx, contractAddress)
+	if contractInfo == nil {
+		return 0, sdkerrors.Wrap(types.ErrNotFound, "contract")
+	}
+	if !k.canManageCallbacks(ctx, contractAddress, contractInfo, sender) {
+		return 0, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not schedule callback")
+	}
+	if height <= uint64(ctx.BlockHeight()) {
+		return 0, sdkerrors.Wrap(types.ErrInvalid, "height must be in the future")
+	}
+	if !json.Valid(msg) {
+		return 0, sdkerrors.Wrap(types.ErrInvalid, "msg json")
+	}
+	if gasLimit == 0 {
+		return 0, sdkerrors.Wrap(types.ErrEmpty, "gas limit")
+	}
+	if maxGas := k.GetMaxScheduledCallbackGas(ctx); gasLimit > maxGas {
+		return 0, sdkerrors.Wrapf(types.ErrLimit, "gas limit exceeds max %d", maxGas)
+	}
+	if fee.IsZero() {
+		return 0, sdkerrors.Wrap(types.ErrEmpty, "fee")
+	}
+	if minFee := k.GetMinCallbackFee(ctx); !fee.IsAllGTE(minFee) {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "min callback fee: %s", minFee)
+	}
+	if err := k.chargeCallbackFee(ctx, sender, fee); err != nil {
+		return 0, err
+	}
+	callback := types.ScheduledCallback{
+		ID:       k.autoIncrementID(ctx, types.KeyLastCallbackID),
+		Contract: contractAddress.String(),
+		Creator:  sender.String(),
+		Msg:      msg,
+		Height:   height,
+		Interval: interval,
+		GasLimit: gasLimit,
+		Fee:      fee,
+	}
+	k.storeScheduledCallback(ctx, &callback)
+	return callback.ID, nil
+}
+
+// GetMinCallbackFee returns the min fee for each execution of a scheduled callback
+func (k Keeper) GetMinCallbackFee(ctx sdk.Context) sdk.Coins {
+	var a sdk.Coins
+	k.paramSpace.GetIfExists(ctx, types.ParamStoreKeyMinCallbackFee, &a)
+	return a
+}
+
+// GetMaxScheduledCallbackGas returns the max sum of callback gas limits that is executed within a single block
+func (k Keeper) GetMaxScheduledCallbackGas(ctx sdk.Context) uint64 {
+	return k.getGasParam(ctx, types.ParamStoreKeyMaxScheduledCallbackGas, types.DefaultMaxScheduledCallbackGas)
+}
+
+// CancelCallback removes a scheduled callback. Only the contract itself or its admin can cancel callbacks.
+func (k Keeper) CancelCallback(ctx sdk.Context, sender sdk.AccAddress, id uint64) error {
+	callback := k.GetScheduledCallback(ctx, id)
+	if callback == nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "callback")
+	}
+	contractAddress, err := sdk.AccAddressFromBech32(callback.Contract)
+	if err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	contractInfo := k.GetContractInfo(ctx, contractAddress)
+	if contractInfo == nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "contract")
+	}
+	if !k.canManageCallbacks(ctx, contractAddress, contractInfo, sender) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not cancel callback")
+	}
+	k.deleteScheduledCallback(ctx, callback)
+	return nil
+}
+
+func (k Keeper) canManageCallbacks(ctx sdk.Context, contractAddress sdk.AccAddress, contractInfo *types.ContractInfo, actor sdk.AccAddress) bool {
+	return contractAddress.Equals(actor) || k.authZPolicy.CanModifyContract(ctx, contractInfo.AdminAddr(), actor)
+}
+
+// GetScheduledCallback returns the callback for the given id or nil when not found
+func (k Keeper) GetScheduledCallback(ctx sdk.Context, id uint64) *types.ScheduledCallback {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetScheduledCallbackKey(id))
+	if bz == nil {
+		return nil
+	}
+	var callback types.ScheduledCallback
+	k.cdc.MustUnmarshalBinaryBare(bz, &callback)
+	return &callback
+}
+
+// IterateScheduledCallbacks iterates over all callbacks ordered by id
+func (k Keeper) IterateScheduledCallbacks(ctx sdk.Context, cb func(types.ScheduledCallback) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduledCallbackPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var callback types.ScheduledCallback
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &callback)
+		// cb returns true to stop early
+		if cb(callback) {
+			return
+		}
+	}
+}
+
+func (k Keeper) storeScheduledCallback(ctx sdk.Context, callback *types.ScheduledCallback) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetScheduledCallbackKey(callback.ID), k.cdc.MustMarshalBinaryBare(callback))
+	store.Set(types.GetScheduledCallbackQueueKey(callback.Height, callback.ID), []byte{})
+}
+
+func (k Keeper) deleteScheduledCallback(ctx sdk.Context, callback *types.ScheduledCallback) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetScheduledCallbackKey(callback.ID))
+	store.Delete(types.GetScheduledCallbackQueueKey(callback.Height, callback.ID))
+}
+
+func (k Keeper) importScheduledCallback(ctx sdk.Context, callback *types.ScheduledCallback) error {
+	contractAddress, err := sdk.AccAddressFromBech32(callback.Contract)
+	if err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	if !k.containsContractInfo(ctx, contractAddress) {
+		return sdkerrors.Wrapf(types.ErrNotFound, "contract: %s", callback.Contract)
+	}
+	if k.GetScheduledCallback(ctx, callback.ID) != nil {
+		return sdkerrors.Wrapf(types.ErrDuplicate, "callback id: %d", callback.ID)
+	}
+	if maxGas := k.GetMaxScheduledCallbackGas(ctx); callback.GasLimit > maxGas {
+		return sdkerrors.Wrapf(types.ErrLimit, "gas limit exceeds max %d", maxGas)
+	}
+	k.storeScheduledCallback(ctx, callback)
+	return nil
+}
+
+// chargeCallbackFee transfers the fee to the fee collector. The transfer is done in a cached context so that
+// no coins are debited when the payer can not pay all denoms.
+func (k Keeper) chargeCallbackFee(ctx sdk.Context, payer sdk.AccAddress, fee sdk.Coins) error {
+	if fee.IsZero() {
+		return nil
+	}
+	cacheCtx, commit := ctx.CacheContext()
+	if err := k.bank.TransferCoins(cacheCtx, payer, authtypes.NewModuleAddress(authtypes.FeeCollectorName), fee); err != nil {
+		return err
+	}
+	commit()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return nil
+}
+
+// ExecuteScheduledCallbacks runs all callbacks that are due at the current block height in the order of their
+// heights. The sum of the callback gas limits is bound by the max scheduled callback gas. Callbacks that do not
+// fit are executed in a later block. Callbacks with a gas limit above the max, for example after the max was
+// lowered by governance, can never be executed and are dropped.
+func (k Keeper) ExecuteScheduledCallbacks(ctx sdk.Context) {
+	var due, oversized []uint64
+	var orphaned [][]byte
+	maxGas := k.GetMaxScheduledCallbackGas(ctx)
+	budget := maxGas
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduledCallbackQueuePrefix)
+	iter := prefixStore.Iterator(nil, sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())+1))
+	for ; iter.Valid(); iter.Next() {
+		_, id := types.ParseScheduledCallbackQueueKey(iter.Key())
+		callback := k.GetScheduledCallback(ctx, id)
+		if callback == nil { // should never happen, the queue entry is removed so that it does not halt the chain
+			k.Logger(ctx).Error("scheduled callback not found", "id", id)
+			orphaned = append(orphaned, append([]byte{}, iter.Key()...))
+			continue
+		}
+		if callback.GasLimit > maxGas {
+			oversized = append(oversized, id)
+			continue
+		}
+		if callback.GasLimit > budget {
+			break
+		}
+		budget -= callback.GasLimit
+		due = append(due, id)
+	}
+	iter.Close()
+
+	for _, key := range orphaned {
+		prefixStore.Delete(key)
+	}
+	for _, id := range oversized {
+		callback := k.GetScheduledCallback(ctx, id)
+		k.deleteScheduledCallback(ctx, callback)
+		k.Logger(ctx).Info("scheduled callback dropped", "id", callback.ID, "contract", callback.Contract, "error", "gas limit exceeds max")
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeScheduledCallback,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyCallbackID, fmt.Sprintf("%d", callback.ID)),
+			sdk.NewAttribute(types.AttributeKeyContract, callback.Contract),
+			sdk.NewAttribute(types.AttributeKeyResult, "dropped"),
+			sdk.NewAttribute(types.AttributeKeyError, fmt.Sprintf("gas limit exceeds max %d", maxGas)),
+		))
+	}
+
+	for _, id := range due {
+		callback := k.GetScheduledCallback(ctx, id)
+		if callback == nil { // canceled by a previous callback
+			continue
+		}
+		k.deleteScheduledCallback(ctx, callback)
+		k.executeScheduledCallback(ctx, callback)
+	}
+}
+
+func (k Keeper) executeScheduledCallback(ctx sdk.Context, callback *types.ScheduledCallback) {
+	contractAddress, err := sdk.AccAddressFromBech32(callback.Contract)
+	if err != nil { // should never happen as it was validated before
+		k.Logger(ctx).Error("scheduled callback dropped", "id", callback.ID, "contract", callback.Contract, "error", err.Error())
+		return
+	}
+	err = k.sudoWithGasLimit(ctx, contractAddress, callback.Msg, callback.GasLimit)
+
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyCallbackID, fmt.Sprintf("%d", callback.ID)),
+		sdk.NewAttribute(types.AttributeKeyContract, callback.Contract),
+	}
+	if err != nil {
+		k.Logger(ctx).Info("scheduled callback failed", "id", callback.ID, "contract", callback.Contract, "error", err.Error())
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyResult, "error"), sdk.NewAttribute(types.AttributeKeyError, err.Error()))
+	} else {
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyResult, "success"))
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeScheduledCallback, attrs...))
+
+	if callback.Interval == 0 {
+		return
+	}
+	// the next execution is scheduled from the current height so that deferred callbacks do not pile up
+	creator, err := sdk.AccAddressFromBech32(callback.Creator)
+	if err != nil { // should never happen as it was validated before
+		k.Logger(ctx).Error("scheduled callback dropped", "id", callback.ID, "creator", callback.Creator, "error", err.Error())
+		return
+	}
+	if err := k.chargeCallbackFee(ctx, creator, callback.Fee); err != nil {
+		k.Logger(ctx).Info("scheduled callback dropped", "id", callback.ID, "contract", callback.Contract, "error", err.Error())
+		return
+	}
+	callback.Height = uint64(ctx.BlockHeight()) + callback.Interval
+	k.storeScheduledCallback(ctx, callback)
+}
+
+// sudoWithGasLimit calls the contract with a limited gas meter in a cached context. State changes and events are
+// only committed on success. Any panic is recovered and returned as an error so that a callback can not halt the
+// chain in the end blocker.
+func (k Keeper) sudoWithGasLimit(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte, gasLimit uint64) (err error) {
+	subCtx, commit := ctx.CacheContext()
+	em := sdk.NewEventManager()
+	subCtx = subCtx.WithGasMeter(sdk.NewGasMeter(gasLimit)).WithEventManager(em)
+
+	// catch out of gas panic and just charge the entire gas limit
+	defer func() {
+		if r := recover(); r != nil {
+			ctx.GasMeter().ConsumeGas(gasLimit, "Scheduled callback panic")
+			if _, ok := r.(sdk.ErrorOutOfGas); ok {
+				err = sdkerrors.Wrap(sdkerrors.ErrOutOfGas, "scheduled callback hit gas limit")
+				return
+			}
+			k.Logger(ctx).Error("scheduled callback panic", "contract", contractAddress.String(), "panic", r)
+			err = sdkerrors.Wrapf(types.ErrExecuteFailed, "scheduled callback panic: %v", r)
+		}
+	}()
+	_, err = k.Sudo(subCtx, contractAddress, msg)
+	ctx.GasMeter().ConsumeGas(subCtx.GasMeter().GasConsumed(), "From scheduled callback")
+	if err != nil {
+		return err
+	}
+	commit()
+	ctx.EventManager().EmitEvents(em.Events())
+	return nil
+}
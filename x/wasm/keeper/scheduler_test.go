@@ -0,0 +1,512 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/wasmtesting"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+	wasmvm "github.com/CosmWasm/wasmvm"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestScheduleCallback(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, SupportedFeatures)
+	parentCtx = parentCtx.WithBlockHeight(10)
+	k := keepers.WasmKeeper
+
+	mock := wasmtesting.MockWasmer{
+		CreateFn:      wasmtesting.NoOpCreateFn,
+		InstantiateFn: wasmtesting.NoOpInstantiateFn,
+		AnalyzeCodeFn: wasmtesting.WithoutIBCAnalyzeFn,
+	}
+	example := SeedNewContractInstance(t, parentCtx, keepers, &mock)
+	otherAddr := createFakeFundedAccount(t, parentCtx, keepers.AccountKeeper, keepers.BankKeeper, sdk.NewCoins(sdk.NewInt64Coin("denom", 100)))
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	myFee := sdk.NewCoins(sdk.NewInt64Coin("denom", 10))
+	require.NoError(t, keepers.BankKeeper.SetBalances(parentCtx, example.Contract, myFee))
+
+	specs := map[string]struct {
+		sender   sdk.AccAddress
+		contract sdk.AccAddress
+		height   uint64
+		gasLimit uint64
+		fee      sdk.Coins
+		minFee   sdk.Coins
+		maxGas   uint64
+		msg      []byte
+		expErr   *sdkerrors.Error
+	}{
+		"scheduled by admin": {
+			sender:   example.CreatorAddr,
+			contract: example.Contract,
+			height:   11,
+			gasLimit: 100_000,
+			fee:      myFee,
+		},
+		"scheduled by contract": {
+			sender:   example.Contract,
+			contract: example.Contract,
+			height:   11,
+			gasLimit: 100_000,
+			fee:      myFee,
+		},
+		"fee covers min fee": {
+			sender:   example.CreatorAddr,
+			contract: example.Contract,
+			height:   11,
+			gasLimit: 100_000,
+			fee:      myFee,
+			minFee:   myFee,
+		},
+		"scheduled by other address": {
+			sender:   otherAddr,
+			contract: example.Contract,
+			height:   11,
+			gasLimit: 100_000,
+			expErr:   sdkerrors.ErrUnauthorized,
+		},
+		"unknown contract": {
+			sender:   example.CreatorAddr,
+			contract: RandomAccountAddress(t),
+			height:   11,
+			gasLimit: 100_000,
+			expErr:   types.ErrNotFound,
+		},
+		"height not in the future": {
+			sender:   example.CreatorAddr,
+			contract: example.Contract,
+			height:   10,
+			gasLimit: 100_000,
+			expErr:   types.ErrInvalid,
+		},
+		"gas limit exceeds max": {
+			sender:   example.CreatorAddr,
+			contract: example.Contract,
+			height:   11,
+			gasLimit: types.DefaultMaxScheduledCallbackGas + 1,
+			expErr:   types.ErrLimit,
+		},
+		"gas limit exceeds max param": {
+			sender:   example.CreatorAddr,
+			contract: example.Contract,
+			height:   11,
+			gasLimit: 100_001,
+			maxGas:   100_000,
+			expErr:   types.ErrLimit,
+		},
+		"zero gas limit": {
+			sender:   example.CreatorAddr,
+			contract: example.Contract,
+			height:   11,
+			fee:      myFee,
+			expErr:   types.ErrEmpty,
+		},
+		"invalid json msg": {
+			sender:   example.CreatorAddr,
+			contract: example.Contract,
+			height:   11,
+			gasLimit: 100_000,
+			fee:      myFee,
+			msg:      []byte("not json"),
+			expErr:   types.ErrInvalid,
+		},
+		"fee can not be paid": {
+			sender:   example.CreatorAddr,
+			contract: example.Contract,
+			height:   11,
+			gasLimit: 100_000,
+			fee:      sdk.NewCoins(sdk.NewInt64Coin("denom", 1_000_000)),
+			expErr:   sdkerrors.ErrInsufficientFunds,
+		},
+		"fee missing": {
+			sender:   example.CreatorAddr,
+			contract: example.Contract,
+			height:   11,
+			gasLimit: 100_000,
+			expErr:   types.ErrEmpty,
+		},
+		"fee below min fee": {
+			sender:   example.CreatorAddr,
+			contract: example.Contract,
+			height:   11,
+			gasLimit: 100_000,
+			fee:      myFee,
+			minFee:   myFee.Add(sdk.NewInt64Coin("denom", 1)),
+			expErr:   sdkerrors.ErrInsufficientFee,
+		},
+		"fee without min fee denom": {
+			sender:   example.CreatorAddr,
+			contract: example.Contract,
+			height:   11,
+			gasLimit: 100_000,
+			fee:      myFee,
+			minFee:   sdk.NewCoins(sdk.NewInt64Coin("other", 1)),
+			expErr:   sdkerrors.ErrInsufficientFee,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			params := k.GetParams(ctx)
+			params.MinCallbackFee = spec.minFee
+			if spec.maxGas != 0 {
+				params.MaxScheduledCallbackGas = spec.maxGas
+			}
+			k.setParams(ctx, params)
+			msg := []byte(`{"foo":"bar"}`)
+			if spec.msg != nil {
+				msg = spec.msg
+			}
+			id, gotErr := k.ScheduleCallback(ctx, spec.sender, spec.contract, msg, spec.height, 0, spec.gasLimit, spec.fee)
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(gotErr), "got %+v", gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			exp := types.ScheduledCallback{
+				ID:       id,
+				Contract: spec.contract.String(),
+				Creator:  spec.sender.String(),
+				Msg:      []byte(`{"foo":"bar"}`),
+				Height:   spec.height,
+				GasLimit: spec.gasLimit,
+				Fee:      spec.fee,
+			}
+			assert.Equal(t, &exp, k.GetScheduledCallback(ctx, id))
+			assert.Equal(t, spec.fee.String(), keepers.BankKeeper.GetAllBalances(ctx, feeCollector).String())
+		})
+	}
+}
+
+func TestCancelCallback(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, SupportedFeatures)
+	parentCtx = parentCtx.WithBlockHeight(1)
+	k := keepers.WasmKeeper
+
+	mock := wasmtesting.MockWasmer{
+		CreateFn:      wasmtesting.NoOpCreateFn,
+		InstantiateFn: wasmtesting.NoOpInstantiateFn,
+		AnalyzeCodeFn: wasmtesting.WithoutIBCAnalyzeFn,
+	}
+	example := SeedNewContractInstance(t, parentCtx, keepers, &mock)
+	id, err := k.ScheduleCallback(parentCtx, example.CreatorAddr, example.Contract, []byte(`{}`), 100, 0, 100_000, sdk.NewCoins(sdk.NewInt64Coin("denom", 1)))
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		sender sdk.AccAddress
+		id     uint64
+		expErr *sdkerrors.Error
+	}{
+		"canceled by admin": {
+			sender: example.CreatorAddr,
+			id:     id,
+		},
+		"canceled by contract": {
+			sender: example.Contract,
+			id:     id,
+		},
+		"canceled by other address": {
+			sender: RandomAccountAddress(t),
+			id:     id,
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"unknown id": {
+			sender: example.CreatorAddr,
+			id:     id + 1,
+			expErr: types.ErrNotFound,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			gotErr := k.CancelCallback(ctx, spec.sender, spec.id)
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(gotErr), "got %+v", gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Nil(t, k.GetScheduledCallback(ctx, spec.id))
+			// and not executed anymore
+			k.ExecuteScheduledCallbacks(ctx.WithBlockHeight(100))
+		})
+	}
+}
+
+func TestExecuteScheduledCallbacks(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, SupportedFeatures)
+	k := keepers.WasmKeeper
+
+	var capturedMsgs []string
+	mock := wasmtesting.MockWasmer{
+		CreateFn:      wasmtesting.NoOpCreateFn,
+		InstantiateFn: wasmtesting.NoOpInstantiateFn,
+		AnalyzeCodeFn: wasmtesting.WithoutIBCAnalyzeFn,
+		SudoFn: func(codeID wasmvm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64) (*wasmvmtypes.Response, uint64, error) {
+			capturedMsgs = append(capturedMsgs, string(sudoMsg))
+			store.Set([]byte("height"), sdk.Uint64ToBigEndian(env.Block.Height))
+			switch string(sudoMsg) {
+			case `"fail"`:
+				return nil, 0, types.ErrInvalid
+			case `"out of gas"`:
+				return nil, gasLimit + 1, nil
+			case `"panic"`:
+				panic("testing")
+			}
+			return &wasmvmtypes.Response{}, 0, nil
+		},
+	}
+	example := SeedNewContractInstance(t, parentCtx, keepers, &mock)
+	require.NoError(t, keepers.BankKeeper.SetBalances(parentCtx, example.CreatorAddr, example.InitialAmount.Add(sdk.NewInt64Coin("other", 10))))
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	myFee := sdk.NewCoins(sdk.NewInt64Coin("denom", 10))
+	twoDenomFee := sdk.NewCoins(sdk.NewInt64Coin("denom", 10), sdk.NewInt64Coin("other", 10))
+
+	specs := map[string]struct {
+		msg             string
+		interval        uint64
+		gasLimit        uint64
+		fee             sdk.Coins
+		expSuccess      bool
+		expRescheduled  bool
+		expTotalFeePaid sdk.Coins
+	}{
+		"one time": {
+			msg:             `"ok"`,
+			gasLimit:        100_000,
+			fee:             myFee,
+			expSuccess:      true,
+			expTotalFeePaid: myFee,
+		},
+		"recurring": {
+			msg:             `"ok"`,
+			interval:        5,
+			gasLimit:        100_000,
+			fee:             myFee,
+			expSuccess:      true,
+			expRescheduled:  true,
+			expTotalFeePaid: myFee.Add(myFee...),
+		},
+		"recurring without funds for next execution": {
+			msg:             `"ok"`,
+			interval:        5,
+			gasLimit:        100_000,
+			fee:             sdk.NewCoins(sdk.NewInt64Coin("denom", 600)),
+			expSuccess:      true,
+			expTotalFeePaid: sdk.NewCoins(sdk.NewInt64Coin("denom", 600)),
+		},
+		"recurring without funds for second fee denom": {
+			msg:             `"ok"`,
+			interval:        5,
+			gasLimit:        100_000,
+			fee:             twoDenomFee,
+			expSuccess:      true,
+			expTotalFeePaid: twoDenomFee,
+		},
+		"contract fails": {
+			msg:             `"fail"`,
+			gasLimit:        100_000,
+			fee:             myFee,
+			expTotalFeePaid: myFee,
+		},
+		"recurring contract fails": {
+			msg:             `"fail"`,
+			interval:        5,
+			gasLimit:        100_000,
+			fee:             myFee,
+			expRescheduled:  true,
+			expTotalFeePaid: myFee.Add(myFee...),
+		},
+		"out of gas": {
+			msg:             `"out of gas"`,
+			gasLimit:        InstanceCost + 10_000,
+			fee:             myFee,
+			expTotalFeePaid: myFee,
+		},
+		"contract panics": {
+			msg:             `"panic"`,
+			gasLimit:        100_000,
+			fee:             myFee,
+			expTotalFeePaid: myFee,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			capturedMsgs = nil
+			ctx, _ := parentCtx.CacheContext()
+			ctx = ctx.WithBlockHeight(1).WithEventManager(sdk.NewEventManager())
+			creatorBalance := keepers.BankKeeper.GetAllBalances(ctx, example.CreatorAddr)
+			id, err := k.ScheduleCallback(ctx, example.CreatorAddr, example.Contract, []byte(spec.msg), 10, spec.interval, spec.gasLimit, spec.fee)
+			require.NoError(t, err)
+
+			// when not due
+			k.ExecuteScheduledCallbacks(ctx.WithBlockHeight(9))
+			assert.Empty(t, capturedMsgs)
+
+			// when due
+			em := sdk.NewEventManager()
+			k.ExecuteScheduledCallbacks(ctx.WithBlockHeight(10).WithEventManager(em))
+
+			// then
+			assert.Equal(t, []string{spec.msg}, capturedMsgs)
+			if spec.expSuccess {
+				assert.Equal(t, sdk.Uint64ToBigEndian(10), k.QueryRaw(ctx, example.Contract, []byte("height")))
+			} else {
+				assert.Nil(t, k.QueryRaw(ctx, example.Contract, []byte("height")))
+			}
+			var callbackEvents []abci.Event
+			for _, e := range em.ABCIEvents() {
+				if e.Type == types.EventTypeScheduledCallback {
+					callbackEvents = append(callbackEvents, e)
+				}
+			}
+			require.Len(t, callbackEvents, 1)
+			expResult := "error"
+			if spec.expSuccess {
+				expResult = "success"
+			}
+			assert.Contains(t, callbackEvents[0].Attributes, abci.EventAttribute{Key: []byte(types.AttributeKeyResult), Value: []byte(expResult)})
+
+			gotCallback := k.GetScheduledCallback(ctx, id)
+			if spec.expRescheduled {
+				require.NotNil(t, gotCallback)
+				assert.Equal(t, uint64(15), gotCallback.Height)
+			} else {
+				assert.Nil(t, gotCallback)
+			}
+			assert.Equal(t, spec.expTotalFeePaid.String(), keepers.BankKeeper.GetAllBalances(ctx, feeCollector).String())
+			// no coins are lost on a failed fee charge
+			assert.Equal(t, creatorBalance.Sub(spec.expTotalFeePaid).String(), keepers.BankKeeper.GetAllBalances(ctx, example.CreatorAddr).String())
+		})
+	}
+}
+
+func TestExecuteScheduledCallbacksGasBudget(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures)
+	ctx = ctx.WithBlockHeight(1)
+	k := keepers.WasmKeeper
+	params := k.GetParams(ctx)
+	params.MaxScheduledCallbackGas = 250_000
+	k.setParams(ctx, params)
+
+	var capturedMsgs []string
+	mock := wasmtesting.MockWasmer{
+		CreateFn:      wasmtesting.NoOpCreateFn,
+		InstantiateFn: wasmtesting.NoOpInstantiateFn,
+		AnalyzeCodeFn: wasmtesting.WithoutIBCAnalyzeFn,
+		SudoFn: func(codeID wasmvm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64) (*wasmvmtypes.Response, uint64, error) {
+			capturedMsgs = append(capturedMsgs, string(sudoMsg))
+			return &wasmvmtypes.Response{}, 0, nil
+		},
+	}
+	example := SeedNewContractInstance(t, ctx, keepers, &mock)
+	for _, s := range []struct {
+		msg    string
+		height uint64
+	}{{`"third"`, 3}, {`"first"`, 2}, {`"second"`, 2}} {
+		_, err := k.ScheduleCallback(ctx, example.CreatorAddr, example.Contract, []byte(s.msg), s.height, 0, 100_000, sdk.NewCoins(sdk.NewInt64Coin("denom", 1)))
+		require.NoError(t, err)
+	}
+
+	// when
+	k.ExecuteScheduledCallbacks(ctx.WithBlockHeight(3))
+	// then the oldest fit into the budget
+	assert.Equal(t, []string{`"first"`, `"second"`}, capturedMsgs)
+
+	// and the remaining callback is executed in the next block
+	k.ExecuteScheduledCallbacks(ctx.WithBlockHeight(4))
+	assert.Equal(t, []string{`"first"`, `"second"`, `"third"`}, capturedMsgs)
+}
+
+func TestExecuteScheduledCallbacksDropsOversized(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures)
+	ctx = ctx.WithBlockHeight(1)
+	k := keepers.WasmKeeper
+
+	var capturedMsgs []string
+	mock := wasmtesting.MockWasmer{
+		CreateFn:      wasmtesting.NoOpCreateFn,
+		InstantiateFn: wasmtesting.NoOpInstantiateFn,
+		AnalyzeCodeFn: wasmtesting.WithoutIBCAnalyzeFn,
+		SudoFn: func(codeID wasmvm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64) (*wasmvmtypes.Response, uint64, error) {
+			capturedMsgs = append(capturedMsgs, string(sudoMsg))
+			return &wasmvmtypes.Response{}, 0, nil
+		},
+	}
+	example := SeedNewContractInstance(t, ctx, keepers, &mock)
+	myFee := sdk.NewCoins(sdk.NewInt64Coin("denom", 1))
+	oversizedID, err := k.ScheduleCallback(ctx, example.CreatorAddr, example.Contract, []byte(`"oversized"`), 2, 0, 200_000, myFee)
+	require.NoError(t, err)
+	_, err = k.ScheduleCallback(ctx, example.CreatorAddr, example.Contract, []byte(`"next"`), 2, 0, 100_000, myFee)
+	require.NoError(t, err)
+	// the max was lowered by governance after the callback was scheduled
+	params := k.GetParams(ctx)
+	params.MaxScheduledCallbackGas = 150_000
+	k.setParams(ctx, params)
+
+	// when
+	em := sdk.NewEventManager()
+	k.ExecuteScheduledCallbacks(ctx.WithBlockHeight(2).WithEventManager(em))
+
+	// then the oversized callback does not block the queue
+	assert.Equal(t, []string{`"next"`}, capturedMsgs)
+	assert.Nil(t, k.GetScheduledCallback(ctx, oversizedID))
+	var results []string
+	for _, e := range em.ABCIEvents() {
+		if e.Type != types.EventTypeScheduledCallback {
+			continue
+		}
+		for _, a := range e.Attributes {
+			if string(a.Key) == types.AttributeKeyResult {
+				results = append(results, string(a.Value))
+			}
+		}
+	}
+	assert.Equal(t, []string{"dropped", "success"}, results)
+}
+
+func TestExecuteScheduledCallbacksSkipsInconsistentState(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures)
+	ctx = ctx.WithBlockHeight(1)
+	k := keepers.WasmKeeper
+
+	var capturedMsgs []string
+	mock := wasmtesting.MockWasmer{
+		CreateFn:      wasmtesting.NoOpCreateFn,
+		InstantiateFn: wasmtesting.NoOpInstantiateFn,
+		AnalyzeCodeFn: wasmtesting.WithoutIBCAnalyzeFn,
+		SudoFn: func(codeID wasmvm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64) (*wasmvmtypes.Response, uint64, error) {
+			capturedMsgs = append(capturedMsgs, string(sudoMsg))
+			return &wasmvmtypes.Response{}, 0, nil
+		},
+	}
+	example := SeedNewContractInstance(t, ctx, keepers, &mock)
+	myFee := sdk.NewCoins(sdk.NewInt64Coin("denom", 1))
+	orphanedID, err := k.ScheduleCallback(ctx, example.CreatorAddr, example.Contract, []byte(`"orphaned"`), 2, 0, 100_000, myFee)
+	require.NoError(t, err)
+	invalidID, err := k.ScheduleCallback(ctx, example.CreatorAddr, example.Contract, []byte(`"invalid"`), 2, 0, 100_000, myFee)
+	require.NoError(t, err)
+	_, err = k.ScheduleCallback(ctx, example.CreatorAddr, example.Contract, []byte(`"next"`), 2, 0, 100_000, myFee)
+	require.NoError(t, err)
+	// a queue entry without the callback and a callback with an invalid contract address
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetScheduledCallbackKey(orphanedID))
+	invalid := k.GetScheduledCallback(ctx, invalidID)
+	invalid.Contract = "invalid address"
+	store.Set(types.GetScheduledCallbackKey(invalidID), k.cdc.MustMarshalBinaryBare(invalid))
+
+	// when
+	require.NotPanics(t, func() {
+		k.ExecuteScheduledCallbacks(ctx.WithBlockHeight(2))
+	})
+
+	// then the valid callback is executed and the inconsistent entries are removed
+	assert.Equal(t, []string{`"next"`}, capturedMsgs)
+	assert.False(t, store.Has(types.GetScheduledCallbackQueueKey(2, orphanedID)))
+	assert.Nil(t, k.GetScheduledCallback(ctx, invalidID))
+}
@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GetStorageDeposit returns the amount that is locked for the storage of the contract
+func (k Keeper) GetStorageDeposit(ctx sdk.Context, contractAddress sdk.AccAddress) sdk.Coins {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetStorageDepositKey(contractAddress))
+	if bz == nil {
+		return sdk.NewCoins()
+	}
+	var deposit types.StorageDeposit
+	k.cdc.MustUnmarshalBinaryBare(bz, &deposit)
+	return deposit.Amount
+}
+
+func (k Keeper) setStorageDeposit(ctx sdk.Context, contractAddress sdk.AccAddress, amount sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	if amount.IsZero() {
+		store.Delete(types.GetStorageDepositKey(contractAddress))
+		return
+	}
+	store.Set(types.GetStorageDepositKey(contractAddress), k.cdc.MustMarshalBinaryBare(&types.StorageDeposit{Amount: amount}))
+}
+
+// settleStorageDeposit locks the deposit for the bytes that the contract store grew in the contract call
+// or refunds the share of the deposit for the bytes that were removed. The deposits are held by the wasm
+// module account. The sender pays unless the params define that the contract does. The deposit of a contract is
+// a pool that is paid by many senders. Refunds are therefore always sent to the contract and never to the sender
+// that happens to remove the state.
+func (k Keeper) settleStorageDeposit(ctx sdk.Context, contractAddress sdk.AccAddress, store sdk.KVStore, sender sdk.AccAddress) error {
+	s, ok := store.(*storageStatsStore)
+	if !ok || s.stats.ByteCount == s.initialBytes {
+		return nil
+	}
+	// the lookups are not charged so that contract calls cost the same when the deposits are disabled
+	bookkeepingCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	var pricePerByte sdk.DecCoins
+	var fromContract bool
+	k.paramSpace.GetIfExists(bookkeepingCtx, types.ParamStoreKeyStorageDepositPerByte, &pricePerByte)
+	k.paramSpace.GetIfExists(bookkeepingCtx, types.ParamStoreKeyStorageDepositFromContract, &fromContract)
+	deposit := k.GetStorageDeposit(bookkeepingCtx, contractAddress)
+	if pricePerByte.IsZero() && deposit.IsZero() {
+		return nil
+	}
+	payer := sender
+	if fromContract || payer == nil {
+		payer = contractAddress
+	}
+	transferrer, ok := k.bank.(storageDepositTransferrer)
+	if !ok {
+		return sdkerrors.Wrap(types.ErrStorageDeposit, "not supported by coin transferrer")
+	}
+	if k.accountKeeper.GetModuleAccount(ctx, types.ModuleName) == nil {
+		return sdkerrors.Wrap(types.ErrStorageDeposit, "module account not registered")
+	}
+
+	if s.stats.ByteCount > s.initialBytes {
+		grown := sdk.NewDec(int64(s.stats.ByteCount - s.initialBytes))
+		amount := ceilCoins(pricePerByte.MulDec(grown))
+		if amount.IsZero() {
+			return nil
+		}
+		if err := transferrer.LockStorageDeposit(ctx, payer, amount); err != nil {
+			return sdkerrors.Wrap(types.ErrStorageDeposit, err.Error())
+		}
+		k.setStorageDeposit(ctx, contractAddress, deposit.Add(amount...))
+		return nil
+	}
+
+	// the refund is the share of the deposit for the removed bytes
+	removed := sdk.NewIntFromUint64(s.initialBytes - s.stats.ByteCount)
+	total := sdk.NewIntFromUint64(s.initialBytes)
+	refund := sdk.NewCoins()
+	for _, c := range deposit {
+		refund = refund.Add(sdk.NewCoin(c.Denom, c.Amount.Mul(removed).Quo(total)))
+	}
+	if refund.IsZero() {
+		return nil
+	}
+	if err := transferrer.RefundStorageDeposit(ctx, contractAddress, refund); err != nil {
+		return sdkerrors.Wrap(types.ErrStorageDeposit, err.Error())
+	}
+	k.setStorageDeposit(ctx, contractAddress, deposit.Sub(refund))
+	return nil
+}
+
+// ceilCoins rounds the decimal amounts up so that fractions of a coin are charged in full
+func ceilCoins(amount sdk.DecCoins) sdk.Coins {
+	r := sdk.NewCoins()
+	for _, c := range amount {
+		r = r.Add(sdk.NewCoin(c.Denom, c.Amount.Ceil().TruncateInt()))
+	}
+	return r
+}
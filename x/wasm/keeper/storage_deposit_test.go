@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageDepositOnInstantiate(t *testing.T) {
+	specs := map[string]struct {
+		pricePerByte sdk.DecCoins
+		fromContract bool
+		expErr       bool
+	}{
+		"disabled": {},
+		"sender pays": {
+			pricePerByte: sdk.NewDecCoins(sdk.NewDecCoinFromDec("denom", sdk.NewDecWithPrec(1, 1))),
+		},
+		"contract pays": {
+			pricePerByte: sdk.NewDecCoins(sdk.NewDecCoinFromDec("denom", sdk.NewDecWithPrec(1, 1))),
+			fromContract: true,
+		},
+		"insufficient funds": {
+			pricePerByte: sdk.NewDecCoins(sdk.NewInt64DecCoin("denom", 100)),
+			expErr:       true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, SupportedFeatures)
+			k, bank := keepers.WasmKeeper, keepers.BankKeeper
+			example := StoreHackatomExampleContract(t, ctx, keepers)
+
+			params := k.GetParams(ctx)
+			params.StorageDepositPerByte = spec.pricePerByte
+			params.StorageDepositFromContract = spec.fromContract
+			k.setParams(ctx, params)
+
+			_, _, bob := keyPubAddr()
+			_, _, fred := keyPubAddr()
+			initMsg := HackatomExampleInitMsg{Verifier: fred, Beneficiary: bob}.GetBytes(t)
+			funds := sdk.NewCoins(sdk.NewInt64Coin("denom", 100))
+
+			// when
+			contractAddr, _, err := k.Instantiate(ctx, example.CodeID, example.CreatorAddr, nil, initMsg, "label", funds)
+
+			// then
+			if spec.expErr {
+				assert.True(t, types.ErrStorageDeposit.Is(err), "got %+v", err)
+				return
+			}
+			require.NoError(t, err)
+			byteCount := k.GetContractStorageStats(ctx, contractAddr).ByteCount
+			expDeposit := ceilCoins(spec.pricePerByte.MulDec(sdk.NewDec(int64(byteCount))))
+			assert.Equal(t, expDeposit, k.GetStorageDeposit(ctx, contractAddr))
+
+			moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+			assert.Equal(t, expDeposit, bank.GetAllBalances(ctx, moduleAddr))
+			expCreatorBalance := example.InitialAmount.Sub(funds)
+			expContractBalance := funds
+			if spec.fromContract {
+				expContractBalance = expContractBalance.Sub(expDeposit)
+			} else {
+				expCreatorBalance = expCreatorBalance.Sub(expDeposit)
+			}
+			assert.Equal(t, expCreatorBalance, bank.GetAllBalances(ctx, example.CreatorAddr))
+			assert.Equal(t, expContractBalance, bank.GetAllBalances(ctx, contractAddr))
+		})
+	}
+}
+
+func TestStorageDepositRefund(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures)
+	k, bank := keepers.WasmKeeper, keepers.BankKeeper
+	example := StoreHackatomExampleContract(t, ctx, keepers)
+
+	params := k.GetParams(ctx)
+	params.StorageDepositPerByte = sdk.NewDecCoins(sdk.NewDecCoinFromDec("denom", sdk.NewDecWithPrec(5, 1)))
+	k.setParams(ctx, params)
+
+	_, _, bob := keyPubAddr()
+	_, _, fred := keyPubAddr()
+	initMsg := HackatomExampleInitMsg{Verifier: fred, Beneficiary: bob}.GetBytes(t)
+	contractAddr, _, err := k.Instantiate(ctx, example.CodeID, example.CreatorAddr, nil, initMsg, "label", nil)
+	require.NoError(t, err)
+	deposit := k.GetStorageDeposit(ctx, contractAddr)
+	require.False(t, deposit.IsZero())
+	// paid by the creator
+	require.Equal(t, example.InitialAmount.Sub(deposit), bank.GetAllBalances(ctx, example.CreatorAddr))
+	initialBytes := k.GetContractStorageStats(ctx, contractAddr).ByteCount
+
+	// when all but one key are deleted by another user than the creator who paid the deposit
+	store := k.contractStore(ctx, contractAddr, example.CodeID)
+	var keys [][]byte
+	iter := store.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	require.NoError(t, iter.Close())
+	for _, key := range keys[1:] {
+		store.Delete(key)
+	}
+	err = k.settleStorageDeposit(ctx, contractAddr, store, bob)
+
+	// then the share for the removed bytes is refunded to the contract and not to the other user
+	require.NoError(t, err)
+	removedBytes := initialBytes - k.GetContractStorageStats(ctx, contractAddr).ByteCount
+	expRefund := sdk.NewCoins(sdk.NewCoin("denom", deposit.AmountOf("denom").MulRaw(int64(removedBytes)).QuoRaw(int64(initialBytes))))
+	assert.Equal(t, expRefund, bank.GetAllBalances(ctx, contractAddr))
+	assert.True(t, bank.GetAllBalances(ctx, bob).IsZero())
+	assert.Equal(t, deposit.Sub(expRefund), k.GetStorageDeposit(ctx, contractAddr))
+
+	// and the remaining deposit when the last key is deleted
+	store = k.contractStore(ctx, contractAddr, example.CodeID)
+	store.Delete(keys[0])
+	require.NoError(t, k.settleStorageDeposit(ctx, contractAddr, store, bob))
+	assert.Equal(t, deposit, bank.GetAllBalances(ctx, contractAddr))
+	assert.True(t, bank.GetAllBalances(ctx, bob).IsZero())
+	assert.True(t, k.GetStorageDeposit(ctx, contractAddr).IsZero())
+	assert.True(t, bank.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName)).IsZero())
+}
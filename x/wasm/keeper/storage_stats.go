@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GetContractStorageStats returns the number of keys and bytes in the contract store
+func (k Keeper) GetContractStorageStats(ctx sdk.Context, contractAddress sdk.AccAddress) types.ContractStorageStats {
+	var stats types.ContractStorageStats
+	bz := ctx.KVStore(k.storeKey).Get(types.GetContractStorageStatsKey(contractAddress))
+	if bz != nil {
+		k.cdc.MustUnmarshalBinaryBare(bz, &stats)
+	}
+	return stats
+}
+
+func (k Keeper) setContractStorageStats(ctx sdk.Context, contractAddress sdk.AccAddress, stats types.ContractStorageStats) {
+	ctx.KVStore(k.storeKey).Set(types.GetContractStorageStatsKey(contractAddress), k.cdc.MustMarshalBinaryBare(&stats))
+}
+
+// GetContractStorageLimit returns the max bytes that a contract of the given code can store. Zero for no limit.
+func (k Keeper) GetContractStorageLimit(ctx sdk.Context, codeID uint64) uint64 {
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	var limits []types.CodeStorageLimit
+	k.paramSpace.GetIfExists(ctx, types.ParamStoreKeyCodeStorageLimits, &limits)
+	for _, l := range limits {
+		if l.CodeID == codeID {
+			return l.MaxBytes
+		}
+	}
+	var maxBytes uint64
+	k.paramSpace.GetIfExists(ctx, types.ParamStoreKeyMaxContractStorageBytes, &maxBytes)
+	return maxBytes
+}
+
+// contractStore returns the prefixed data store of the contract that charges the storage gas costs
+// and keeps the storage stats of the contract up to date. The reads and writes of the bookkeeping are
+// charged to the gas meter of the contract call with the sdk KV store costs.
+func (k Keeper) contractStore(ctx sdk.Context, contractAddress sdk.AccAddress, codeID uint64) sdk.KVStore {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetContractStorePrefix(contractAddress))
+	return &storageStatsStore{
+		KVStore:  newMeteredStore(prefixStore, ctx.GasMeter(), k.GetStorageGasConfig(ctx)),
+		parent:   prefixStore,
+		maxBytes: k.GetContractStorageLimit(ctx, codeID),
+		load: func() types.ContractStorageStats {
+			return k.GetContractStorageStats(ctx, contractAddress)
+		},
+		save: func(stats types.ContractStorageStats) {
+			k.setContractStorageStats(ctx, contractAddress, stats)
+		},
+	}
+}
+
+// contractStoreErr returns the error that aborted the contract execution in the contract store, if any.
+func contractStoreErr(store sdk.KVStore) error {
+	if s, ok := store.(*storageStatsStore); ok {
+		return s.err
+	}
+	return nil
+}
+
+// updateContractStorageStats counts the keys and bytes of a contract store that was written without
+// a storageStatsStore, for example on genesis import.
+func (k Keeper) updateContractStorageStats(ctx sdk.Context, contractAddress sdk.AccAddress) {
+	var stats types.ContractStorageStats
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetContractStorePrefix(contractAddress))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		stats.KeyCount++
+		stats.ByteCount += uint64(len(iter.Key()) + len(iter.Value()))
+	}
+	k.setContractStorageStats(ctx, contractAddress, stats)
+}
+
+var _ sdk.KVStore = &storageStatsStore{}
+
+// storageStatsStore counts the keys and bytes that are written to or deleted from the contract store
+// and enforces the storage limit of the contract.
+type storageStatsStore struct {
+	sdk.KVStore
+	// parent is the contract store without the storage gas costs to look up the existing entries
+	parent sdk.KVStore
+	// stats are loaded with the first write, so that calls without writes are not charged for the bookkeeping
+	stats  types.ContractStorageStats
+	loaded bool
+	// initialBytes is the byte count before the contract call, used to settle the storage deposit
+	initialBytes uint64
+	maxBytes     uint64
+	load         func() types.ContractStorageStats
+	save         func(stats types.ContractStorageStats)
+	// err is set when a write exceeded the storage limit
+	err error
+}
+
+func (s *storageStatsStore) loadStats() {
+	if s.loaded {
+		return
+	}
+	s.stats = s.load()
+	s.initialBytes = s.stats.ByteCount
+	s.loaded = true
+}
+
+func (s *storageStatsStore) Set(key, value []byte) {
+	s.loadStats()
+	stats := s.stats
+	if old := s.parent.Get(key); old != nil {
+		stats = stats.Remove(uint64(len(key) + len(old)))
+	}
+	stats.KeyCount++
+	stats.ByteCount += uint64(len(key) + len(value))
+	if s.maxBytes != 0 && stats.ByteCount > s.maxBytes && stats.ByteCount > s.stats.ByteCount {
+		s.err = sdkerrors.Wrapf(types.ErrStorageLimit, "%d bytes exceed max %d", stats.ByteCount, s.maxBytes)
+		// abort the contract execution
+		panic(s.err)
+	}
+	s.KVStore.Set(key, value)
+	s.stats = stats
+	s.save(stats)
+}
+
+func (s *storageStatsStore) Delete(key []byte) {
+	s.loadStats()
+	old := s.parent.Get(key)
+	s.KVStore.Delete(key)
+	if old == nil {
+		return
+	}
+	s.stats = s.stats.Remove(uint64(len(key) + len(old)))
+	s.save(s.stats)
+}
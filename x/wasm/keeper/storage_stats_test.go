@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestStorageStatsStore(t *testing.T) {
+	specs := map[string]struct {
+		maxBytes uint64
+		exec     func(store sdk.KVStore)
+		expStats types.ContractStorageStats
+		expErr   bool
+	}{
+		"set new": {
+			exec:     func(store sdk.KVStore) { store.Set([]byte("foo"), []byte("bar1")) },
+			expStats: types.ContractStorageStats{KeyCount: 2, ByteCount: 7 + 7},
+		},
+		"overwrite": {
+			exec:     func(store sdk.KVStore) { store.Set([]byte("key"), []byte("v")) },
+			expStats: types.ContractStorageStats{KeyCount: 1, ByteCount: 4},
+		},
+		"delete": {
+			exec:     func(store sdk.KVStore) { store.Delete([]byte("key")) },
+			expStats: types.ContractStorageStats{},
+		},
+		"delete non existing": {
+			exec:     func(store sdk.KVStore) { store.Delete([]byte("foo")) },
+			expStats: types.ContractStorageStats{KeyCount: 1, ByteCount: 7},
+		},
+		"set within limit": {
+			maxBytes: 14,
+			exec:     func(store sdk.KVStore) { store.Set([]byte("foo"), []byte("bar1")) },
+			expStats: types.ContractStorageStats{KeyCount: 2, ByteCount: 14},
+		},
+		"set exceeds limit": {
+			maxBytes: 13,
+			exec:     func(store sdk.KVStore) { store.Set([]byte("foo"), []byte("bar1")) },
+			expStats: types.ContractStorageStats{KeyCount: 1, ByteCount: 7},
+			expErr:   true,
+		},
+		"shrink when above limit": {
+			maxBytes: 1,
+			exec:     func(store sdk.KVStore) { store.Set([]byte("key"), []byte("v")) },
+			expStats: types.ContractStorageStats{KeyCount: 1, ByteCount: 4},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			parent := dbadapter.Store{DB: dbm.NewMemDB()}
+			parent.Set([]byte("key"), []byte("valu"))
+			saved := types.ContractStorageStats{KeyCount: 1, ByteCount: 7}
+			store := &storageStatsStore{
+				KVStore:  parent,
+				parent:   parent,
+				maxBytes: spec.maxBytes,
+				load:     func() types.ContractStorageStats { return saved },
+				save:     func(stats types.ContractStorageStats) { saved = stats },
+			}
+			// when
+			if spec.expErr {
+				require.Panics(t, func() { spec.exec(store) })
+				assert.True(t, types.ErrStorageLimit.Is(contractStoreErr(store)))
+				assert.False(t, parent.Has([]byte("foo")))
+				assert.Equal(t, spec.expStats, saved)
+				return
+			}
+			spec.exec(store)
+			// then
+			require.NoError(t, contractStoreErr(store))
+			assert.Equal(t, spec.expStats, saved)
+		})
+	}
+}
+
+func TestContractStorageStats(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+
+	// stats match the store content
+	var expStats types.ContractStorageStats
+	iter := k.GetContractState(ctx, example.Contract)
+	for ; iter.Valid(); iter.Next() {
+		expStats.KeyCount++
+		expStats.ByteCount += uint64(len(iter.Key()) + len(iter.Value()))
+	}
+	require.NoError(t, iter.Close())
+	require.NotZero(t, expStats.KeyCount)
+	assert.Equal(t, expStats, k.GetContractStorageStats(ctx, example.Contract))
+
+	// and are updated on execute
+	_, err := k.Execute(ctx, example.Contract, example.VerifierAddr, []byte(`{"release":{}}`), nil)
+	require.NoError(t, err)
+	assert.Equal(t, expStats, k.GetContractStorageStats(ctx, example.Contract))
+}
+
+func TestContractStorageLimit(t *testing.T) {
+	specs := map[string]struct {
+		maxBytes   uint64
+		codeLimits func(codeID uint64) []types.CodeStorageLimit
+		expErr     bool
+	}{
+		"no limit": {},
+		"within limit": {
+			maxBytes: 1_000,
+		},
+		"exceeds limit": {
+			maxBytes: 10,
+			expErr:   true,
+		},
+		"code override raises limit": {
+			maxBytes: 10,
+			codeLimits: func(codeID uint64) []types.CodeStorageLimit {
+				return []types.CodeStorageLimit{{CodeID: codeID, MaxBytes: 1_000}}
+			},
+		},
+		"code override lowers limit": {
+			codeLimits: func(codeID uint64) []types.CodeStorageLimit {
+				return []types.CodeStorageLimit{{CodeID: codeID, MaxBytes: 10}}
+			},
+			expErr: true,
+		},
+		"override for other code": {
+			maxBytes: 10,
+			codeLimits: func(codeID uint64) []types.CodeStorageLimit {
+				return []types.CodeStorageLimit{{CodeID: codeID + 1, MaxBytes: 1_000}}
+			},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, SupportedFeatures)
+			k := keepers.WasmKeeper
+			example := StoreHackatomExampleContract(t, ctx, keepers)
+
+			params := k.GetParams(ctx)
+			params.MaxContractStorageBytes = spec.maxBytes
+			if spec.codeLimits != nil {
+				params.CodeStorageLimits = spec.codeLimits(example.CodeID)
+			}
+			k.setParams(ctx, params)
+
+			_, _, bob := keyPubAddr()
+			_, _, fred := keyPubAddr()
+			initMsg := HackatomExampleInitMsg{Verifier: fred, Beneficiary: bob}.GetBytes(t)
+
+			// when
+			contractAddr, _, err := k.Instantiate(ctx, example.CodeID, example.CreatorAddr, nil, initMsg, "label", nil)
+
+			// then
+			if spec.expErr {
+				assert.True(t, types.ErrStorageLimit.Is(err), "got %+v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotZero(t, k.GetContractStorageStats(ctx, contractAddr).ByteCount)
+		})
+	}
+}
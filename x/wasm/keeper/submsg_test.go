@@ -6,10 +6,14 @@ import (
 	"github.com/stretchr/testify/assert"
 	"io/ioutil"
 	"strconv"
+	"strings"
 	"testing"
 
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/wasmtesting"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/require"
 )
 
@@ -230,10 +234,16 @@ func TestDispatchSubMsgErrorHandling(t *testing.T) {
 	assertGotContractAddr := func(t *testing.T, ctx sdk.Context, contract, emptyAccount string, response wasmvmtypes.SubcallResult) {
 		// should get the events emitted on new contract
 		event := response.Ok.Events[0]
-		assert.Equal(t, event.Type, "wasm")
-		assert.Equal(t, event.Attributes[0].Key, "contract_address")
+		assert.Equal(t, "instantiate", event.Type)
+		assert.Equal(t, "_contract_address", event.Attributes[0].Key)
 		eventAddr := event.Attributes[0].Value
 		assert.NotEqual(t, contract, eventAddr)
+		assert.Equal(t, "code_id", event.Attributes[1].Key)
+
+		event = response.Ok.Events[1]
+		assert.Equal(t, "wasm", event.Type)
+		assert.Equal(t, "contract_address", event.Attributes[0].Key)
+		assert.Equal(t, eventAddr, event.Attributes[0].Value)
 
 		// data field is the raw canonical address
 		// QUESTION: why not types.MsgInstantiateContractResponse? difference between calling Router and Service?
@@ -261,14 +271,14 @@ func TestDispatchSubMsgErrorHandling(t *testing.T) {
 			submsgID: 5,
 			msg:      validBankSend,
 			// note we charge another 40k for the reply call
-			resultAssertions: []assertion{assertReturnedEvents(3), assertGasUsed(123000, 125000)},
+			resultAssertions: []assertion{assertReturnedEvents(3), assertGasUsed(141000, 143000)},
 		},
 		"not enough tokens": {
 			submsgID:    6,
 			msg:         invalidBankSend,
 			subMsgError: true,
 			// uses less gas than the send tokens (cost of bank transfer)
-			resultAssertions: []assertion{assertGasUsed(97000, 99000), assertErrorString("insufficient funds")},
+			resultAssertions: []assertion{assertGasUsed(102500, 104500), assertErrorString("codespace: sdk, code: 5")},
 		},
 		"out of gas panic with no gas limit": {
 			submsgID:        7,
@@ -281,7 +291,7 @@ func TestDispatchSubMsgErrorHandling(t *testing.T) {
 			msg:      validBankSend,
 			gasLimit: &subGasLimit,
 			// uses same gas as call without limit
-			resultAssertions: []assertion{assertReturnedEvents(3), assertGasUsed(123000, 125000)},
+			resultAssertions: []assertion{assertReturnedEvents(3), assertGasUsed(141000, 143000)},
 		},
 		"not enough tokens with limit": {
 			submsgID:    16,
@@ -289,21 +299,21 @@ func TestDispatchSubMsgErrorHandling(t *testing.T) {
 			subMsgError: true,
 			gasLimit:    &subGasLimit,
 			// uses same gas as call without limit
-			resultAssertions: []assertion{assertGasUsed(97000, 99000), assertErrorString("insufficient funds")},
+			resultAssertions: []assertion{assertGasUsed(102500, 104500), assertErrorString("codespace: sdk, code: 5")},
 		},
 		"out of gas caught with gas limit": {
 			submsgID:    17,
 			msg:         infiniteLoop,
 			subMsgError: true,
 			gasLimit:    &subGasLimit,
-			// uses all the subGasLimit, plus the 92k or so for the main contract
-			resultAssertions: []assertion{assertGasUsed(subGasLimit+92000, subGasLimit+94000), assertErrorString("out of gas")},
+			// uses all the subGasLimit, plus the 98k or so for the main contract
+			resultAssertions: []assertion{assertGasUsed(subGasLimit+98000, subGasLimit+100000), assertErrorString("codespace: sdk, code: 11")},
 		},
 
 		"instantiate contract gets address in data and events": {
 			submsgID:         21,
 			msg:              instantiateContract,
-			resultAssertions: []assertion{assertReturnedEvents(1), assertGotContractAddr},
+			resultAssertions: []assertion{assertReturnedEvents(2), assertGotContractAddr},
 		},
 	}
 
@@ -386,7 +396,7 @@ func TestDispatchSubMsgEncodeToNoSdkMsg(t *testing.T) {
 		Bank: nilEncoder,
 	}
 
-	ctx, keepers := CreateTestInput(t, false, ReflectFeatures, WithMessageHandler(NewSDKMessageHandler(nil, customEncoders)))
+	ctx, keepers := CreateTestInput(t, false, ReflectFeatures, WithMessageHandler(NewSDKMessageHandler(nil, customEncoders, nil)))
 	accKeeper, keeper, bankKeeper := keepers.AccountKeeper, keepers.WasmKeeper, keepers.BankKeeper
 
 	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
@@ -450,3 +460,205 @@ func TestDispatchSubMsgEncodeToNoSdkMsg(t *testing.T) {
 	assert.Empty(t, sub.Data)
 	require.Len(t, sub.Events, 0)
 }
+
+func TestDispatchSubMsgReplyOn(t *testing.T) {
+	var mockErr = sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, "node specific details")
+	specs := map[string]struct {
+		replyOn      types.ReplyOn
+		msgErr       error
+		expErr       bool
+		expReply     bool
+		expReplyErr  string
+		expResponses [][]byte
+	}{
+		"always on success": {
+			replyOn:      types.ReplyAlways,
+			expReply:     true,
+			expResponses: [][]byte{[]byte("first"), []byte("second")},
+		},
+		"always on error": {
+			replyOn:     types.ReplyAlways,
+			msgErr:      mockErr,
+			expReply:    true,
+			expReplyErr: "codespace: sdk, code: 5",
+		},
+		"success on success": {
+			replyOn:      types.ReplySuccess,
+			expReply:     true,
+			expResponses: [][]byte{[]byte("first"), []byte("second")},
+		},
+		"success on error": {
+			replyOn: types.ReplySuccess,
+			msgErr:  mockErr,
+			expErr:  true,
+		},
+		"error on success": {
+			replyOn:      types.ReplyError,
+			expResponses: [][]byte{[]byte("first"), []byte("second")},
+		},
+		"error on error": {
+			replyOn:     types.ReplyError,
+			msgErr:      mockErr,
+			expReply:    true,
+			expReplyErr: "codespace: sdk, code: 5",
+		},
+		"never on success": {
+			replyOn:      types.ReplyNever,
+			expResponses: [][]byte{[]byte("first"), []byte("second")},
+		},
+		"never on error": {
+			replyOn: types.ReplyNever,
+			msgErr:  mockErr,
+			expErr:  true,
+		},
+		"unknown reply mode": {
+			replyOn: types.ReplyOn("sometimes"),
+			expErr:  true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			mock := &wasmtesting.MockMessageHandler{
+				DispatchMsgFn: func(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) ([]sdk.Event, [][]byte, error) {
+					if spec.msgErr != nil {
+						return nil, nil, spec.msgErr
+					}
+					return []sdk.Event{sdk.NewEvent("mock")}, [][]byte{[]byte("first"), []byte("second")}, nil
+				},
+			}
+			ctx, keepers := CreateTestInput(t, false, ReflectFeatures, WithMessageHandler(mock))
+			keeper := keepers.WasmKeeper
+			creator := createFakeFundedAccount(t, ctx, keepers.AccountKeeper, keepers.BankKeeper, sdk.NewCoins(sdk.NewInt64Coin("denom", 100000)))
+
+			reflectCode, err := ioutil.ReadFile("./testdata/reflect.wasm")
+			require.NoError(t, err)
+			codeID, err := keeper.Create(ctx, creator, reflectCode, "", "", nil)
+			require.NoError(t, err)
+			contractAddr, _, err := keeper.Instantiate(ctx, codeID, creator, nil, []byte("{}"), "reflect contract 1", nil)
+			require.NoError(t, err)
+
+			// when
+			msgs := []types.SubMsg{{
+				ID:      7,
+				Msg:     wasmvmtypes.CosmosMsg{Custom: []byte(`{}`)},
+				ReplyOn: spec.replyOn,
+			}}
+			gotResponses, gotErr := keeper.dispatchSubmessages(ctx, contractAddr, "", msgs)
+
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expResponses, gotResponses)
+
+			queryBz, err := json.Marshal(ReflectQueryMsg{SubCallResult: &SubCall{ID: 7}})
+			require.NoError(t, err)
+			queryRes, err := keeper.QuerySmart(ctx, contractAddr, queryBz)
+			if !spec.expReply {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			var res wasmvmtypes.Reply
+			require.NoError(t, json.Unmarshal(queryRes, &res))
+			assert.Equal(t, spec.expReplyErr, res.Result.Err)
+			if spec.expReplyErr == "" {
+				require.NotNil(t, res.Result.Ok)
+				assert.Equal(t, []byte("first"), res.Result.Ok.Data)
+			}
+		})
+	}
+}
+
+func TestDispatchSubMsgOrdering(t *testing.T) {
+	okMsg := func(name string) wasmvmtypes.CosmosMsg {
+		return wasmvmtypes.CosmosMsg{Custom: []byte(fmt.Sprintf(`{"ok":%q}`, name))}
+	}
+	failMsg := func(name string) wasmvmtypes.CosmosMsg {
+		return wasmvmtypes.CosmosMsg{Custom: []byte(fmt.Sprintf(`{"fail":%q}`, name))}
+	}
+	specs := map[string]struct {
+		msgs          []types.SubMsg
+		expErr        bool
+		expDispatched []string
+		expReplies    map[uint64]bool // id -> success
+		expResponses  [][]byte
+	}{
+		"message before submessage": {
+			msgs: []types.SubMsg{
+				{Msg: okMsg("send"), ReplyOn: types.ReplyNever},
+				{ID: 1, Msg: okMsg("call"), ReplyOn: types.ReplyAlways},
+			},
+			expDispatched: []string{`{"ok":"send"}`, `{"ok":"call"}`},
+			expReplies:    map[uint64]bool{1: true},
+			expResponses:  [][]byte{[]byte(`{"ok":"send"}`), []byte(`{"ok":"call"}`)},
+		},
+		"interleaved with failing submessage": {
+			msgs: []types.SubMsg{
+				{Msg: okMsg("first"), ReplyOn: types.ReplyNever},
+				{ID: 1, Msg: failMsg("second"), ReplyOn: types.ReplyError},
+				{Msg: okMsg("third"), ReplyOn: types.ReplyNever},
+				{ID: 2, Msg: okMsg("fourth"), ReplyOn: types.ReplySuccess},
+			},
+			expDispatched: []string{`{"ok":"first"}`, `{"fail":"second"}`, `{"ok":"third"}`, `{"ok":"fourth"}`},
+			expReplies:    map[uint64]bool{1: false, 2: true},
+			expResponses:  [][]byte{[]byte(`{"ok":"first"}`), []byte(`{"ok":"third"}`), []byte(`{"ok":"fourth"}`)},
+		},
+		"failing message aborts the rest": {
+			msgs: []types.SubMsg{
+				{ID: 1, Msg: okMsg("first"), ReplyOn: types.ReplyAlways},
+				{Msg: failMsg("second"), ReplyOn: types.ReplyNever},
+				{ID: 2, Msg: okMsg("third"), ReplyOn: types.ReplyAlways},
+			},
+			expErr:        true,
+			expDispatched: []string{`{"ok":"first"}`, `{"fail":"second"}`},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var dispatched []string
+			mock := &wasmtesting.MockMessageHandler{
+				DispatchMsgFn: func(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) ([]sdk.Event, [][]byte, error) {
+					dispatched = append(dispatched, string(msg.Custom))
+					if strings.Contains(string(msg.Custom), "fail") {
+						return nil, nil, sdkerrors.ErrInsufficientFunds
+					}
+					return nil, [][]byte{msg.Custom}, nil
+				},
+			}
+			ctx, keepers := CreateTestInput(t, false, ReflectFeatures, WithMessageHandler(mock))
+			keeper := keepers.WasmKeeper
+			creator := createFakeFundedAccount(t, ctx, keepers.AccountKeeper, keepers.BankKeeper, sdk.NewCoins(sdk.NewInt64Coin("denom", 100000)))
+
+			reflectCode, err := ioutil.ReadFile("./testdata/reflect.wasm")
+			require.NoError(t, err)
+			codeID, err := keeper.Create(ctx, creator, reflectCode, "", "", nil)
+			require.NoError(t, err)
+			contractAddr, _, err := keeper.Instantiate(ctx, codeID, creator, nil, []byte("{}"), "reflect contract 1", nil)
+			require.NoError(t, err)
+
+			// when
+			gotResponses, gotErr := keeper.dispatchSubmessages(ctx, contractAddr, "", spec.msgs)
+
+			// then
+			assert.Equal(t, spec.expDispatched, dispatched)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expResponses, gotResponses)
+			for id, success := range spec.expReplies {
+				queryBz, err := json.Marshal(ReflectQueryMsg{SubCallResult: &SubCall{ID: id}})
+				require.NoError(t, err)
+				queryRes, err := keeper.QuerySmart(ctx, contractAddr, queryBz)
+				require.NoError(t, err)
+				var res wasmvmtypes.Reply
+				require.NoError(t, json.Unmarshal(queryRes, &res))
+				assert.Equal(t, success, res.Result.Ok != nil)
+			}
+		})
+	}
+}
@@ -134,6 +134,7 @@ type TestKeepers struct {
 	GovKeeper     govkeeper.Keeper
 	WasmKeeper    *Keeper
 	IBCKeeper     *ibckeeper.Keeper
+	ParamsKeeper  paramskeeper.Keeper
 	Router        *baseapp.Router
 }
 
@@ -212,6 +213,7 @@ func createTestInput(
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 		govtypes.ModuleName:            {authtypes.Burner},
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
+		types.ModuleName:               nil,
 	}
 	authSubsp, _ := paramsKeeper.GetSubspace(authtypes.ModuleName)
 	authKeeper := authkeeper.NewAccountKeeper(
@@ -328,6 +330,7 @@ func createTestInput(
 		BankKeeper:    bankKeeper,
 		GovKeeper:     govKeeper,
 		IBCKeeper:     ibcKeeper,
+		ParamsKeeper:  paramsKeeper,
 		Router:        router,
 	}
 	return ctx, keepers
@@ -342,6 +345,8 @@ func TestHandler(k *Keeper) sdk.Handler {
 			return handleStoreCode(ctx, k, msg)
 		case *types.MsgInstantiateContract:
 			return handleInstantiate(ctx, k, msg)
+		case *types.MsgInstantiateContract2:
+			return handleInstantiate2(ctx, k, msg)
 		case *types.MsgExecuteContract:
 			return handleExecute(ctx, k, msg)
 		default:
@@ -390,6 +395,29 @@ func handleInstantiate(ctx sdk.Context, k *Keeper, msg *types.MsgInstantiateCont
 	}, nil
 }
 
+func handleInstantiate2(ctx sdk.Context, k *Keeper, msg *types.MsgInstantiateContract2) (*sdk.Result, error) {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+	var adminAddr sdk.AccAddress
+	if msg.Admin != "" {
+		if adminAddr, err = sdk.AccAddressFromBech32(msg.Admin); err != nil {
+			return nil, sdkerrors.Wrap(err, "admin")
+		}
+	}
+
+	contractAddr, _, err := k.Instantiate2(ctx, msg.CodeID, senderAddr, adminAddr, msg.InitMsg, msg.Label, msg.Funds, msg.Salt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{
+		Data:   contractAddr,
+		Events: ctx.EventManager().Events().ToABCIEvents(),
+	}, nil
+}
+
 func handleExecute(ctx sdk.Context, k *Keeper, msg *types.MsgExecuteContract) (*sdk.Result, error) {
 	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
 	if err != nil {
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/CosmWasm/wasmd/x/wasm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -9,7 +10,7 @@ import (
 	tmBytes "github.com/tendermint/tendermint/libs/bytes"
 )
 
-var ModelFuzzers = []interface{}{FuzzAddr, FuzzAddrString, FuzzAbsoluteTxPosition, FuzzContractInfo, FuzzStateModel, FuzzAccessType, FuzzAccessConfig, FuzzContractCodeHistory}
+var ModelFuzzers = []interface{}{FuzzAddr, FuzzAddrString, FuzzAbsoluteTxPosition, FuzzContractInfo, FuzzStateModel, FuzzAccessType, FuzzAccessConfig, FuzzContractCodeHistory, FuzzParams}
 
 func FuzzAddr(m *sdk.AccAddress, c fuzz.Continue) {
 	*m = make([]byte, 20)
@@ -30,8 +31,16 @@ func FuzzContractInfo(m *types.ContractInfo, c fuzz.Continue) {
 	m.CodeID = c.RandUint64()
 	FuzzAddrString(&m.Creator, c)
 	FuzzAddrString(&m.Admin, c)
+	if c.RandBool() {
+		FuzzAddrString(&m.PendingAdmin, c)
+	}
+	if c.RandBool() {
+		m.MigrationAllowlist = &types.MigrationAllowlist{CodeIDs: []uint64{c.RandUint64()%1000 + 1}, CodeCreators: []string{""}}
+		FuzzAddrString(&m.MigrationAllowlist.CodeCreators[0], c)
+	}
 	m.Label = c.RandString()
 	c.Fuzz(&m.Created)
+	m.Paused = c.RandBool()
 }
 
 func FuzzContractCodeHistory(m *types.ContractCodeHistoryEntry, c fuzz.Continue) {
@@ -71,3 +80,44 @@ func FuzzAccessConfig(m *types.AccessConfig, c fuzz.Continue) {
 	FuzzAddr(&add, c)
 	*m = m.Permission.With(add)
 }
+
+func FuzzParams(m *types.Params, c fuzz.Continue) {
+	FuzzAccessConfig(&m.CodeUploadAccess, c)
+	FuzzAccessType(&m.InstantiateDefaultPermission, c)
+	if m.InstantiateDefaultPermission == types.AccessTypeOnlyContract {
+		m.InstantiateDefaultPermission = types.AccessTypeAnyOfAddresses
+	}
+	m.MaxWasmCodeSize = c.RandUint64()
+	m.AcceptedStargateQueries = make([]string, c.Intn(4)+1)
+	for i := range m.AcceptedStargateQueries {
+		m.AcceptedStargateQueries[i] = fmt.Sprintf("/fuzz.v1.Query/Path%d", i)
+	}
+	m.AcceptedStargateMsgs = make([]string, c.Intn(4)+1)
+	for i := range m.AcceptedStargateMsgs {
+		m.AcceptedStargateMsgs[i] = fmt.Sprintf("/fuzz.v1.MsgAccepted%d", i)
+	}
+	m.DeniedStargateMsgs = make([]string, c.Intn(4)+1)
+	for i := range m.DeniedStargateMsgs {
+		m.DeniedStargateMsgs[i] = fmt.Sprintf("/fuzz.v1.MsgDenied%d", i)
+	}
+	m.GasMultiplier = c.RandUint64()%1000 + 1
+	m.InstanceCost = c.RandUint64()
+	m.CompileCost = c.RandUint64()
+	m.HumanizeCost = c.RandUint64()
+	m.CanonicalizeCost = c.RandUint64()
+	m.ContractMemoryLimit = uint32(c.Intn(int(types.MaxContractMemoryLimit))) + 1
+	m.MaxContractStorageBytes = c.RandUint64()
+	m.CodeStorageLimits = make([]types.CodeStorageLimit, c.Intn(4)+1)
+	for i := range m.CodeStorageLimits {
+		m.CodeStorageLimits[i] = types.CodeStorageLimit{CodeID: uint64(i + 1), MaxBytes: c.RandUint64()}
+	}
+	m.StorageDepositPerByte = sdk.NewDecCoins(sdk.NewDecCoinFromDec("denom", sdk.NewDecWithPrec(int64(c.Intn(1000)+1), 3)))
+	m.StorageDepositFromContract = c.RandBool()
+	m.DeduplicateCodeUploads = c.RandBool()
+	// not below the gas limits of the callbacks in the genesis tests
+	m.MaxScheduledCallbackGas = c.RandUint64()%types.DefaultMaxScheduledCallbackGas + 1_000_000
+	m.StorageReadCostPerByte = c.RandUint64()
+	m.StorageWriteCostPerByte = c.RandUint64()
+	m.StorageIterNextCost = c.RandUint64()
+	m.StorageDeleteCost = c.RandUint64()
+}
@@ -33,6 +33,7 @@ type MockWasmer struct {
 	IBCPacketTimeoutFn  func(codeID wasmvm.Checksum, env wasmvmtypes.Env, packet wasmvmtypes.IBCPacket, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64) (*wasmvmtypes.IBCBasicResponse, uint64, error)
 	PinFn               func(checksum wasmvm.Checksum) error
 	UnpinFn             func(checksum wasmvm.Checksum) error
+	RemoveCodeFn        func(checksum wasmvm.Checksum) error
 }
 
 func (m *MockWasmer) IBCChannelOpen(codeID wasmvm.Checksum, env wasmvmtypes.Env, channel wasmvmtypes.IBCChannel, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64) (uint64, error) {
@@ -162,6 +163,14 @@ func (m *MockWasmer) Unpin(checksum wasmvm.Checksum) error {
 	return m.UnpinFn(checksum)
 }
 
+// RemoveCode is a no-op without a stub function as file removal is not relevant for most tests.
+func (m *MockWasmer) RemoveCode(checksum wasmvm.Checksum) error {
+	if m.RemoveCodeFn == nil {
+		return nil
+	}
+	return m.RemoveCodeFn(checksum)
+}
+
 var AlwaysPanicMockWasmer = &MockWasmer{}
 
 // selfCallingInstMockWasmer prepares a Wasmer mock that calls itself on instantiation.
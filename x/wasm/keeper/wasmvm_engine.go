@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"os"
+	"path/filepath"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+	wasmvm "github.com/CosmWasm/wasmvm"
+)
+
+var (
+	_ types.WasmerEngine = &wasmVMEngine{}
+	_ types.CodeRemover  = &wasmVMEngine{}
+	_ memoryLimitSetter  = &wasmVMEngine{}
+)
+
+// wasmVMEngine extends the wasmvm VM with the operations that are not provided by the library.
+type wasmVMEngine struct {
+	*wasmvm.VM
+	dataDir           string
+	supportedFeatures string
+	memoryLimit       uint32
+	printDebug        bool
+	cacheSize         uint32
+}
+
+func newWasmVMEngine(dataDir, supportedFeatures string, memoryLimit uint32, printDebug bool, cacheSize uint32) (*wasmVMEngine, error) {
+	vm, err := wasmvm.NewVM(dataDir, supportedFeatures, memoryLimit, printDebug, cacheSize)
+	if err != nil {
+		return nil, err
+	}
+	return &wasmVMEngine{
+		VM:                vm,
+		dataDir:           dataDir,
+		supportedFeatures: supportedFeatures,
+		memoryLimit:       memoryLimit,
+		printDebug:        printDebug,
+		cacheSize:         cacheSize,
+	}, nil
+}
+
+// MemoryLimit returns the memory limit of each contract execution (in MiB)
+func (e *wasmVMEngine) MemoryLimit() uint32 {
+	return e.memoryLimit
+}
+
+// SetMemoryLimit recreates the VM with the new memory limit (in MiB) when it differs from the current one.
+// The wasmvm cache can not be reconfigured, so the in memory cache is dropped and the pinned codes must be
+// pinned again. It must not be called while a contract is executed.
+func (e *wasmVMEngine) SetMemoryLimit(memoryLimit uint32) error {
+	if e.memoryLimit == memoryLimit {
+		return nil
+	}
+	vm, err := wasmvm.NewVM(e.dataDir, e.supportedFeatures, memoryLimit, e.printDebug, e.cacheSize)
+	if err != nil {
+		return err
+	}
+	e.VM.Cleanup()
+	e.VM = vm
+	e.memoryLimit = memoryLimit
+	return nil
+}
+
+// RemoveCode deletes the wasm code and the compiled modules from the wasmvm data directory.
+// The layout is `<dataDir>/wasm/<checksum>` for the code and `<dataDir>/modules/<version>/<checksum>`
+// for the compiled artifacts. An in memory cache entry is not affected and dropped on restart.
+func (e *wasmVMEngine) RemoveCode(checksum wasmvm.Checksum) error {
+	name := hex.EncodeToString(checksum)
+	modules, err := filepath.Glob(filepath.Join(e.dataDir, "modules", "*", name))
+	if err != nil {
+		return err
+	}
+	for _, p := range append(modules, filepath.Join(e.dataDir, "wasm", name)) {
+		if err := os.Remove(p); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
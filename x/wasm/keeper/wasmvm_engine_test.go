@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWasmVMEngineRemoveCode(t *testing.T) {
+	dataDir := t.TempDir()
+	engine, err := newWasmVMEngine(dataDir, SupportedFeatures, contractMemoryLimit, false, 0)
+	require.NoError(t, err)
+	defer engine.Cleanup()
+
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+	checksum, err := engine.Create(wasmCode)
+	require.NoError(t, err)
+	codeFile := filepath.Join(dataDir, "wasm", hex.EncodeToString(checksum))
+	modules, err := filepath.Glob(filepath.Join(dataDir, "modules", "*", hex.EncodeToString(checksum)))
+	require.NoError(t, err)
+	require.NotEmpty(t, modules)
+	require.FileExists(t, codeFile)
+
+	// when
+	require.NoError(t, engine.RemoveCode(checksum))
+
+	// then
+	for _, p := range append(modules, codeFile) {
+		_, err := os.Stat(p)
+		assert.True(t, os.IsNotExist(err), "file not removed: %s", p)
+	}
+	_, err = engine.GetCode(checksum)
+	assert.Error(t, err)
+	// and idempotent
+	assert.NoError(t, engine.RemoveCode(checksum))
+}
+
+func TestWasmVMEngineSetMemoryLimit(t *testing.T) {
+	engine, err := newWasmVMEngine(t.TempDir(), SupportedFeatures, contractMemoryLimit, false, 0)
+	require.NoError(t, err)
+	defer func() { engine.Cleanup() }()
+
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+	checksum, err := engine.Create(wasmCode)
+	require.NoError(t, err)
+	vm := engine.VM
+
+	// same limit keeps the vm
+	require.NoError(t, engine.SetMemoryLimit(contractMemoryLimit))
+	assert.Same(t, vm, engine.VM)
+
+	// when
+	require.NoError(t, engine.SetMemoryLimit(contractMemoryLimit*2))
+
+	// then
+	assert.NotSame(t, vm, engine.VM)
+	assert.Equal(t, contractMemoryLimit*2, engine.MemoryLimit())
+	// and stored code is still available
+	_, err = engine.GetCode(checksum)
+	assert.NoError(t, err)
+}
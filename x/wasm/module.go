@@ -156,7 +156,10 @@ func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
 // EndBlock returns the end blocker for the wasm module. It returns no validator
 // updates.
-func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	am.keeper.RemoveDeletedCodes(ctx)
+	am.keeper.ExecuteScheduledCallbacks(ctx)
+	am.keeper.UpdateContractMemoryLimit(ctx)
 	return []abci.ValidatorUpdate{}
 }
 
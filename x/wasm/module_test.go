@@ -179,12 +179,15 @@ func TestHandleInstantiate(t *testing.T) {
 	contractBech32Addr := parseInitResponse(t, res.Data)
 
 	require.Equal(t, "cosmos18vd8fpwxzck93qlwghaj6arh4p7c5n89uzcee5", contractBech32Addr)
-	// this should be standard x/wasm init event, nothing from contract
-	require.Equal(t, 2, len(res.Events), prettyEvents(res.Events))
-	assert.Equal(t, "wasm", res.Events[0].Type)
-	assertAttribute(t, "contract_address", contractBech32Addr, res.Events[0].Attributes[0])
-	assert.Equal(t, "message", res.Events[1].Type)
-	assertAttribute(t, "module", "wasm", res.Events[1].Attributes[0])
+	// this should be the keeper instantiate event plus standard x/wasm init event, nothing from contract
+	require.Equal(t, 3, len(res.Events), prettyEvents(res.Events))
+	assert.Equal(t, "instantiate", res.Events[0].Type)
+	assertAttribute(t, "_contract_address", contractBech32Addr, res.Events[0].Attributes[0])
+	assertAttribute(t, "code_id", "1", res.Events[0].Attributes[1])
+	assert.Equal(t, "wasm", res.Events[1].Type)
+	assertAttribute(t, "contract_address", contractBech32Addr, res.Events[1].Attributes[0])
+	assert.Equal(t, "message", res.Events[2].Type)
+	assertAttribute(t, "module", "wasm", res.Events[2].Attributes[0])
 
 	assertCodeList(t, q, data.ctx, 1)
 	assertCodeBytes(t, q, data.ctx, 1, testContract)
@@ -198,6 +201,54 @@ func TestHandleInstantiate(t *testing.T) {
 	})
 }
 
+func TestHandleStoreAndInstantiate(t *testing.T) {
+	data := setupTest(t)
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator := createFakeFundedAccount(t, data.ctx, data.acctKeeper, data.bankKeeper, deposit)
+
+	h := data.module.Route().Handler()
+	q := data.module.LegacyQuerierHandler(nil)
+
+	_, _, bob := keyPubAddr()
+	_, _, fred := keyPubAddr()
+
+	initMsg := initMsg{
+		Verifier:    fred,
+		Beneficiary: bob,
+	}
+	initMsgBz, err := json.Marshal(initMsg)
+	require.NoError(t, err)
+
+	// create with no balance is also legal
+	msg := &MsgStoreAndInstantiateContract{
+		Sender:                creator.String(),
+		WASMByteCode:          testContract,
+		InstantiatePermission: &types.AllowEverybody,
+		Label:                 "testing",
+		InitMsg:               initMsgBz,
+		Funds:                 nil,
+	}
+	res, err := h(data.ctx, msg)
+	require.NoError(t, err)
+
+	var pResp MsgStoreAndInstantiateContractResponse
+	require.NoError(t, pResp.Unmarshal(res.Data))
+	assert.Equal(t, uint64(1), pResp.CodeID)
+	require.Equal(t, "cosmos18vd8fpwxzck93qlwghaj6arh4p7c5n89uzcee5", pResp.Address)
+
+	assertCodeList(t, q, data.ctx, 1)
+	assertCodeBytes(t, q, data.ctx, 1, testContract)
+
+	assertContractList(t, q, data.ctx, 1, []string{pResp.Address})
+	assertContractInfo(t, q, data.ctx, pResp.Address, 1, creator)
+	assertContractState(t, q, data.ctx, pResp.Address, state{
+		Verifier:    []byte(fred),
+		Beneficiary: []byte(bob),
+		Funder:      []byte(creator),
+	})
+}
+
 func TestHandleExecute(t *testing.T) {
 	data := setupTest(t)
 
@@ -236,13 +287,16 @@ func TestHandleExecute(t *testing.T) {
 	contractBech32Addr := parseInitResponse(t, res.Data)
 
 	require.Equal(t, "cosmos18vd8fpwxzck93qlwghaj6arh4p7c5n89uzcee5", contractBech32Addr)
-	// this should be standard x/wasm init event, plus a bank send event (2), with no custom contract events
-	require.Equal(t, 3, len(res.Events), prettyEvents(res.Events))
+	// this should be standard x/wasm init event, plus a bank send event (2) and the keeper instantiate event, with no custom contract events
+	require.Equal(t, 4, len(res.Events), prettyEvents(res.Events))
 	assert.Equal(t, "transfer", res.Events[0].Type)
-	assert.Equal(t, "wasm", res.Events[1].Type)
-	assertAttribute(t, "contract_address", contractBech32Addr, res.Events[1].Attributes[0])
-	assert.Equal(t, "message", res.Events[2].Type)
-	assertAttribute(t, "module", "wasm", res.Events[2].Attributes[0])
+	assert.Equal(t, "instantiate", res.Events[1].Type)
+	assertAttribute(t, "_contract_address", contractBech32Addr, res.Events[1].Attributes[0])
+	assertAttribute(t, "code_id", "1", res.Events[1].Attributes[1])
+	assert.Equal(t, "wasm", res.Events[2].Type)
+	assertAttribute(t, "contract_address", contractBech32Addr, res.Events[2].Attributes[0])
+	assert.Equal(t, "message", res.Events[3].Type)
+	assertAttribute(t, "module", "wasm", res.Events[3].Attributes[0])
 
 	// ensure bob doesn't exist
 	bobAcct := data.acctKeeper.GetAccount(data.ctx, bob)
@@ -272,25 +326,30 @@ func TestHandleExecute(t *testing.T) {
 	assertExecuteResponse(t, res.Data, []byte{0xf0, 0x0b, 0xaa})
 
 	// this should be standard x/wasm init event, plus 2 bank send event, plus a special event from the contract
-	require.Equal(t, 4, len(res.Events), prettyEvents(res.Events))
+	// and the keeper execute event
+	require.Equal(t, 5, len(res.Events), prettyEvents(res.Events))
 
 	require.Equal(t, "transfer", res.Events[0].Type)
 	require.Len(t, res.Events[0].Attributes, 3)
 	assertAttribute(t, "recipient", contractBech32Addr, res.Events[0].Attributes[0])
 	assertAttribute(t, "sender", fred.String(), res.Events[0].Attributes[1])
 	assertAttribute(t, "amount", "5000denom", res.Events[0].Attributes[2])
+	// keeper execute event
+	assert.Equal(t, "execute", res.Events[1].Type)
+	assertAttribute(t, "_contract_address", contractBech32Addr, res.Events[1].Attributes[0])
+	assertAttribute(t, "code_id", "1", res.Events[1].Attributes[1])
 	// custom contract event
-	assert.Equal(t, "wasm", res.Events[1].Type)
-	assertAttribute(t, "contract_address", contractBech32Addr, res.Events[1].Attributes[0])
-	assertAttribute(t, "action", "release", res.Events[1].Attributes[1])
+	assert.Equal(t, "wasm", res.Events[2].Type)
+	assertAttribute(t, "contract_address", contractBech32Addr, res.Events[2].Attributes[0])
+	assertAttribute(t, "action", "release", res.Events[2].Attributes[1])
 	// second transfer (this without conflicting message)
-	assert.Equal(t, "transfer", res.Events[2].Type)
-	assertAttribute(t, "recipient", bob.String(), res.Events[2].Attributes[0])
-	assertAttribute(t, "sender", contractBech32Addr, res.Events[2].Attributes[1])
-	assertAttribute(t, "amount", "105000denom", res.Events[2].Attributes[2])
+	assert.Equal(t, "transfer", res.Events[3].Type)
+	assertAttribute(t, "recipient", bob.String(), res.Events[3].Attributes[0])
+	assertAttribute(t, "sender", contractBech32Addr, res.Events[3].Attributes[1])
+	assertAttribute(t, "amount", "105000denom", res.Events[3].Attributes[2])
 	// finally, standard x/wasm tag
-	assert.Equal(t, "message", res.Events[3].Type)
-	assertAttribute(t, "module", "wasm", res.Events[3].Attributes[0])
+	assert.Equal(t, "message", res.Events[4].Type)
+	assertAttribute(t, "module", "wasm", res.Events[4].Attributes[0])
 
 	// ensure bob now exists and got both payments released
 	bobAcct = data.acctKeeper.GetAccount(data.ctx, bob)
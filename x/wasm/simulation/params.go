@@ -43,5 +43,18 @@ func RandomParams(r *rand.Rand) types.Params {
 		CodeUploadAccess:             accessConfig,
 		InstantiateDefaultPermission: accessConfig.Permission,
 		MaxWasmCodeSize:              uint64(simtypes.RandIntBetween(r, 1, 600) * 1024),
+		AcceptedStargateQueries:      types.DefaultAcceptedStargateQueries,
+		AcceptedStargateMsgs:         types.DefaultAcceptedStargateMsgs,
+		GasMultiplier:                types.DefaultGasMultiplier,
+		InstanceCost:                 types.DefaultInstanceCost,
+		CompileCost:                  types.DefaultCompileCost,
+		HumanizeCost:                 types.DefaultHumanizeCost,
+		CanonicalizeCost:             types.DefaultCanonicalizeCost,
+		ContractMemoryLimit:          types.DefaultContractMemoryLimit,
+		MaxScheduledCallbackGas:      types.DefaultMaxScheduledCallbackGas,
+		StorageReadCostPerByte:       types.DefaultStorageReadCostPerByte,
+		StorageWriteCostPerByte:      types.DefaultStorageWriteCostPerByte,
+		StorageIterNextCost:          types.DefaultStorageIterNextCost,
+		StorageDeleteCost:            types.DefaultStorageDeleteCost,
 	}
 }
@@ -0,0 +1,24 @@
+package types
+
+import (
+	"crypto/sha256"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// predictableAddressPrefix separates the hash input of salt based contract addresses from any other address scheme
+const predictableAddressPrefix = "wasm/predictable"
+
+// BuildContractAddressPredictable generates a contract address from the code checksum, the creator and a
+// user supplied salt. The result does not depend on any chain state so that it can be computed before the
+// contract is instantiated.
+func BuildContractAddressPredictable(checksum []byte, creator sdk.AccAddress, salt []byte) sdk.AccAddress {
+	h := sha256.New()
+	h.Write([]byte(predictableAddressPrefix))
+	// length prefix every element to prevent ambiguous concatenations
+	for _, b := range [][]byte{checksum, creator, salt} {
+		h.Write(sdk.Uint64ToBigEndian(uint64(len(b))))
+		h.Write(b)
+	}
+	return sdk.AccAddress(h.Sum(nil)[:sdk.AddrLen])
+}
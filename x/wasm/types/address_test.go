@@ -0,0 +1,52 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildContractAddressPredictable(t *testing.T) {
+	var (
+		checksum = bytes.Repeat([]byte{1}, 32)
+		creator  = sdk.AccAddress(bytes.Repeat([]byte{2}, sdk.AddrLen))
+		salt     = []byte("my salt")
+	)
+	addr := BuildContractAddressPredictable(checksum, creator, salt)
+	assert.Len(t, addr, sdk.AddrLen)
+	assert.Equal(t, addr, BuildContractAddressPredictable(checksum, creator, salt), "must be deterministic")
+
+	specs := map[string]struct {
+		checksum []byte
+		creator  sdk.AccAddress
+		salt     []byte
+	}{
+		"other checksum": {
+			checksum: bytes.Repeat([]byte{3}, 32),
+			creator:  creator,
+			salt:     salt,
+		},
+		"other creator": {
+			checksum: checksum,
+			creator:  sdk.AccAddress(bytes.Repeat([]byte{3}, sdk.AddrLen)),
+			salt:     salt,
+		},
+		"other salt": {
+			checksum: checksum,
+			creator:  creator,
+			salt:     []byte("other salt"),
+		},
+		"shifted bytes between creator and salt": {
+			checksum: checksum,
+			creator:  creator[:sdk.AddrLen-1],
+			salt:     append([]byte{creator[sdk.AddrLen-1]}, salt...),
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			assert.NotEqual(t, addr, BuildContractAddressPredictable(spec.checksum, spec.creator, spec.salt))
+		})
+	}
+}
@@ -13,12 +13,30 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgStoreCode{}, "wasm/MsgStoreCode", nil)
 	cdc.RegisterConcrete(&MsgInstantiateContract{}, "wasm/MsgInstantiateContract", nil)
+	cdc.RegisterConcrete(&MsgInstantiateContract2{}, "wasm/MsgInstantiateContract2", nil)
+	cdc.RegisterConcrete(&MsgStoreAndInstantiateContract{}, "wasm/MsgStoreAndInstantiateContract", nil)
 	cdc.RegisterConcrete(&MsgExecuteContract{}, "wasm/MsgExecuteContract", nil)
 	cdc.RegisterConcrete(&MsgMigrateContract{}, "wasm/MsgMigrateContract", nil)
 	cdc.RegisterConcrete(&MsgUpdateAdmin{}, "wasm/MsgUpdateAdmin", nil)
 	cdc.RegisterConcrete(&MsgClearAdmin{}, "wasm/MsgClearAdmin", nil)
+	cdc.RegisterConcrete(&MsgProposeAdmin{}, "wasm/MsgProposeAdmin", nil)
+	cdc.RegisterConcrete(&MsgAcceptAdmin{}, "wasm/MsgAcceptAdmin", nil)
+	cdc.RegisterConcrete(&MsgCancelProposedAdmin{}, "wasm/MsgCancelProposedAdmin", nil)
+	cdc.RegisterConcrete(&MsgDeleteCode{}, "wasm/MsgDeleteCode", nil)
+	cdc.RegisterConcrete(&MsgUpdateInstantiateConfig{}, "wasm/MsgUpdateInstantiateConfig", nil)
+	cdc.RegisterConcrete(&MsgUpdateMigrationAllowlist{}, "wasm/MsgUpdateMigrationAllowlist", nil)
+	cdc.RegisterConcrete(&MsgScheduleCallback{}, "wasm/MsgScheduleCallback", nil)
+	cdc.RegisterConcrete(&MsgCancelCallback{}, "wasm/MsgCancelCallback", nil)
 	cdc.RegisterConcrete(&PinCodesProposal{}, "wasm/PinCodesProposal", nil)
 	cdc.RegisterConcrete(&UnpinCodesProposal{}, "wasm/UnpinCodesProposal", nil)
+	cdc.RegisterConcrete(&DeleteCodesProposal{}, "wasm/DeleteCodesProposal", nil)
+	cdc.RegisterConcrete(&PauseContractProposal{}, "wasm/PauseContractProposal", nil)
+	cdc.RegisterConcrete(&UnpauseContractProposal{}, "wasm/UnpauseContractProposal", nil)
+	cdc.RegisterConcrete(&ExecuteContractProposal{}, "wasm/ExecuteContractProposal", nil)
+	cdc.RegisterConcrete(&SudoContractProposal{}, "wasm/SudoContractProposal", nil)
+	cdc.RegisterConcrete(&StoreAndInstantiateContractProposal{}, "wasm/StoreAndInstantiateContractProposal", nil)
+	cdc.RegisterConcrete(&UpdateInstantiateConfigProposal{}, "wasm/UpdateInstantiateConfigProposal", nil)
+	cdc.RegisterConcrete(&UpdateMigrationAllowlistProposal{}, "wasm/UpdateMigrationAllowlistProposal", nil)
 
 	cdc.RegisterConcrete(&StoreCodeProposal{}, "wasm/StoreCodeProposal", nil)
 	cdc.RegisterConcrete(&InstantiateContractProposal{}, "wasm/InstantiateContractProposal", nil)
@@ -32,10 +50,20 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgStoreCode{},
 		&MsgInstantiateContract{},
+		&MsgInstantiateContract2{},
+		&MsgStoreAndInstantiateContract{},
 		&MsgExecuteContract{},
 		&MsgMigrateContract{},
 		&MsgUpdateAdmin{},
 		&MsgClearAdmin{},
+		&MsgProposeAdmin{},
+		&MsgAcceptAdmin{},
+		&MsgCancelProposedAdmin{},
+		&MsgDeleteCode{},
+		&MsgUpdateInstantiateConfig{},
+		&MsgUpdateMigrationAllowlist{},
+		&MsgScheduleCallback{},
+		&MsgCancelCallback{},
 		&MsgIBCCloseChannel{},
 		&MsgIBCSend{},
 	)
@@ -48,6 +76,14 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&ClearAdminProposal{},
 		&PinCodesProposal{},
 		&UnpinCodesProposal{},
+		&DeleteCodesProposal{},
+		&PauseContractProposal{},
+		&UnpauseContractProposal{},
+		&ExecuteContractProposal{},
+		&SudoContractProposal{},
+		&StoreAndInstantiateContractProposal{},
+		&UpdateInstantiateConfigProposal{},
+		&UpdateMigrationAllowlistProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
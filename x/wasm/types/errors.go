@@ -66,4 +66,22 @@ var (
 
 	// ErrUnknownMsg error by a message handler to show that it is not responsible for this message type
 	ErrUnknownMsg = sdkErrors.Register(DefaultCodespace, 20, "unknown message from the contract")
+
+	// ErrCodeInUse error when a code is still pinned or referenced by a contract
+	ErrCodeInUse = sdkErrors.Register(DefaultCodespace, 21, "code in use")
+
+	// ErrContractPaused error when a paused contract is called
+	ErrContractPaused = sdkErrors.Register(DefaultCodespace, 22, "contract paused")
+
+	// ErrInvalidEvent error if an attribute/event from the contract is invalid
+	ErrInvalidEvent = sdkErrors.Register(DefaultCodespace, 23, "invalid event")
+
+	// ErrStargateMsgNotAllowed error for stargate messages that contracts are not allowed to dispatch
+	ErrStargateMsgNotAllowed = sdkErrors.Register(DefaultCodespace, 24, "stargate message type not allowed")
+
+	// ErrStorageLimit error when a contract exceeds its storage limit
+	ErrStorageLimit = sdkErrors.Register(DefaultCodespace, 25, "contract storage limit exceeded")
+
+	// ErrStorageDeposit error when the storage deposit can not be locked or refunded
+	ErrStorageDeposit = sdkErrors.Register(DefaultCodespace, 26, "storage deposit failed")
 )
@@ -1,12 +1,41 @@
 package types
 
 const (
-	EventTypePinCode   = "pin_code"
-	EventTypeUnpinCode = "unpin_code"
+	EventTypePinCode                  = "pin_code"
+	EventTypeUnpinCode                = "unpin_code"
+	EventTypeDeleteCode               = "delete_code"
+	EventTypePauseContract            = "pause_contract"
+	EventTypeUnpauseContract          = "unpause_contract"
+	EventTypeScheduledCallback        = "scheduled_callback"
+	EventTypeInstantiate              = "instantiate"
+	EventTypeExecute                  = "execute"
+	EventTypeSudo                     = "sudo"
+	EventTypeMigrate                  = "migrate"
+	EventTypeUpdateAdmin              = "update_contract_admin"
+	EventTypeProposeAdmin             = "propose_contract_admin"
+	EventTypeCancelProposedAdmin      = "cancel_proposed_contract_admin"
+	EventTypeUpdateInstantiateConfig  = "update_instantiate_config"
+	EventTypeUpdateMigrationAllowlist = "update_migration_allowlist"
 )
 const ( // event attributes
-	AttributeKeyContract = "contract_address"
-	AttributeKeyCodeID   = "code_id"
-	AttributeKeyCodeIDs  = "code_ids"
-	AttributeKeySigner   = "signer"
+	AttributeKeyContract              = "contract_address"
+	AttributeKeyCodeID                = "code_id"
+	AttributeKeyCodeIDs               = "code_ids"
+	AttributeKeySigner                = "signer"
+	AttributeKeyCallbackID            = "callback_id"
+	AttributeKeyResult                = "result"
+	AttributeKeyError                 = "error"
+	AttributeKeyNewAdmin              = "new_admin_address"
+	AttributeKeyPendingAdmin          = "pending_admin_address"
+	AttributeKeyInstantiatePermission = "instantiate_permission"
+	AttributeKeyMigrationAllowlist    = "migration_allowlist"
+)
+
+const (
+	// CustomContractEventPrefix is prepended to the type of the custom events that are emitted by contracts
+	CustomContractEventPrefix = "wasm-"
+	// AttributeReservedPrefix marks attribute keys that are set by the keeper and can not be used by contracts
+	AttributeReservedPrefix = "_"
+	// AttributeKeyContractAddrReserved is the contract address that the keeper adds to custom and keeper events
+	AttributeKeyContractAddrReserved = AttributeReservedPrefix + "contract_address"
 )
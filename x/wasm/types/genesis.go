@@ -37,6 +37,11 @@ func (s GenesisState) ValidateBasic() error {
 			return sdkerrors.Wrapf(err, "gen message: %d", i)
 		}
 	}
+	for i := range s.ScheduledCallbacks {
+		if err := s.ScheduledCallbacks[i].ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "scheduled callback: %d", i)
+		}
+	}
 	return nil
 }
 
@@ -69,6 +74,9 @@ func (c Contract) ValidateBasic() error {
 			return sdkerrors.Wrapf(err, "contract state %d", i)
 		}
 	}
+	if err := c.StorageDeposit.Validate(); err != nil {
+		return sdkerrors.Wrap(err, "storage deposit")
+	}
 	return nil
 }
 
@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -25,11 +27,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState - genesis state of x/wasm
 type GenesisState struct {
-	Params    Params                 `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	Codes     []Code                 `protobuf:"bytes,2,rep,name=codes,proto3" json:"codes,omitempty"`
-	Contracts []Contract             `protobuf:"bytes,3,rep,name=contracts,proto3" json:"contracts,omitempty"`
-	Sequences []Sequence             `protobuf:"bytes,4,rep,name=sequences,proto3" json:"sequences,omitempty"`
-	GenMsgs   []GenesisState_GenMsgs `protobuf:"bytes,5,rep,name=gen_msgs,json=genMsgs,proto3" json:"gen_msgs,omitempty"`
+	Params             Params                 `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Codes              []Code                 `protobuf:"bytes,2,rep,name=codes,proto3" json:"codes,omitempty"`
+	Contracts          []Contract             `protobuf:"bytes,3,rep,name=contracts,proto3" json:"contracts,omitempty"`
+	Sequences          []Sequence             `protobuf:"bytes,4,rep,name=sequences,proto3" json:"sequences,omitempty"`
+	GenMsgs            []GenesisState_GenMsgs `protobuf:"bytes,5,rep,name=gen_msgs,json=genMsgs,proto3" json:"gen_msgs,omitempty"`
+	ScheduledCallbacks []ScheduledCallback    `protobuf:"bytes,6,rep,name=scheduled_callbacks,json=scheduledCallbacks,proto3" json:"scheduled_callbacks,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -100,6 +103,13 @@ func (m *GenesisState) GetGenMsgs() []GenesisState_GenMsgs {
 	return nil
 }
 
+func (m *GenesisState) GetScheduledCallbacks() []ScheduledCallback {
+	if m != nil {
+		return m.ScheduledCallbacks
+	}
+	return nil
+}
+
 // GenMsgs define the messages that can be executed during genesis phase in
 // order. The intention is to have more human readable data that is auditable.
 type GenesisState_GenMsgs struct {
@@ -277,6 +287,8 @@ type Contract struct {
 	ContractAddress string       `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
 	ContractInfo    ContractInfo `protobuf:"bytes,2,opt,name=contract_info,json=contractInfo,proto3" json:"contract_info"`
 	ContractState   []Model      `protobuf:"bytes,3,rep,name=contract_state,json=contractState,proto3" json:"contract_state"`
+	// StorageDeposit is the amount locked for the contract state
+	StorageDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=storage_deposit,json=storageDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"storage_deposit,omitempty"`
 }
 
 func (m *Contract) Reset()         { *m = Contract{} }
@@ -333,6 +345,13 @@ func (m *Contract) GetContractState() []Model {
 	return nil
 }
 
+func (m *Contract) GetStorageDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.StorageDeposit
+	}
+	return nil
+}
+
 // Sequence key and value of an id generation counter
 type Sequence struct {
 	IDKey []byte `protobuf:"bytes,1,opt,name=id_key,json=idKey,proto3" json:"id_key,omitempty"`
@@ -399,48 +418,55 @@ func init() {
 }
 
 var fileDescriptor_931ba204ce53afe0 = []byte{
-	// 647 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x86, 0xe3, 0x26, 0x4e, 0x93, 0x69, 0xa0, 0xd5, 0xb6, 0x80, 0x95, 0x52, 0x27, 0x24, 0x12,
-	0x6a, 0x05, 0x24, 0x6a, 0x39, 0x72, 0x01, 0xb7, 0x88, 0x86, 0xaa, 0x08, 0xb9, 0x12, 0x48, 0xbd,
-	0x44, 0x8e, 0x3d, 0x35, 0x16, 0xb5, 0x37, 0x64, 0x37, 0xa5, 0x7e, 0x0b, 0xc4, 0x43, 0xf0, 0x2c,
-	0x3d, 0xf6, 0xc8, 0x29, 0x42, 0xe9, 0x8d, 0xa7, 0x40, 0xbb, 0x5e, 0xbb, 0x46, 0xd4, 0xe5, 0x62,
-	0x79, 0x66, 0xff, 0xf9, 0x76, 0x66, 0x76, 0x76, 0xa1, 0xeb, 0x52, 0x16, 0x7e, 0x75, 0x58, 0xd8,
-	0x97, 0x9f, 0xb3, 0xed, 0x11, 0x72, 0x67, 0xbb, 0xef, 0x63, 0x84, 0x2c, 0x60, 0xbd, 0xf1, 0x84,
-	0x72, 0x4a, 0xee, 0xa5, 0xa2, 0x9e, 0xfc, 0x28, 0x51, 0x73, 0xcd, 0xa7, 0x3e, 0x95, 0x8a, 0xbe,
-	0xf8, 0x4b, 0xc4, 0xcd, 0x47, 0x37, 0x13, 0x79, 0x3c, 0x46, 0xc5, 0x6b, 0x9a, 0x05, 0x92, 0xf3,
-	0x64, 0xbd, 0x73, 0xa1, 0x43, 0xe3, 0x4d, 0x92, 0xc1, 0x11, 0x77, 0x38, 0x92, 0x17, 0x50, 0x1d,
-	0x3b, 0x13, 0x27, 0x64, 0x86, 0xd6, 0xd6, 0x36, 0x97, 0x76, 0x36, 0x7a, 0x37, 0x66, 0xd4, 0x7b,
-	0x2f, 0x45, 0x56, 0xe5, 0x62, 0xd6, 0x2a, 0xd9, 0x2a, 0x84, 0xbc, 0x05, 0xdd, 0xa5, 0x1e, 0x32,
-	0x63, 0xa1, 0x5d, 0xde, 0x5c, 0xda, 0x59, 0x2f, 0x88, 0xdd, 0xa5, 0x1e, 0x5a, 0x0f, 0x44, 0xe4,
-	0xef, 0x59, 0x6b, 0x59, 0x46, 0x3c, 0xa5, 0x61, 0xc0, 0x31, 0x1c, 0xf3, 0xd8, 0x4e, 0x10, 0xe4,
-	0x18, 0xea, 0x2e, 0x8d, 0xf8, 0xc4, 0x71, 0x39, 0x33, 0xca, 0x92, 0xd7, 0x2a, 0xe4, 0x25, 0x3a,
-	0x6b, 0x5d, 0x31, 0x57, 0xb3, 0xc8, 0x1c, 0xf7, 0x1a, 0x27, 0xd8, 0x0c, 0xbf, 0x4c, 0x31, 0x72,
-	0x91, 0x19, 0x95, 0x5b, 0xd9, 0x47, 0x4a, 0x77, 0xcd, 0xce, 0x22, 0xf3, 0xec, 0xcc, 0x49, 0x46,
-	0x50, 0xf3, 0x31, 0x1a, 0x86, 0xcc, 0x67, 0x86, 0x2e, 0xd1, 0x4f, 0x0a, 0xd0, 0xf9, 0xbe, 0x0b,
-	0xe3, 0x90, 0xf9, 0xcc, 0x6a, 0xaa, 0x6d, 0x48, 0x0a, 0xc9, 0xed, 0xb2, 0xe8, 0x27, 0xa2, 0xe6,
-	0xf7, 0x05, 0x58, 0x54, 0x01, 0x64, 0x0f, 0x80, 0x71, 0x3a, 0xc1, 0xa1, 0x68, 0x9b, 0x3a, 0xb4,
-	0x6e, 0xc1, 0x8e, 0x87, 0xcc, 0x3f, 0x12, 0x5a, 0x71, 0x00, 0xfb, 0x25, 0xbb, 0xce, 0x52, 0x83,
-	0x8c, 0x60, 0x2d, 0x88, 0x18, 0x77, 0x22, 0x1e, 0x38, 0x5c, 0xb0, 0x92, 0x56, 0x19, 0x0b, 0x92,
-	0xf7, 0xac, 0x98, 0x37, 0xb8, 0x8e, 0x4a, 0x8f, 0x61, 0xbf, 0x64, 0xaf, 0x06, 0xff, 0xba, 0xc9,
-	0x07, 0x58, 0xc1, 0x73, 0x74, 0xa7, 0x79, 0x7e, 0x59, 0xf2, 0xb7, 0x8a, 0xf9, 0xaf, 0x93, 0x88,
-	0x1c, 0x7b, 0x19, 0xff, 0x76, 0x59, 0x3a, 0x94, 0xd9, 0x34, 0xec, 0xfc, 0xd0, 0xa0, 0x22, 0x6b,
-	0xe9, 0xc2, 0xa2, 0xe8, 0xc5, 0x30, 0xf0, 0x64, 0x3b, 0x2a, 0x16, 0xcc, 0x67, 0xad, 0xaa, 0x58,
-	0x1a, 0xec, 0xd9, 0x55, 0xb1, 0x34, 0xf0, 0x88, 0x25, 0xc6, 0x4b, 0x88, 0xa2, 0x13, 0xaa, 0xaa,
-	0x6c, 0xdd, 0x32, 0xae, 0x83, 0xe8, 0x84, 0xaa, 0x61, 0xaf, 0xb9, 0xca, 0x26, 0x1b, 0x00, 0x92,
-	0x31, 0x8a, 0x39, 0x32, 0x59, 0x4a, 0xc3, 0x96, 0x54, 0x4b, 0x38, 0xc8, 0x7d, 0xa8, 0x8e, 0x83,
-	0x28, 0x42, 0xcf, 0xa8, 0xb4, 0xb5, 0xcd, 0x9a, 0xad, 0xac, 0xce, 0xa5, 0x06, 0xb5, 0xac, 0x29,
-	0x5b, 0xb0, 0x92, 0x36, 0x63, 0xe8, 0x78, 0xde, 0x04, 0x59, 0x72, 0xf3, 0xea, 0xf6, 0x72, 0xea,
-	0x7f, 0x95, 0xb8, 0xc9, 0x3b, 0xb8, 0x93, 0x49, 0x73, 0x69, 0x77, 0xff, 0x73, 0x2b, 0x72, 0xa9,
-	0x37, 0xdc, 0x9c, 0x8f, 0x0c, 0xe0, 0x6e, 0xc6, 0x63, 0x62, 0x08, 0xd5, 0x35, 0x7b, 0x58, 0x74,
-	0x1a, 0xd4, 0xc3, 0x53, 0x45, 0xca, 0x32, 0x91, 0xd3, 0xdb, 0xb1, 0xa0, 0x96, 0x5e, 0x14, 0xd2,
-	0x86, 0x6a, 0xe0, 0x0d, 0x3f, 0x63, 0x2c, 0xeb, 0x68, 0x58, 0xf5, 0xf9, 0xac, 0xa5, 0x0f, 0xf6,
-	0x0e, 0x30, 0xb6, 0xf5, 0xc0, 0x3b, 0xc0, 0x98, 0xac, 0x81, 0x7e, 0xe6, 0x9c, 0x4e, 0x51, 0x16,
-	0x50, 0xb1, 0x13, 0xc3, 0x7a, 0x79, 0x31, 0x37, 0xb5, 0xcb, 0xb9, 0xa9, 0xfd, 0x9a, 0x9b, 0xda,
-	0xb7, 0x2b, 0xb3, 0x74, 0x79, 0x65, 0x96, 0x7e, 0x5e, 0x99, 0xa5, 0xe3, 0xc7, 0x7e, 0xc0, 0x3f,
-	0x4d, 0x47, 0x3d, 0x97, 0x86, 0xfd, 0x5d, 0xca, 0xc2, 0x8f, 0xe9, 0x7b, 0xe6, 0xf5, 0xcf, 0x93,
-	0x77, 0x4d, 0x3e, 0x79, 0xa3, 0xaa, 0x7c, 0xd3, 0x9e, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0xb4,
-	0x5c, 0x72, 0x53, 0x6a, 0x05, 0x00, 0x00,
+	// 767 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0x4d, 0x6f, 0xf3, 0x44,
+	0x10, 0xc7, 0xe3, 0xbc, 0xb8, 0xc9, 0x3e, 0xe1, 0x49, 0xb5, 0x2d, 0xe0, 0xa6, 0x34, 0x09, 0x89,
+	0x40, 0xa9, 0xa0, 0x89, 0x5a, 0x8e, 0x5c, 0xc0, 0x0d, 0xa2, 0xa1, 0x2a, 0x42, 0xae, 0x04, 0x52,
+	0x2f, 0x96, 0x5f, 0xb6, 0xae, 0xd5, 0xd8, 0x1b, 0x32, 0x9b, 0xd2, 0xdc, 0xf8, 0x08, 0xc0, 0x87,
+	0xe0, 0xc0, 0x27, 0xa9, 0xc4, 0xa5, 0x47, 0x4e, 0x01, 0xa5, 0x9c, 0xf8, 0x14, 0x68, 0x5f, 0xec,
+	0x98, 0x12, 0xf7, 0xb9, 0xc4, 0xd9, 0xd9, 0xff, 0xfc, 0x66, 0x76, 0x76, 0x67, 0x50, 0xcf, 0xa3,
+	0x10, 0xfd, 0xe0, 0x40, 0x34, 0x14, 0x3f, 0x77, 0xc7, 0x2e, 0x61, 0xce, 0xf1, 0x30, 0x20, 0x31,
+	0x81, 0x10, 0x06, 0xd3, 0x19, 0x65, 0x14, 0xbf, 0x9d, 0x88, 0x06, 0xe2, 0x47, 0x89, 0x9a, 0xbb,
+	0x01, 0x0d, 0xa8, 0x50, 0x0c, 0xf9, 0x3f, 0x29, 0x6e, 0xb6, 0xb8, 0x98, 0xc2, 0xd0, 0x75, 0x80,
+	0xa4, 0x3c, 0x8f, 0x86, 0xb1, 0xda, 0x7f, 0x7f, 0x73, 0x44, 0xb6, 0x98, 0x12, 0xc8, 0x22, 0x36,
+	0x48, 0xee, 0xe5, 0x7e, 0xf7, 0x77, 0x1d, 0xd5, 0xbf, 0x94, 0x19, 0x5e, 0x32, 0x87, 0x11, 0xfc,
+	0x29, 0xd2, 0xa7, 0xce, 0xcc, 0x89, 0xc0, 0xd0, 0x3a, 0x5a, 0xff, 0xd5, 0xc9, 0xc1, 0x60, 0x63,
+	0xc6, 0x83, 0x6f, 0x84, 0xc8, 0x2c, 0x3f, 0x2c, 0xdb, 0x05, 0x4b, 0xb9, 0xe0, 0xaf, 0x50, 0xc5,
+	0xa3, 0x3e, 0x01, 0xa3, 0xd8, 0x29, 0xf5, 0x5f, 0x9d, 0xec, 0xe7, 0xf8, 0x9e, 0x52, 0x9f, 0x98,
+	0xef, 0x72, 0xcf, 0x7f, 0x96, 0xed, 0x86, 0xf0, 0xf8, 0x98, 0x46, 0x21, 0x23, 0xd1, 0x94, 0x2d,
+	0x2c, 0x89, 0xc0, 0x57, 0xa8, 0xe6, 0xd1, 0x98, 0xcd, 0x1c, 0x8f, 0x81, 0x51, 0x12, 0xbc, 0x76,
+	0x2e, 0x4f, 0xea, 0xcc, 0x7d, 0xc5, 0xdc, 0x49, 0x3d, 0x33, 0xdc, 0x35, 0x8e, 0xb3, 0x81, 0x7c,
+	0x3f, 0x27, 0xb1, 0x47, 0xc0, 0x28, 0xbf, 0xc8, 0xbe, 0x54, 0xba, 0x35, 0x3b, 0xf5, 0xcc, 0xb2,
+	0x53, 0x23, 0x76, 0x51, 0x35, 0x20, 0xb1, 0x1d, 0x41, 0x00, 0x46, 0x45, 0xa0, 0x3f, 0xca, 0x41,
+	0x67, 0xeb, 0xce, 0x17, 0x17, 0x10, 0x80, 0xd9, 0x54, 0x61, 0x70, 0x02, 0xc9, 0x44, 0xd9, 0x0a,
+	0xa4, 0x08, 0xff, 0xa8, 0xa1, 0x1d, 0xf0, 0x6e, 0x88, 0x3f, 0x9f, 0x10, 0xdf, 0xf6, 0x9c, 0xc9,
+	0xc4, 0x75, 0xbc, 0x5b, 0x30, 0x74, 0x11, 0xaf, 0x9f, 0x77, 0x94, 0xc4, 0xe3, 0x54, 0x39, 0x98,
+	0x1f, 0xa8, 0x60, 0x07, 0x1b, 0x60, 0x99, 0xb8, 0x18, 0x9e, 0x7b, 0x42, 0xf3, 0x97, 0x22, 0xda,
+	0x52, 0x39, 0xe3, 0x11, 0x42, 0xc0, 0xe8, 0x8c, 0xd8, 0xfc, 0xe6, 0xd4, 0xbb, 0xe9, 0xe5, 0x24,
+	0x71, 0x01, 0xc1, 0x25, 0xd7, 0xf2, 0x37, 0x70, 0x56, 0xb0, 0x6a, 0x90, 0x2c, 0xb0, 0x8b, 0x76,
+	0xc3, 0x18, 0x98, 0x13, 0xb3, 0xd0, 0x61, 0xc4, 0x4e, 0x6e, 0xcb, 0x28, 0x0a, 0xde, 0x51, 0x3e,
+	0x6f, 0xbc, 0xf6, 0x4a, 0x5e, 0xc2, 0x59, 0xc1, 0xda, 0x09, 0xff, 0x6f, 0xc6, 0xdf, 0xa2, 0x6d,
+	0x72, 0x4f, 0xbc, 0x79, 0x96, 0x5f, 0x12, 0xfc, 0xc3, 0x7c, 0xfe, 0x17, 0xd2, 0x23, 0xc3, 0x6e,
+	0x90, 0xff, 0x9a, 0xcc, 0x0a, 0x2a, 0xc1, 0x3c, 0xea, 0xfe, 0xaa, 0xa1, 0xb2, 0x38, 0x4b, 0x0f,
+	0x6d, 0xf1, 0x5a, 0xd8, 0xa1, 0x2f, 0xca, 0x51, 0x36, 0xd1, 0x6a, 0xd9, 0xd6, 0xf9, 0xd6, 0x78,
+	0x64, 0xe9, 0x7c, 0x6b, 0xec, 0x63, 0x13, 0xd5, 0xa4, 0x28, 0xbe, 0xa6, 0xea, 0x94, 0xed, 0x17,
+	0x3a, 0x66, 0x1c, 0x5f, 0x53, 0xd5, 0x6f, 0x55, 0x4f, 0xad, 0xf1, 0x01, 0x42, 0x82, 0xe1, 0x2e,
+	0x18, 0x01, 0x71, 0x94, 0xba, 0x25, 0xa8, 0x26, 0x37, 0xe0, 0x77, 0x90, 0x3e, 0x0d, 0xe3, 0x98,
+	0xf8, 0x46, 0xb9, 0xa3, 0xf5, 0xab, 0x96, 0x5a, 0x75, 0xff, 0x2e, 0xa2, 0x6a, 0x5a, 0x94, 0x43,
+	0xb4, 0x9d, 0x14, 0xc3, 0x76, 0x7c, 0x7f, 0x46, 0x40, 0x36, 0x7f, 0xcd, 0x6a, 0x24, 0xf6, 0xcf,
+	0xa5, 0x19, 0x7f, 0x8d, 0xde, 0x4a, 0xa5, 0x99, 0xb4, 0x7b, 0x6f, 0x68, 0xcc, 0x4c, 0xea, 0x75,
+	0x2f, 0x63, 0xc3, 0x63, 0xf4, 0x3a, 0xe5, 0x01, 0xef, 0x03, 0xd5, 0xe9, 0xef, 0xe5, 0xdd, 0x06,
+	0xf5, 0xc9, 0x44, 0x91, 0xd2, 0x4c, 0xe4, 0xe0, 0xfa, 0x59, 0x43, 0x0d, 0xfe, 0x98, 0x9c, 0x80,
+	0xd8, 0x3e, 0x99, 0x52, 0x08, 0x99, 0x6a, 0xed, 0xbd, 0x81, 0x9c, 0xa3, 0x03, 0x3e, 0x47, 0x33,
+	0xb9, 0x85, 0xb1, 0x79, 0xa1, 0x1a, 0x60, 0xef, 0x99, 0xe7, 0xfa, 0xf1, 0xff, 0xf6, 0x67, 0xbb,
+	0x1f, 0x84, 0xec, 0x66, 0xee, 0x0e, 0x3c, 0x1a, 0x0d, 0xd5, 0x44, 0x96, 0x9f, 0x23, 0xf0, 0x6f,
+	0xd5, 0xb4, 0xe5, 0x34, 0xb0, 0x5e, 0x2b, 0xcc, 0x48, 0x52, 0xba, 0x26, 0xaa, 0x26, 0xf3, 0x03,
+	0x77, 0x90, 0x1e, 0xfa, 0xf6, 0x2d, 0x59, 0x88, 0xda, 0xd6, 0xcd, 0xda, 0x6a, 0xd9, 0xae, 0x8c,
+	0x47, 0xe7, 0x64, 0x61, 0x55, 0x42, 0xff, 0x9c, 0x2c, 0xf0, 0x2e, 0xaa, 0xdc, 0x39, 0x93, 0x39,
+	0x11, 0x45, 0x2d, 0x5b, 0x72, 0x61, 0x7e, 0xf6, 0xb0, 0x6a, 0x69, 0x8f, 0xab, 0x96, 0xf6, 0xd7,
+	0xaa, 0xa5, 0xfd, 0xf4, 0xd4, 0x2a, 0x3c, 0x3e, 0xb5, 0x0a, 0x7f, 0x3c, 0xb5, 0x0a, 0x57, 0x1f,
+	0x66, 0xf2, 0x3a, 0xa5, 0x10, 0x7d, 0x97, 0x8c, 0x79, 0x7f, 0x78, 0x2f, 0xbe, 0x32, 0x37, 0x57,
+	0x17, 0xa3, 0xfe, 0x93, 0x7f, 0x07, 0x00, 0xcf, 0x65, 0x8d, 0x13, 0xa1, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -463,6 +489,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ScheduledCallbacks) > 0 {
+		for iNdEx := len(m.ScheduledCallbacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledCallbacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.GenMsgs) > 0 {
 		for iNdEx := len(m.GenMsgs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -702,6 +742,20 @@ func (m *Contract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.StorageDeposit) > 0 {
+		for iNdEx := len(m.StorageDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StorageDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.ContractState) > 0 {
 		for iNdEx := len(m.ContractState) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -814,6 +868,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ScheduledCallbacks) > 0 {
+		for _, e := range m.ScheduledCallbacks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -904,6 +964,12 @@ func (m *Contract) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.StorageDeposit) > 0 {
+		for _, e := range m.StorageDeposit {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1127,6 +1193,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledCallbacks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledCallbacks = append(m.ScheduledCallbacks, ScheduledCallback{})
+			if err := m.ScheduledCallbacks[len(m.ScheduledCallbacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1587,6 +1687,40 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageDeposit = append(m.StorageDeposit, types.Coin{})
+			if err := m.StorageDeposit[len(m.StorageDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
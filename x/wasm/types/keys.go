@@ -30,9 +30,18 @@ var (
 	ContractCodeHistoryElementPrefix               = []byte{0x05}
 	ContractByCodeIDAndCreatedSecondaryIndexPrefix = []byte{0x06}
 	PinnedCodeIndexPrefix                          = []byte{0x07}
+	PendingCodeRemovalPrefix                       = []byte{0x08}
+	ScheduledCallbackPrefix                        = []byte{0x09}
+	ScheduledCallbackQueuePrefix                   = []byte{0x0a}
+	ContractStorageStatsPrefix                     = []byte{0x0b}
+	StorageDepositPrefix                           = []byte{0x0c}
+	ContractByCreatorSecondaryIndexPrefix          = []byte{0x0d}
+	ContractByAdminSecondaryIndexPrefix            = []byte{0x0e}
+	CodeByChecksumIndexPrefix                      = []byte{0x0f}
 
 	KeyLastCodeID     = append(SequenceKeyPrefix, []byte("lastCodeId")...)
 	KeyLastInstanceID = append(SequenceKeyPrefix, []byte("lastContractId")...)
+	KeyLastCallbackID = append(SequenceKeyPrefix, []byte("lastCallbackId")...)
 )
 
 // GetCodeKey constructs the key for retreiving the ID for the WASM code
@@ -51,6 +60,31 @@ func GetContractStorePrefix(addr sdk.AccAddress) []byte {
 	return append(ContractStorePrefix, addr...)
 }
 
+// GetContractStorageStatsKey returns the key for the storage usage of the WASM contract instance
+func GetContractStorageStatsKey(addr sdk.AccAddress) []byte {
+	return append(ContractStorageStatsPrefix, addr...)
+}
+
+// GetStorageDepositKey returns the key for the storage deposit of the WASM contract instance
+func GetStorageDepositKey(addr sdk.AccAddress) []byte {
+	return append(StorageDepositPrefix, addr...)
+}
+
+// GetCodeByChecksumIndexKey returns the key for the checksum index: `<prefix><checksum><codeID>`
+func GetCodeByChecksumIndexKey(checksum []byte, codeID uint64) []byte {
+	prefix := GetCodeByChecksumIndexPrefix(checksum)
+	prefixLen := len(prefix)
+	r := make([]byte, prefixLen+8)
+	copy(r[0:], prefix)
+	copy(r[prefixLen:], sdk.Uint64ToBigEndian(codeID))
+	return r
+}
+
+// GetCodeByChecksumIndexPrefix returns the prefix for the checksum index: `<prefix><checksum>`
+func GetCodeByChecksumIndexPrefix(checksum []byte) []byte {
+	return append(append([]byte{}, CodeByChecksumIndexPrefix...), checksum...)
+}
+
 // GetContractByCreatedSecondaryIndexKey returns the key for the secondary index:
 // `<prefix><codeID><created><contractAddr>`
 func GetContractByCreatedSecondaryIndexKey(contractAddr sdk.AccAddress, c *ContractInfo) []byte {
@@ -73,6 +107,37 @@ func GetContractByCodeIDSecondaryIndexPrefix(codeID uint64) []byte {
 	return r
 }
 
+// GetContractByCreatorSecondaryIndexKey returns the key for the secondary index:
+// `<prefix><creator><created><contractAddr>`
+func GetContractByCreatorSecondaryIndexKey(creator, contractAddr sdk.AccAddress, c *ContractInfo) []byte {
+	return contractByAddressSecondaryIndexKey(GetContractByCreatorSecondaryIndexPrefix(creator), contractAddr, c)
+}
+
+// GetContractByCreatorSecondaryIndexPrefix returns the prefix for the creator index: `<prefix><creator>`
+func GetContractByCreatorSecondaryIndexPrefix(creator sdk.AccAddress) []byte {
+	return append(append([]byte{}, ContractByCreatorSecondaryIndexPrefix...), creator...)
+}
+
+// GetContractByAdminSecondaryIndexKey returns the key for the secondary index:
+// `<prefix><admin><created><contractAddr>`
+func GetContractByAdminSecondaryIndexKey(admin, contractAddr sdk.AccAddress, c *ContractInfo) []byte {
+	return contractByAddressSecondaryIndexKey(GetContractByAdminSecondaryIndexPrefix(admin), contractAddr, c)
+}
+
+// GetContractByAdminSecondaryIndexPrefix returns the prefix for the admin index: `<prefix><admin>`
+func GetContractByAdminSecondaryIndexPrefix(admin sdk.AccAddress) []byte {
+	return append(append([]byte{}, ContractByAdminSecondaryIndexPrefix...), admin...)
+}
+
+func contractByAddressSecondaryIndexKey(prefix []byte, contractAddr sdk.AccAddress, c *ContractInfo) []byte {
+	prefixLen := len(prefix)
+	r := make([]byte, prefixLen+AbsoluteTxPositionLen+sdk.AddrLen)
+	copy(r[0:], prefix)
+	copy(r[prefixLen:], c.Created.Bytes())
+	copy(r[prefixLen+AbsoluteTxPositionLen:], contractAddr)
+	return r
+}
+
 // GetContractCodeHistoryElementKey returns the key a contract code history entry: `<prefix><contractAddr><position>`
 func GetContractCodeHistoryElementKey(contractAddr sdk.AccAddress, pos uint64) []byte {
 	prefix := GetContractCodeHistoryElementPrefix(contractAddr)
@@ -105,3 +170,28 @@ func GetPinnedCodeIndexPrefix(codeID uint64) []byte {
 func ParsePinnedCodeIndex(s []byte) uint64 {
 	return sdk.BigEndianToUint64(s)
 }
+
+// GetPendingCodeRemovalKey returns the key for a code checksum that is scheduled to be removed from the wasmvm
+func GetPendingCodeRemovalKey(checksum []byte) []byte {
+	return append(PendingCodeRemovalPrefix, checksum...)
+}
+
+// GetScheduledCallbackKey returns the key for a scheduled callback: `<prefix><id>`
+func GetScheduledCallbackKey(id uint64) []byte {
+	return append(ScheduledCallbackPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetScheduledCallbackQueueKey returns the key of the execution queue: `<prefix><height><id>`
+func GetScheduledCallbackQueueKey(height, id uint64) []byte {
+	prefixLen := len(ScheduledCallbackQueuePrefix)
+	r := make([]byte, prefixLen+8+8)
+	copy(r[0:], ScheduledCallbackQueuePrefix)
+	copy(r[prefixLen:], sdk.Uint64ToBigEndian(height))
+	copy(r[prefixLen+8:], sdk.Uint64ToBigEndian(id))
+	return r
+}
+
+// ParseScheduledCallbackQueueKey converts the execution queue key without the prefix back into height and id.
+func ParseScheduledCallbackQueueKey(s []byte) (height, id uint64) {
+	return sdk.BigEndianToUint64(s[0:8]), sdk.BigEndianToUint64(s[8:16])
+}
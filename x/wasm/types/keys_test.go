@@ -43,3 +43,29 @@ func TestGetContractByCreatedSecondaryIndexKey(t *testing.T) {
 	}
 	assert.Equal(t, exp, got)
 }
+
+func TestGetContractByCreatorAndAdminSecondaryIndexKey(t *testing.T) {
+	c := &ContractInfo{
+		CodeID:  1,
+		Created: &AbsoluteTxPosition{2 + 1<<(8*7), 3 + 1<<(8*7)},
+	}
+	owner := bytes.Repeat([]byte{5}, sdk.AddrLen)
+	addr := bytes.Repeat([]byte{4}, sdk.AddrLen)
+	exp := []byte{
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, // owner address
+		1, 0, 0, 0, 0, 0, 0, 2, // height
+		1, 0, 0, 0, 0, 0, 0, 3, // index
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, // address
+	}
+	assert.Equal(t, append([]byte{0x0d}, exp...), GetContractByCreatorSecondaryIndexKey(owner, addr, c))
+	assert.Equal(t, append([]byte{0x0e}, exp...), GetContractByAdminSecondaryIndexKey(owner, addr, c))
+}
+
+func TestGetCodeByChecksumIndexKey(t *testing.T) {
+	checksum := bytes.Repeat([]byte{4}, 32)
+	got := GetCodeByChecksumIndexKey(checksum, 1+1<<(8*7))
+	exp := append(append([]byte{0x0f}, checksum...), // prefix, checksum
+		1, 0, 0, 0, 0, 0, 0, 1, // codeID
+	)
+	assert.Equal(t, exp, got)
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -17,29 +18,101 @@ const (
 	DefaultParamspace = ModuleName
 	// DefaultMaxWasmCodeSize limit max bytes read to prevent gzip bombs
 	DefaultMaxWasmCodeSize = 600 * 1024
+
+	// DefaultGasMultiplier is how many cosmwasm gas points = 1 sdk gas point
+	// SDK reference costs can be found here: https://github.com/cosmos/cosmos-sdk/blob/02c6c9fafd58da88550ab4d7d494724a477c8a68/store/types/gas.go#L153-L164
+	// A write at ~3000 gas and ~200us = 10 gas per us (microsecond) cpu/io
+	// Rough timing have 88k gas at 90us, which is equal to 1k sdk gas... (one read)
+	//
+	// Please note that all gas prices returned to the wasmer engine should have this multiplied
+	DefaultGasMultiplier uint64 = 100
+	// DefaultInstanceCost is how much SDK gas we charge each time we load a WASM instance.
+	// Creating a new instance is costly, and this helps put a recursion limit to contracts calling contracts.
+	DefaultInstanceCost uint64 = 40_000
+	// DefaultCompileCost is how much SDK gas we charge *per byte* for compiling WASM code.
+	DefaultCompileCost uint64 = 2
+	// DefaultHumanizeCost is how much SDK gas we charge to convert a canonical address to the bech32 format
+	DefaultHumanizeCost uint64 = 5
+	// DefaultCanonicalizeCost is how much SDK gas we charge to convert a bech32 address to the canonical format
+	DefaultCanonicalizeCost uint64 = 4
+	// DefaultMaxScheduledCallbackGas is the default sum of callback gas limits that is executed within a single block.
+	// Callbacks that do not fit are deferred to the next block.
+	DefaultMaxScheduledCallbackGas uint64 = 10_000_000
+	// DefaultContractMemoryLimit is the memory limit of each contract execution (in MiB)
+	DefaultContractMemoryLimit uint32 = 32
+	// MaxContractMemoryLimit is the upper bound for the contract memory limit param (in MiB)
+	MaxContractMemoryLimit uint32 = 4096
+	// DefaultStorageReadCostPerByte is how much SDK gas we charge for every byte a contract reads from its storage.
+	// The per byte costs match the sdk KVGasConfig so that the contract storage pays twice for the size of the data.
+	// See `BenchmarkContractStorage` for the calibration.
+	DefaultStorageReadCostPerByte uint64 = 3
+	// DefaultStorageWriteCostPerByte is how much SDK gas we charge for every byte a contract writes to its storage
+	DefaultStorageWriteCostPerByte uint64 = 30
+	// DefaultStorageIterNextCost is how much SDK gas we charge for every step of a contract storage iterator
+	DefaultStorageIterNextCost uint64 = 30
+	// DefaultStorageDeleteCost is how much SDK gas we charge for every delete in the contract storage
+	DefaultStorageDeleteCost uint64 = 1000
 )
 
 var ParamStoreKeyUploadAccess = []byte("uploadAccess")
 var ParamStoreKeyInstantiateAccess = []byte("instantiateAccess")
 var ParamStoreKeyMaxWasmCodeSize = []byte("maxWasmCodeSize")
+var ParamStoreKeyAcceptedStargateQueries = []byte("acceptedStargateQueries")
+var ParamStoreKeyAcceptedStargateMsgs = []byte("acceptedStargateMsgs")
+var ParamStoreKeyDeniedStargateMsgs = []byte("deniedStargateMsgs")
+var ParamStoreKeyGasMultiplier = []byte("gasMultiplier")
+var ParamStoreKeyInstanceCost = []byte("instanceCost")
+var ParamStoreKeyCompileCost = []byte("compileCost")
+var ParamStoreKeyHumanizeCost = []byte("humanizeCost")
+var ParamStoreKeyCanonicalizeCost = []byte("canonicalizeCost")
+var ParamStoreKeyContractMemoryLimit = []byte("contractMemoryLimit")
+var ParamStoreKeyMaxContractStorageBytes = []byte("maxContractStorageBytes")
+var ParamStoreKeyCodeStorageLimits = []byte("codeStorageLimits")
+var ParamStoreKeyStorageDepositPerByte = []byte("storageDepositPerByte")
+var ParamStoreKeyStorageDepositFromContract = []byte("storageDepositFromContract")
+var ParamStoreKeyDeduplicateCodeUploads = []byte("deduplicateCodeUploads")
+var ParamStoreKeyMinCallbackFee = []byte("minCallbackFee")
+var ParamStoreKeyMaxScheduledCallbackGas = []byte("maxScheduledCallbackGas")
+var ParamStoreKeyStorageReadCostPerByte = []byte("storageReadCostPerByte")
+var ParamStoreKeyStorageWriteCostPerByte = []byte("storageWriteCostPerByte")
+var ParamStoreKeyStorageIterNextCost = []byte("storageIterNextCost")
+var ParamStoreKeyStorageDeleteCost = []byte("storageDeleteCost")
 
 var AllAccessTypes = []AccessType{
 	AccessTypeNobody,
 	AccessTypeOnlyAddress,
 	AccessTypeEverybody,
+	AccessTypeAnyOfAddresses,
+	AccessTypeOnlyContract,
 }
 
-func (a AccessType) With(addr sdk.AccAddress) AccessConfig {
+// With returns the access config of the type for the given addresses. OnlyAddress and OnlyContract
+// require exactly one address, AnyOfAddresses at least one.
+func (a AccessType) With(addrs ...sdk.AccAddress) AccessConfig {
+	for _, addr := range addrs {
+		if err := sdk.VerifyAddressFormat(addr); err != nil {
+			panic(err)
+		}
+	}
 	switch a {
 	case AccessTypeNobody:
 		return AllowNobody
-	case AccessTypeOnlyAddress:
-		if err := sdk.VerifyAddressFormat(addr); err != nil {
-			panic(err)
+	case AccessTypeOnlyAddress, AccessTypeOnlyContract:
+		if len(addrs) != 1 {
+			panic("exactly one address required")
 		}
-		return AccessConfig{Permission: AccessTypeOnlyAddress, Address: addr.String()}
+		return AccessConfig{Permission: a, Address: addrs[0].String()}
 	case AccessTypeEverybody:
 		return AllowEverybody
+	case AccessTypeAnyOfAddresses:
+		if len(addrs) == 0 {
+			panic("addresses required")
+		}
+		bech32Addrs := make([]string, len(addrs))
+		for i, addr := range addrs {
+			bech32Addrs[i] = addr.String()
+		}
+		return AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: bech32Addrs}
 	}
 	panic("unsupported access type")
 }
@@ -52,6 +125,10 @@ func (a AccessType) String() string {
 		return "OnlyAddress"
 	case AccessTypeEverybody:
 		return "Everybody"
+	case AccessTypeAnyOfAddresses:
+		return "AnyOfAddresses"
+	case AccessTypeOnlyContract:
+		return "OnlyContract"
 	}
 	return "Unspecified"
 }
@@ -79,7 +156,42 @@ func (a *AccessType) UnmarshalJSONPB(_ *jsonpb.Unmarshaler, data []byte) error {
 }
 
 func (a AccessConfig) Equals(o AccessConfig) bool {
-	return a.Permission == o.Permission && a.Address == o.Address
+	if a.Permission != o.Permission || a.Address != o.Address || len(a.Addresses) != len(o.Addresses) {
+		return false
+	}
+	for i := range a.Addresses {
+		if a.Addresses[i] != o.Addresses[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// DefaultAcceptedStargateQueries are the gRPC query paths that contracts can call by default.
+// They must match the response types that are registered in the keeper.
+var DefaultAcceptedStargateQueries = []string{
+	"/cosmos.bank.v1beta1.Query/AllBalances",
+	"/cosmos.bank.v1beta1.Query/Balance",
+	"/cosmos.bank.v1beta1.Query/SupplyOf",
+	"/cosmos.bank.v1beta1.Query/TotalSupply",
+}
+
+// DefaultAcceptedStargateMsgs are the message type URLs that contracts can dispatch via stargate by default
+var DefaultAcceptedStargateMsgs = []string{
+	"/cosmos.bank.v1beta1.MsgSend",
+	"/cosmos.distribution.v1beta1.MsgSetWithdrawAddress",
+	"/cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward",
+	"/cosmos.gov.v1beta1.MsgVote",
+	"/cosmos.staking.v1beta1.MsgBeginRedelegate",
+	"/cosmos.staking.v1beta1.MsgDelegate",
+	"/cosmos.staking.v1beta1.MsgUndelegate",
+	"/cosmwasm.wasm.v1beta1.MsgClearAdmin",
+	"/cosmwasm.wasm.v1beta1.MsgExecuteContract",
+	"/cosmwasm.wasm.v1beta1.MsgInstantiateContract",
+	"/cosmwasm.wasm.v1beta1.MsgInstantiateContract2",
+	"/cosmwasm.wasm.v1beta1.MsgMigrateContract",
+	"/cosmwasm.wasm.v1beta1.MsgUpdateAdmin",
+	"/ibc.applications.transfer.v1.MsgTransfer",
 }
 
 var (
@@ -99,6 +211,19 @@ func DefaultParams() Params {
 		CodeUploadAccess:             AllowEverybody,
 		InstantiateDefaultPermission: AccessTypeEverybody,
 		MaxWasmCodeSize:              DefaultMaxWasmCodeSize,
+		AcceptedStargateQueries:      DefaultAcceptedStargateQueries,
+		AcceptedStargateMsgs:         DefaultAcceptedStargateMsgs,
+		GasMultiplier:                DefaultGasMultiplier,
+		InstanceCost:                 DefaultInstanceCost,
+		CompileCost:                  DefaultCompileCost,
+		HumanizeCost:                 DefaultHumanizeCost,
+		CanonicalizeCost:             DefaultCanonicalizeCost,
+		ContractMemoryLimit:          DefaultContractMemoryLimit,
+		MaxScheduledCallbackGas:      DefaultMaxScheduledCallbackGas,
+		StorageReadCostPerByte:       DefaultStorageReadCostPerByte,
+		StorageWriteCostPerByte:      DefaultStorageWriteCostPerByte,
+		StorageIterNextCost:          DefaultStorageIterNextCost,
+		StorageDeleteCost:            DefaultStorageDeleteCost,
 	}
 }
 
@@ -113,6 +238,26 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyUploadAccess, &p.CodeUploadAccess, validateAccessConfig),
 		paramtypes.NewParamSetPair(ParamStoreKeyInstantiateAccess, &p.InstantiateDefaultPermission, validateAccessType),
 		paramtypes.NewParamSetPair(ParamStoreKeyMaxWasmCodeSize, &p.MaxWasmCodeSize, validateMaxWasmCodeSize),
+		paramtypes.NewParamSetPair(ParamStoreKeyAcceptedStargateQueries, &p.AcceptedStargateQueries, validatePaths),
+		paramtypes.NewParamSetPair(ParamStoreKeyAcceptedStargateMsgs, &p.AcceptedStargateMsgs, validatePaths),
+		paramtypes.NewParamSetPair(ParamStoreKeyDeniedStargateMsgs, &p.DeniedStargateMsgs, validatePaths),
+		paramtypes.NewParamSetPair(ParamStoreKeyGasMultiplier, &p.GasMultiplier, validateGasMultiplier),
+		paramtypes.NewParamSetPair(ParamStoreKeyInstanceCost, &p.InstanceCost, validateUint64),
+		paramtypes.NewParamSetPair(ParamStoreKeyCompileCost, &p.CompileCost, validateUint64),
+		paramtypes.NewParamSetPair(ParamStoreKeyHumanizeCost, &p.HumanizeCost, validateUint64),
+		paramtypes.NewParamSetPair(ParamStoreKeyCanonicalizeCost, &p.CanonicalizeCost, validateUint64),
+		paramtypes.NewParamSetPair(ParamStoreKeyContractMemoryLimit, &p.ContractMemoryLimit, validateContractMemoryLimit),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxContractStorageBytes, &p.MaxContractStorageBytes, validateUint64),
+		paramtypes.NewParamSetPair(ParamStoreKeyCodeStorageLimits, &p.CodeStorageLimits, validateCodeStorageLimits),
+		paramtypes.NewParamSetPair(ParamStoreKeyStorageDepositPerByte, &p.StorageDepositPerByte, validateStorageDepositPerByte),
+		paramtypes.NewParamSetPair(ParamStoreKeyStorageDepositFromContract, &p.StorageDepositFromContract, validateBool),
+		paramtypes.NewParamSetPair(ParamStoreKeyDeduplicateCodeUploads, &p.DeduplicateCodeUploads, validateBool),
+		paramtypes.NewParamSetPair(ParamStoreKeyMinCallbackFee, &p.MinCallbackFee, validateCoins),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxScheduledCallbackGas, &p.MaxScheduledCallbackGas, validateMaxScheduledCallbackGas),
+		paramtypes.NewParamSetPair(ParamStoreKeyStorageReadCostPerByte, &p.StorageReadCostPerByte, validateUint64),
+		paramtypes.NewParamSetPair(ParamStoreKeyStorageWriteCostPerByte, &p.StorageWriteCostPerByte, validateUint64),
+		paramtypes.NewParamSetPair(ParamStoreKeyStorageIterNextCost, &p.StorageIterNextCost, validateUint64),
+		paramtypes.NewParamSetPair(ParamStoreKeyStorageDeleteCost, &p.StorageDeleteCost, validateUint64),
 	}
 }
 
@@ -127,6 +272,57 @@ func (p Params) ValidateBasic() error {
 	if err := validateMaxWasmCodeSize(p.MaxWasmCodeSize); err != nil {
 		return errors.Wrap(err, "max wasm code size")
 	}
+	if err := validatePaths(p.AcceptedStargateQueries); err != nil {
+		return errors.Wrap(err, "accepted stargate queries")
+	}
+	if err := validatePaths(p.AcceptedStargateMsgs); err != nil {
+		return errors.Wrap(err, "accepted stargate msgs")
+	}
+	if err := validatePaths(p.DeniedStargateMsgs); err != nil {
+		return errors.Wrap(err, "denied stargate msgs")
+	}
+	if err := validateGasMultiplier(p.GasMultiplier); err != nil {
+		return errors.Wrap(err, "gas multiplier")
+	}
+	if err := validateUint64(p.InstanceCost); err != nil {
+		return errors.Wrap(err, "instance cost")
+	}
+	if err := validateUint64(p.CompileCost); err != nil {
+		return errors.Wrap(err, "compile cost")
+	}
+	if err := validateUint64(p.HumanizeCost); err != nil {
+		return errors.Wrap(err, "humanize cost")
+	}
+	if err := validateUint64(p.CanonicalizeCost); err != nil {
+		return errors.Wrap(err, "canonicalize cost")
+	}
+	if err := validateContractMemoryLimit(p.ContractMemoryLimit); err != nil {
+		return errors.Wrap(err, "contract memory limit")
+	}
+	if err := validateCodeStorageLimits(p.CodeStorageLimits); err != nil {
+		return errors.Wrap(err, "code storage limits")
+	}
+	if err := validateStorageDepositPerByte(p.StorageDepositPerByte); err != nil {
+		return errors.Wrap(err, "storage deposit per byte")
+	}
+	if err := validateCoins(p.MinCallbackFee); err != nil {
+		return errors.Wrap(err, "min callback fee")
+	}
+	if err := validateMaxScheduledCallbackGas(p.MaxScheduledCallbackGas); err != nil {
+		return errors.Wrap(err, "max scheduled callback gas")
+	}
+	if err := validateUint64(p.StorageReadCostPerByte); err != nil {
+		return errors.Wrap(err, "storage read cost per byte")
+	}
+	if err := validateUint64(p.StorageWriteCostPerByte); err != nil {
+		return errors.Wrap(err, "storage write cost per byte")
+	}
+	if err := validateUint64(p.StorageIterNextCost); err != nil {
+		return errors.Wrap(err, "storage iter next cost")
+	}
+	if err := validateUint64(p.StorageDeleteCost); err != nil {
+		return errors.Wrap(err, "storage delete cost")
+	}
 	return nil
 }
 
@@ -146,6 +342,10 @@ func validateAccessType(i interface{}) error {
 	if a == AccessTypeUnspecified {
 		return sdkerrors.Wrap(ErrEmpty, "type")
 	}
+	// the default permission is bound to the creator of a code which is not a contract
+	if a == AccessTypeOnlyContract {
+		return sdkerrors.Wrapf(ErrInvalid, "not supported as default: %q", a)
+	}
 	for _, v := range AllAccessTypes {
 		if v == a {
 			return nil
@@ -165,30 +365,168 @@ func validateMaxWasmCodeSize(i interface{}) error {
 	return nil
 }
 
+func validateGasMultiplier(i interface{}) error {
+	a, ok := i.(uint64)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalid, "type: %T", i)
+	}
+	if a == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "must be greater 0")
+	}
+	return nil
+}
+
+func validateContractMemoryLimit(i interface{}) error {
+	a, ok := i.(uint32)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalid, "type: %T", i)
+	}
+	if a == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "must be greater 0")
+	}
+	if a > MaxContractMemoryLimit {
+		return sdkerrors.Wrapf(ErrLimit, "must not be greater %d", MaxContractMemoryLimit)
+	}
+	return nil
+}
+
+func validateMaxScheduledCallbackGas(i interface{}) error {
+	a, ok := i.(uint64)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalid, "type: %T", i)
+	}
+	if a == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "must be greater 0")
+	}
+	return nil
+}
+
+func validateUint64(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return sdkerrors.Wrapf(ErrInvalid, "type: %T", i)
+	}
+	return nil
+}
+
+func validateCodeStorageLimits(i interface{}) error {
+	limits, ok := i.([]CodeStorageLimit)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalid, "type: %T", i)
+	}
+	unique := make(map[uint64]struct{}, len(limits))
+	for _, l := range limits {
+		if l.CodeID == 0 {
+			return sdkerrors.Wrap(ErrEmpty, "code id")
+		}
+		if _, exists := unique[l.CodeID]; exists {
+			return sdkerrors.Wrapf(ErrDuplicate, "code id: %d", l.CodeID)
+		}
+		unique[l.CodeID] = struct{}{}
+	}
+	return nil
+}
+
+func validateStorageDepositPerByte(i interface{}) error {
+	a, ok := i.(sdk.DecCoins)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalid, "type: %T", i)
+	}
+	if err := a.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalid, err.Error())
+	}
+	return nil
+}
+
+func validateCoins(i interface{}) error {
+	a, ok := i.(sdk.Coins)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalid, "type: %T", i)
+	}
+	if err := a.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalid, err.Error())
+	}
+	return nil
+}
+
+func validateBool(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return sdkerrors.Wrapf(ErrInvalid, "type: %T", i)
+	}
+	return nil
+}
+
+// validatePaths ensures a list of unique gRPC query paths or message type URLs
+func validatePaths(i interface{}) error {
+	paths, ok := i.([]string)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalid, "type: %T", i)
+	}
+	unique := make(map[string]struct{}, len(paths))
+	for _, p := range paths {
+		if !strings.HasPrefix(p, "/") || strings.TrimSpace(p) != p || len(p) == 1 {
+			return sdkerrors.Wrapf(ErrInvalid, "path: %q", p)
+		}
+		if _, exists := unique[p]; exists {
+			return sdkerrors.Wrapf(ErrDuplicate, "path: %q", p)
+		}
+		unique[p] = struct{}{}
+	}
+	return nil
+}
+
 func (v AccessConfig) ValidateBasic() error {
 	switch v.Permission {
 	case AccessTypeUnspecified:
 		return sdkerrors.Wrap(ErrEmpty, "type")
 	case AccessTypeNobody, AccessTypeEverybody:
-		if len(v.Address) != 0 {
+		if len(v.Address) != 0 || len(v.Addresses) != 0 {
 			return sdkerrors.Wrap(ErrInvalid, "address not allowed for this type")
 		}
 		return nil
-	case AccessTypeOnlyAddress:
+	case AccessTypeOnlyAddress, AccessTypeOnlyContract:
+		if len(v.Addresses) != 0 {
+			return sdkerrors.Wrap(ErrInvalid, "addresses not allowed for this type")
+		}
 		_, err := sdk.AccAddressFromBech32(v.Address)
 		return err
+	case AccessTypeAnyOfAddresses:
+		if len(v.Address) != 0 {
+			return sdkerrors.Wrap(ErrInvalid, "address not allowed for this type")
+		}
+		if len(v.Addresses) == 0 {
+			return sdkerrors.Wrap(ErrEmpty, "addresses")
+		}
+		unique := make(map[string]struct{}, len(v.Addresses))
+		for _, a := range v.Addresses {
+			if _, err := sdk.AccAddressFromBech32(a); err != nil {
+				return sdkerrors.Wrapf(err, "address %q", a)
+			}
+			if _, exists := unique[a]; exists {
+				return sdkerrors.Wrapf(ErrDuplicate, "address %q", a)
+			}
+			unique[a] = struct{}{}
+		}
+		return nil
 	}
 	return sdkerrors.Wrapf(ErrInvalid, "unknown type: %q", v.Permission)
 }
 
+// Allowed returns if the actor has access
 func (v AccessConfig) Allowed(actor sdk.AccAddress) bool {
 	switch v.Permission {
 	case AccessTypeNobody:
 		return false
 	case AccessTypeEverybody:
 		return true
-	case AccessTypeOnlyAddress:
+	case AccessTypeOnlyAddress, AccessTypeOnlyContract:
 		return v.Address == actor.String()
+	case AccessTypeAnyOfAddresses:
+		for _, a := range v.Addresses {
+			if a == actor.String() {
+				return true
+			}
+		}
+		return false
 	default:
 		panic("unknown type")
 	}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"encoding/json"
 	"testing"
 
@@ -9,11 +10,13 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
 )
 
 func TestValidateParams(t *testing.T) {
 	var (
 		anyAddress     sdk.AccAddress = make([]byte, sdk.AddrLen)
+		otherAddress   sdk.AccAddress = bytes.Repeat([]byte{1}, sdk.AddrLen)
 		invalidAddress                = "invalid address"
 	)
 
@@ -24,11 +27,42 @@ func TestValidateParams(t *testing.T) {
 		"all good with defaults": {
 			src: DefaultParams(),
 		},
+		"all good with storage limits": {
+			src: Params{
+				CodeUploadAccess:             AllowNobody,
+				InstantiateDefaultPermission: AccessTypeNobody,
+				MaxWasmCodeSize:              DefaultMaxWasmCodeSize,
+				GasMultiplier:                DefaultGasMultiplier,
+				MaxScheduledCallbackGas:      DefaultMaxScheduledCallbackGas,
+				ContractMemoryLimit:          DefaultContractMemoryLimit,
+				MaxContractStorageBytes:      1024,
+				CodeStorageLimits:            []CodeStorageLimit{{CodeID: 1, MaxBytes: 0}, {CodeID: 2, MaxBytes: 2048}},
+			},
+		},
+		"all good with storage deposit": {
+			src: Params{
+				CodeUploadAccess:             AllowNobody,
+				InstantiateDefaultPermission: AccessTypeNobody,
+				MaxWasmCodeSize:              DefaultMaxWasmCodeSize,
+				GasMultiplier:                DefaultGasMultiplier,
+				MaxScheduledCallbackGas:      DefaultMaxScheduledCallbackGas,
+				ContractMemoryLimit:          DefaultContractMemoryLimit,
+				StorageDepositPerByte:        sdk.NewDecCoins(sdk.NewDecCoinFromDec("denom", sdk.NewDecWithPrec(5, 1))),
+				StorageDepositFromContract:   true,
+			},
+		},
 		"all good with nobody": {
 			src: Params{
 				CodeUploadAccess:             AllowNobody,
 				InstantiateDefaultPermission: AccessTypeNobody,
 				MaxWasmCodeSize:              DefaultMaxWasmCodeSize,
+				GasMultiplier:                DefaultGasMultiplier,
+				MaxScheduledCallbackGas:      DefaultMaxScheduledCallbackGas,
+				ContractMemoryLimit:          DefaultContractMemoryLimit,
+				InstanceCost:                 DefaultInstanceCost,
+				CompileCost:                  DefaultCompileCost,
+				HumanizeCost:                 DefaultHumanizeCost,
+				CanonicalizeCost:             DefaultCanonicalizeCost,
 			},
 		},
 		"all good with everybody": {
@@ -36,6 +70,13 @@ func TestValidateParams(t *testing.T) {
 				CodeUploadAccess:             AllowEverybody,
 				InstantiateDefaultPermission: AccessTypeEverybody,
 				MaxWasmCodeSize:              DefaultMaxWasmCodeSize,
+				GasMultiplier:                DefaultGasMultiplier,
+				MaxScheduledCallbackGas:      DefaultMaxScheduledCallbackGas,
+				ContractMemoryLimit:          DefaultContractMemoryLimit,
+				InstanceCost:                 DefaultInstanceCost,
+				CompileCost:                  DefaultCompileCost,
+				HumanizeCost:                 DefaultHumanizeCost,
+				CanonicalizeCost:             DefaultCanonicalizeCost,
 			},
 		},
 		"all good with only address": {
@@ -43,12 +84,68 @@ func TestValidateParams(t *testing.T) {
 				CodeUploadAccess:             AccessTypeOnlyAddress.With(anyAddress),
 				InstantiateDefaultPermission: AccessTypeOnlyAddress,
 				MaxWasmCodeSize:              DefaultMaxWasmCodeSize,
+				GasMultiplier:                DefaultGasMultiplier,
+				MaxScheduledCallbackGas:      DefaultMaxScheduledCallbackGas,
+				ContractMemoryLimit:          DefaultContractMemoryLimit,
+				InstanceCost:                 DefaultInstanceCost,
+				CompileCost:                  DefaultCompileCost,
+				HumanizeCost:                 DefaultHumanizeCost,
+				CanonicalizeCost:             DefaultCanonicalizeCost,
+			},
+		},
+		"all good with any of addresses": {
+			src: Params{
+				CodeUploadAccess:             AccessTypeAnyOfAddresses.With(anyAddress, otherAddress),
+				InstantiateDefaultPermission: AccessTypeAnyOfAddresses,
+				MaxWasmCodeSize:              DefaultMaxWasmCodeSize,
+				GasMultiplier:                DefaultGasMultiplier,
+				MaxScheduledCallbackGas:      DefaultMaxScheduledCallbackGas,
+				ContractMemoryLimit:          DefaultContractMemoryLimit,
+			},
+		},
+		"all good with only contract upload": {
+			src: Params{
+				CodeUploadAccess:             AccessTypeOnlyContract.With(anyAddress),
+				InstantiateDefaultPermission: AccessTypeNobody,
+				MaxWasmCodeSize:              DefaultMaxWasmCodeSize,
+				GasMultiplier:                DefaultGasMultiplier,
+				MaxScheduledCallbackGas:      DefaultMaxScheduledCallbackGas,
+				ContractMemoryLimit:          DefaultContractMemoryLimit,
+			},
+		},
+		"reject only contract as default instantiate permission": {
+			src: Params{
+				CodeUploadAccess:             AllowNobody,
+				InstantiateDefaultPermission: AccessTypeOnlyContract,
+				MaxWasmCodeSize:              DefaultMaxWasmCodeSize,
+				GasMultiplier:                DefaultGasMultiplier,
+				MaxScheduledCallbackGas:      DefaultMaxScheduledCallbackGas,
+				ContractMemoryLimit:          DefaultContractMemoryLimit,
 			},
+			expErr: true,
+		},
+		"reject empty addresses in any of addresses": {
+			src: Params{
+				CodeUploadAccess:             AccessConfig{Permission: AccessTypeAnyOfAddresses},
+				InstantiateDefaultPermission: AccessTypeNobody,
+				MaxWasmCodeSize:              DefaultMaxWasmCodeSize,
+				GasMultiplier:                DefaultGasMultiplier,
+				MaxScheduledCallbackGas:      DefaultMaxScheduledCallbackGas,
+				ContractMemoryLimit:          DefaultContractMemoryLimit,
+			},
+			expErr: true,
 		},
 		"reject empty type in instantiate permission": {
 			src: Params{
-				CodeUploadAccess: AllowNobody,
-				MaxWasmCodeSize:  DefaultMaxWasmCodeSize,
+				CodeUploadAccess:        AllowNobody,
+				MaxWasmCodeSize:         DefaultMaxWasmCodeSize,
+				GasMultiplier:           DefaultGasMultiplier,
+				MaxScheduledCallbackGas: DefaultMaxScheduledCallbackGas,
+				ContractMemoryLimit:     DefaultContractMemoryLimit,
+				InstanceCost:            DefaultInstanceCost,
+				CompileCost:             DefaultCompileCost,
+				HumanizeCost:            DefaultHumanizeCost,
+				CanonicalizeCost:        DefaultCanonicalizeCost,
 			},
 			expErr: true,
 		},
@@ -57,6 +154,13 @@ func TestValidateParams(t *testing.T) {
 				CodeUploadAccess:             AllowNobody,
 				InstantiateDefaultPermission: 1111,
 				MaxWasmCodeSize:              DefaultMaxWasmCodeSize,
+				GasMultiplier:                DefaultGasMultiplier,
+				MaxScheduledCallbackGas:      DefaultMaxScheduledCallbackGas,
+				ContractMemoryLimit:          DefaultContractMemoryLimit,
+				InstanceCost:                 DefaultInstanceCost,
+				CompileCost:                  DefaultCompileCost,
+				HumanizeCost:                 DefaultHumanizeCost,
+				CanonicalizeCost:             DefaultCanonicalizeCost,
 			},
 			expErr: true,
 		},
@@ -65,6 +169,13 @@ func TestValidateParams(t *testing.T) {
 				CodeUploadAccess:             AccessConfig{Permission: AccessTypeOnlyAddress, Address: invalidAddress},
 				InstantiateDefaultPermission: AccessTypeOnlyAddress,
 				MaxWasmCodeSize:              DefaultMaxWasmCodeSize,
+				GasMultiplier:                DefaultGasMultiplier,
+				MaxScheduledCallbackGas:      DefaultMaxScheduledCallbackGas,
+				ContractMemoryLimit:          DefaultContractMemoryLimit,
+				InstanceCost:                 DefaultInstanceCost,
+				CompileCost:                  DefaultCompileCost,
+				HumanizeCost:                 DefaultHumanizeCost,
+				CanonicalizeCost:             DefaultCanonicalizeCost,
 			},
 			expErr: true,
 		},
@@ -73,6 +184,13 @@ func TestValidateParams(t *testing.T) {
 				CodeUploadAccess:             AccessConfig{Permission: AccessTypeEverybody, Address: anyAddress.String()},
 				InstantiateDefaultPermission: AccessTypeOnlyAddress,
 				MaxWasmCodeSize:              DefaultMaxWasmCodeSize,
+				GasMultiplier:                DefaultGasMultiplier,
+				MaxScheduledCallbackGas:      DefaultMaxScheduledCallbackGas,
+				ContractMemoryLimit:          DefaultContractMemoryLimit,
+				InstanceCost:                 DefaultInstanceCost,
+				CompileCost:                  DefaultCompileCost,
+				HumanizeCost:                 DefaultHumanizeCost,
+				CanonicalizeCost:             DefaultCanonicalizeCost,
 			},
 			expErr: true,
 		},
@@ -81,6 +199,13 @@ func TestValidateParams(t *testing.T) {
 				CodeUploadAccess:             AccessConfig{Permission: AccessTypeNobody, Address: anyAddress.String()},
 				InstantiateDefaultPermission: AccessTypeOnlyAddress,
 				MaxWasmCodeSize:              DefaultMaxWasmCodeSize,
+				GasMultiplier:                DefaultGasMultiplier,
+				MaxScheduledCallbackGas:      DefaultMaxScheduledCallbackGas,
+				ContractMemoryLimit:          DefaultContractMemoryLimit,
+				InstanceCost:                 DefaultInstanceCost,
+				CompileCost:                  DefaultCompileCost,
+				HumanizeCost:                 DefaultHumanizeCost,
+				CanonicalizeCost:             DefaultCanonicalizeCost,
 			},
 			expErr: true,
 		},
@@ -88,6 +213,13 @@ func TestValidateParams(t *testing.T) {
 			src: Params{
 				InstantiateDefaultPermission: AccessTypeOnlyAddress,
 				MaxWasmCodeSize:              DefaultMaxWasmCodeSize,
+				GasMultiplier:                DefaultGasMultiplier,
+				MaxScheduledCallbackGas:      DefaultMaxScheduledCallbackGas,
+				ContractMemoryLimit:          DefaultContractMemoryLimit,
+				InstanceCost:                 DefaultInstanceCost,
+				CompileCost:                  DefaultCompileCost,
+				HumanizeCost:                 DefaultHumanizeCost,
+				CanonicalizeCost:             DefaultCanonicalizeCost,
 			},
 			expErr: true,
 		},
@@ -96,6 +228,91 @@ func TestValidateParams(t *testing.T) {
 				CodeUploadAccess:             AccessConfig{Permission: AccessTypeUnspecified},
 				InstantiateDefaultPermission: AccessTypeOnlyAddress,
 				MaxWasmCodeSize:              DefaultMaxWasmCodeSize,
+				GasMultiplier:                DefaultGasMultiplier,
+				MaxScheduledCallbackGas:      DefaultMaxScheduledCallbackGas,
+				ContractMemoryLimit:          DefaultContractMemoryLimit,
+				InstanceCost:                 DefaultInstanceCost,
+				CompileCost:                  DefaultCompileCost,
+				HumanizeCost:                 DefaultHumanizeCost,
+				CanonicalizeCost:             DefaultCanonicalizeCost,
+			},
+			expErr: true,
+		},
+		"all good without accepted stargate queries": {
+			src: Params{
+				CodeUploadAccess:             AllowNobody,
+				InstantiateDefaultPermission: AccessTypeNobody,
+				MaxWasmCodeSize:              DefaultMaxWasmCodeSize,
+				GasMultiplier:                DefaultGasMultiplier,
+				MaxScheduledCallbackGas:      DefaultMaxScheduledCallbackGas,
+				ContractMemoryLimit:          DefaultContractMemoryLimit,
+				InstanceCost:                 DefaultInstanceCost,
+				CompileCost:                  DefaultCompileCost,
+				HumanizeCost:                 DefaultHumanizeCost,
+				CanonicalizeCost:             DefaultCanonicalizeCost,
+			},
+		},
+		"reject invalid accepted stargate query path": {
+			src: Params{
+				CodeUploadAccess:             AllowNobody,
+				InstantiateDefaultPermission: AccessTypeNobody,
+				MaxWasmCodeSize:              DefaultMaxWasmCodeSize,
+				AcceptedStargateQueries:      []string{"cosmos.bank.v1beta1.Query/Balance"},
+				GasMultiplier:                DefaultGasMultiplier,
+				MaxScheduledCallbackGas:      DefaultMaxScheduledCallbackGas,
+				ContractMemoryLimit:          DefaultContractMemoryLimit,
+				InstanceCost:                 DefaultInstanceCost,
+				CompileCost:                  DefaultCompileCost,
+				HumanizeCost:                 DefaultHumanizeCost,
+				CanonicalizeCost:             DefaultCanonicalizeCost,
+			},
+			expErr: true,
+		},
+		"reject duplicate accepted stargate query path": {
+			src: Params{
+				CodeUploadAccess:             AllowNobody,
+				InstantiateDefaultPermission: AccessTypeNobody,
+				MaxWasmCodeSize:              DefaultMaxWasmCodeSize,
+				AcceptedStargateQueries:      []string{"/cosmos.bank.v1beta1.Query/Balance", "/cosmos.bank.v1beta1.Query/Balance"},
+				GasMultiplier:                DefaultGasMultiplier,
+				MaxScheduledCallbackGas:      DefaultMaxScheduledCallbackGas,
+				ContractMemoryLimit:          DefaultContractMemoryLimit,
+				InstanceCost:                 DefaultInstanceCost,
+				CompileCost:                  DefaultCompileCost,
+				HumanizeCost:                 DefaultHumanizeCost,
+				CanonicalizeCost:             DefaultCanonicalizeCost,
+			},
+			expErr: true,
+		},
+		"reject invalid accepted stargate msg": {
+			src: Params{
+				CodeUploadAccess:             AllowNobody,
+				InstantiateDefaultPermission: AccessTypeNobody,
+				MaxWasmCodeSize:              DefaultMaxWasmCodeSize,
+				AcceptedStargateMsgs:         []string{""},
+				GasMultiplier:                DefaultGasMultiplier,
+				MaxScheduledCallbackGas:      DefaultMaxScheduledCallbackGas,
+				ContractMemoryLimit:          DefaultContractMemoryLimit,
+				InstanceCost:                 DefaultInstanceCost,
+				CompileCost:                  DefaultCompileCost,
+				HumanizeCost:                 DefaultHumanizeCost,
+				CanonicalizeCost:             DefaultCanonicalizeCost,
+			},
+			expErr: true,
+		},
+		"reject duplicate denied stargate msg": {
+			src: Params{
+				CodeUploadAccess:             AllowNobody,
+				InstantiateDefaultPermission: AccessTypeNobody,
+				MaxWasmCodeSize:              DefaultMaxWasmCodeSize,
+				DeniedStargateMsgs:           []string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.bank.v1beta1.MsgSend"},
+				GasMultiplier:                DefaultGasMultiplier,
+				MaxScheduledCallbackGas:      DefaultMaxScheduledCallbackGas,
+				ContractMemoryLimit:          DefaultContractMemoryLimit,
+				InstanceCost:                 DefaultInstanceCost,
+				CompileCost:                  DefaultCompileCost,
+				HumanizeCost:                 DefaultHumanizeCost,
+				CanonicalizeCost:             DefaultCanonicalizeCost,
 			},
 			expErr: true,
 		},
@@ -103,6 +320,88 @@ func TestValidateParams(t *testing.T) {
 			src: Params{
 				CodeUploadAccess:             AllowNobody,
 				InstantiateDefaultPermission: AccessTypeNobody,
+				GasMultiplier:                DefaultGasMultiplier,
+				MaxScheduledCallbackGas:      DefaultMaxScheduledCallbackGas,
+				ContractMemoryLimit:          DefaultContractMemoryLimit,
+				InstanceCost:                 DefaultInstanceCost,
+				CompileCost:                  DefaultCompileCost,
+				HumanizeCost:                 DefaultHumanizeCost,
+				CanonicalizeCost:             DefaultCanonicalizeCost,
+			},
+			expErr: true,
+		},
+		"reject code storage limit without code id": {
+			src: Params{
+				CodeUploadAccess:             AllowNobody,
+				InstantiateDefaultPermission: AccessTypeNobody,
+				MaxWasmCodeSize:              DefaultMaxWasmCodeSize,
+				GasMultiplier:                DefaultGasMultiplier,
+				MaxScheduledCallbackGas:      DefaultMaxScheduledCallbackGas,
+				ContractMemoryLimit:          DefaultContractMemoryLimit,
+				CodeStorageLimits:            []CodeStorageLimit{{MaxBytes: 1}},
+			},
+			expErr: true,
+		},
+		"reject duplicate code storage limit": {
+			src: Params{
+				CodeUploadAccess:             AllowNobody,
+				InstantiateDefaultPermission: AccessTypeNobody,
+				MaxWasmCodeSize:              DefaultMaxWasmCodeSize,
+				GasMultiplier:                DefaultGasMultiplier,
+				MaxScheduledCallbackGas:      DefaultMaxScheduledCallbackGas,
+				ContractMemoryLimit:          DefaultContractMemoryLimit,
+				CodeStorageLimits:            []CodeStorageLimit{{CodeID: 1, MaxBytes: 1}, {CodeID: 1, MaxBytes: 2}},
+			},
+			expErr: true,
+		},
+		"reject invalid storage deposit denom": {
+			src: Params{
+				CodeUploadAccess:             AllowNobody,
+				InstantiateDefaultPermission: AccessTypeNobody,
+				MaxWasmCodeSize:              DefaultMaxWasmCodeSize,
+				GasMultiplier:                DefaultGasMultiplier,
+				MaxScheduledCallbackGas:      DefaultMaxScheduledCallbackGas,
+				ContractMemoryLimit:          DefaultContractMemoryLimit,
+				StorageDepositPerByte:        sdk.DecCoins{{Denom: "1", Amount: sdk.OneDec()}},
+			},
+			expErr: true,
+		},
+		"reject zero gas multiplier": {
+			src: Params{
+				CodeUploadAccess:             AllowNobody,
+				InstantiateDefaultPermission: AccessTypeNobody,
+				MaxWasmCodeSize:              DefaultMaxWasmCodeSize,
+			},
+			expErr: true,
+		},
+		"reject zero contract memory limit": {
+			src: Params{
+				CodeUploadAccess:             AllowNobody,
+				InstantiateDefaultPermission: AccessTypeNobody,
+				MaxWasmCodeSize:              DefaultMaxWasmCodeSize,
+				GasMultiplier:                DefaultGasMultiplier,
+				MaxScheduledCallbackGas:      DefaultMaxScheduledCallbackGas,
+			},
+			expErr: true,
+		},
+		"reject contract memory limit above max": {
+			src: Params{
+				CodeUploadAccess:             AllowNobody,
+				InstantiateDefaultPermission: AccessTypeNobody,
+				MaxWasmCodeSize:              DefaultMaxWasmCodeSize,
+				GasMultiplier:                DefaultGasMultiplier,
+				MaxScheduledCallbackGas:      DefaultMaxScheduledCallbackGas,
+				ContractMemoryLimit:          MaxContractMemoryLimit + 1,
+			},
+			expErr: true,
+		},
+		"reject zero max scheduled callback gas": {
+			src: Params{
+				CodeUploadAccess:             AllowNobody,
+				InstantiateDefaultPermission: AccessTypeNobody,
+				MaxWasmCodeSize:              DefaultMaxWasmCodeSize,
+				GasMultiplier:                DefaultGasMultiplier,
+				ContractMemoryLimit:          DefaultContractMemoryLimit,
 			},
 			expErr: true,
 		},
@@ -124,11 +423,13 @@ func TestAccessTypeMarshalJson(t *testing.T) {
 		src AccessType
 		exp string
 	}{
-		"Unspecified": {src: AccessTypeUnspecified, exp: `"Unspecified"`},
-		"Nobody":      {src: AccessTypeNobody, exp: `"Nobody"`},
-		"OnlyAddress": {src: AccessTypeOnlyAddress, exp: `"OnlyAddress"`},
-		"Everybody":   {src: AccessTypeEverybody, exp: `"Everybody"`},
-		"unknown":     {src: 999, exp: `"Unspecified"`},
+		"Unspecified":    {src: AccessTypeUnspecified, exp: `"Unspecified"`},
+		"Nobody":         {src: AccessTypeNobody, exp: `"Nobody"`},
+		"OnlyAddress":    {src: AccessTypeOnlyAddress, exp: `"OnlyAddress"`},
+		"Everybody":      {src: AccessTypeEverybody, exp: `"Everybody"`},
+		"AnyOfAddresses": {src: AccessTypeAnyOfAddresses, exp: `"AnyOfAddresses"`},
+		"OnlyContract":   {src: AccessTypeOnlyContract, exp: `"OnlyContract"`},
+		"unknown":        {src: 999, exp: `"Unspecified"`},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -144,11 +445,13 @@ func TestAccessTypeUnmarshalJson(t *testing.T) {
 		src string
 		exp AccessType
 	}{
-		"Unspecified": {src: `"Unspecified"`, exp: AccessTypeUnspecified},
-		"Nobody":      {src: `"Nobody"`, exp: AccessTypeNobody},
-		"OnlyAddress": {src: `"OnlyAddress"`, exp: AccessTypeOnlyAddress},
-		"Everybody":   {src: `"Everybody"`, exp: AccessTypeEverybody},
-		"unknown":     {src: `""`, exp: AccessTypeUnspecified},
+		"Unspecified":    {src: `"Unspecified"`, exp: AccessTypeUnspecified},
+		"Nobody":         {src: `"Nobody"`, exp: AccessTypeNobody},
+		"OnlyAddress":    {src: `"OnlyAddress"`, exp: AccessTypeOnlyAddress},
+		"Everybody":      {src: `"Everybody"`, exp: AccessTypeEverybody},
+		"AnyOfAddresses": {src: `"AnyOfAddresses"`, exp: AccessTypeAnyOfAddresses},
+		"OnlyContract":   {src: `"OnlyContract"`, exp: AccessTypeOnlyContract},
+		"unknown":        {src: `""`, exp: AccessTypeUnspecified},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -168,7 +471,39 @@ func TestParamsUnmarshalJson(t *testing.T) {
 		"defaults": {
 			src: `{"code_upload_access": {"permission": "Everybody"},
 				"instantiate_default_permission": "Everybody",
-				"max_wasm_code_size": 614400}`,
+				"max_wasm_code_size": 614400,
+				"accepted_stargate_queries": [
+					"/cosmos.bank.v1beta1.Query/AllBalances",
+					"/cosmos.bank.v1beta1.Query/Balance",
+					"/cosmos.bank.v1beta1.Query/SupplyOf",
+					"/cosmos.bank.v1beta1.Query/TotalSupply"],
+				"accepted_stargate_msgs": [
+					"/cosmos.bank.v1beta1.MsgSend",
+					"/cosmos.distribution.v1beta1.MsgSetWithdrawAddress",
+					"/cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward",
+					"/cosmos.gov.v1beta1.MsgVote",
+					"/cosmos.staking.v1beta1.MsgBeginRedelegate",
+					"/cosmos.staking.v1beta1.MsgDelegate",
+					"/cosmos.staking.v1beta1.MsgUndelegate",
+					"/cosmwasm.wasm.v1beta1.MsgClearAdmin",
+					"/cosmwasm.wasm.v1beta1.MsgExecuteContract",
+					"/cosmwasm.wasm.v1beta1.MsgInstantiateContract",
+					"/cosmwasm.wasm.v1beta1.MsgInstantiateContract2",
+					"/cosmwasm.wasm.v1beta1.MsgMigrateContract",
+					"/cosmwasm.wasm.v1beta1.MsgUpdateAdmin",
+					"/ibc.applications.transfer.v1.MsgTransfer"],
+				"gas_multiplier": "100",
+				"instance_cost": "40000",
+				"compile_cost": "2",
+				"humanize_cost": "5",
+				"canonicalize_cost": "4",
+				"contract_memory_limit": 32,
+				"max_contract_storage_bytes": "0",
+				"max_scheduled_callback_gas": "10000000",
+				"storage_read_cost_per_byte": "3",
+				"storage_write_cost_per_byte": "30",
+				"storage_iter_next_cost": "30",
+				"storage_delete_cost": "1000"}`,
 			exp: DefaultParams(),
 		},
 	}
@@ -184,3 +519,114 @@ func TestParamsUnmarshalJson(t *testing.T) {
 		})
 	}
 }
+
+func TestAccessConfigValidateBasic(t *testing.T) {
+	var (
+		anyAddress   = sdk.AccAddress(make([]byte, sdk.AddrLen)).String()
+		otherAddress = sdk.AccAddress(bytes.Repeat([]byte{1}, sdk.AddrLen)).String()
+	)
+	specs := map[string]struct {
+		src    AccessConfig
+		expErr bool
+	}{
+		"any of addresses": {
+			src: AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: []string{anyAddress, otherAddress}},
+		},
+		"any of addresses empty": {
+			src:    AccessConfig{Permission: AccessTypeAnyOfAddresses},
+			expErr: true,
+		},
+		"any of addresses with duplicate": {
+			src:    AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: []string{anyAddress, anyAddress}},
+			expErr: true,
+		},
+		"any of addresses with invalid address": {
+			src:    AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: []string{anyAddress, "invalid"}},
+			expErr: true,
+		},
+		"any of addresses with single address field": {
+			src:    AccessConfig{Permission: AccessTypeAnyOfAddresses, Address: anyAddress, Addresses: []string{otherAddress}},
+			expErr: true,
+		},
+		"only contract": {
+			src: AccessConfig{Permission: AccessTypeOnlyContract, Address: anyAddress},
+		},
+		"only contract without address": {
+			src:    AccessConfig{Permission: AccessTypeOnlyContract},
+			expErr: true,
+		},
+		"only address with addresses": {
+			src:    AccessConfig{Permission: AccessTypeOnlyAddress, Address: anyAddress, Addresses: []string{otherAddress}},
+			expErr: true,
+		},
+		"everybody with addresses": {
+			src:    AccessConfig{Permission: AccessTypeEverybody, Addresses: []string{anyAddress}},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAccessConfigAllowed(t *testing.T) {
+	var (
+		anyAddress   = sdk.AccAddress(make([]byte, sdk.AddrLen))
+		otherAddress = sdk.AccAddress(bytes.Repeat([]byte{1}, sdk.AddrLen))
+		strangerAddr = sdk.AccAddress(bytes.Repeat([]byte{2}, sdk.AddrLen))
+	)
+	specs := map[string]struct {
+		src AccessConfig
+		exp map[string]bool
+	}{
+		"any of addresses": {
+			src: AccessTypeAnyOfAddresses.With(anyAddress, otherAddress),
+			exp: map[string]bool{anyAddress.String(): true, otherAddress.String(): true, strangerAddr.String(): false},
+		},
+		"only contract": {
+			src: AccessTypeOnlyContract.With(anyAddress),
+			exp: map[string]bool{anyAddress.String(): true, otherAddress.String(): false, strangerAddr.String(): false},
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			for _, actor := range []sdk.AccAddress{anyAddress, otherAddress, strangerAddr} {
+				assert.Equal(t, spec.exp[actor.String()], spec.src.Allowed(actor), actor.String())
+			}
+		})
+	}
+}
+
+func TestAccessConfigMarshalling(t *testing.T) {
+	var (
+		anyAddress   = sdk.AccAddress(make([]byte, sdk.AddrLen))
+		otherAddress = sdk.AccAddress(bytes.Repeat([]byte{1}, sdk.AddrLen))
+	)
+	marshaler := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	specs := map[string]AccessConfig{
+		"any of addresses": AccessTypeAnyOfAddresses.With(anyAddress, otherAddress),
+		"only contract":    AccessTypeOnlyContract.With(anyAddress),
+	}
+	for msg, src := range specs {
+		t.Run(msg, func(t *testing.T) {
+			bz, err := marshaler.MarshalJSON(&src)
+			require.NoError(t, err)
+			var got AccessConfig
+			require.NoError(t, marshaler.UnmarshalJSON(bz, &got))
+			assert.True(t, src.Equals(got), "got %s", got)
+
+			bz, err = yaml.Marshal(src)
+			require.NoError(t, err)
+			got = AccessConfig{}
+			require.NoError(t, yaml.Unmarshal(bz, &got))
+			assert.True(t, src.Equals(got), "got %s", got)
+		})
+	}
+}
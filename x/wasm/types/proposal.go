@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -13,13 +14,21 @@ import (
 type ProposalType string
 
 const (
-	ProposalTypeStoreCode           ProposalType = "StoreCode"
-	ProposalTypeInstantiateContract ProposalType = "InstantiateContract"
-	ProposalTypeMigrateContract     ProposalType = "MigrateContract"
-	ProposalTypeUpdateAdmin         ProposalType = "UpdateAdmin"
-	ProposalTypeClearAdmin          ProposalType = "ClearAdmin"
-	ProposalTypePinCodes            ProposalType = "PinCodes"
-	ProposalTypeUnpinCodes          ProposalType = "UnpinCodes"
+	ProposalTypeStoreCode                   ProposalType = "StoreCode"
+	ProposalTypeInstantiateContract         ProposalType = "InstantiateContract"
+	ProposalTypeMigrateContract             ProposalType = "MigrateContract"
+	ProposalTypeUpdateAdmin                 ProposalType = "UpdateAdmin"
+	ProposalTypeClearAdmin                  ProposalType = "ClearAdmin"
+	ProposalTypePinCodes                    ProposalType = "PinCodes"
+	ProposalTypeUnpinCodes                  ProposalType = "UnpinCodes"
+	ProposalTypeDeleteCodes                 ProposalType = "DeleteCodes"
+	ProposalTypePauseContract               ProposalType = "PauseContract"
+	ProposalTypeUnpauseContract             ProposalType = "UnpauseContract"
+	ProposalTypeExecuteContract             ProposalType = "ExecuteContract"
+	ProposalTypeSudoContract                ProposalType = "SudoContract"
+	ProposalTypeStoreAndInstantiateContract ProposalType = "StoreAndInstantiateContract"
+	ProposalTypeUpdateInstantiateConfig     ProposalType = "UpdateInstantiateConfig"
+	ProposalTypeUpdateMigrationAllowlist    ProposalType = "UpdateMigrationAllowlist"
 )
 
 // DisableAllProposals contains no wasm gov types.
@@ -34,6 +43,14 @@ var EnableAllProposals = []ProposalType{
 	ProposalTypeClearAdmin,
 	ProposalTypePinCodes,
 	ProposalTypeUnpinCodes,
+	ProposalTypeDeleteCodes,
+	ProposalTypePauseContract,
+	ProposalTypeUnpauseContract,
+	ProposalTypeExecuteContract,
+	ProposalTypeSudoContract,
+	ProposalTypeStoreAndInstantiateContract,
+	ProposalTypeUpdateInstantiateConfig,
+	ProposalTypeUpdateMigrationAllowlist,
 }
 
 // ConvertToProposals maps each key to a ProposalType and returns a typed list.
@@ -62,6 +79,14 @@ func init() { // register new content types with the sdk
 	govtypes.RegisterProposalType(string(ProposalTypeClearAdmin))
 	govtypes.RegisterProposalType(string(ProposalTypePinCodes))
 	govtypes.RegisterProposalType(string(ProposalTypeUnpinCodes))
+	govtypes.RegisterProposalType(string(ProposalTypeDeleteCodes))
+	govtypes.RegisterProposalType(string(ProposalTypePauseContract))
+	govtypes.RegisterProposalType(string(ProposalTypeUnpauseContract))
+	govtypes.RegisterProposalType(string(ProposalTypeExecuteContract))
+	govtypes.RegisterProposalType(string(ProposalTypeSudoContract))
+	govtypes.RegisterProposalType(string(ProposalTypeStoreAndInstantiateContract))
+	govtypes.RegisterProposalType(string(ProposalTypeUpdateInstantiateConfig))
+	govtypes.RegisterProposalType(string(ProposalTypeUpdateMigrationAllowlist))
 	govtypes.RegisterProposalTypeCodec(StoreCodeProposal{}, "wasm/StoreCodeProposal")
 	govtypes.RegisterProposalTypeCodec(InstantiateContractProposal{}, "wasm/InstantiateContractProposal")
 	govtypes.RegisterProposalTypeCodec(MigrateContractProposal{}, "wasm/MigrateContractProposal")
@@ -69,6 +94,14 @@ func init() { // register new content types with the sdk
 	govtypes.RegisterProposalTypeCodec(ClearAdminProposal{}, "wasm/ClearAdminProposal")
 	govtypes.RegisterProposalTypeCodec(PinCodesProposal{}, "wasm/PinCodesProposal")
 	govtypes.RegisterProposalTypeCodec(UnpinCodesProposal{}, "wasm/UnpinCodesProposal")
+	govtypes.RegisterProposalTypeCodec(DeleteCodesProposal{}, "wasm/DeleteCodesProposal")
+	govtypes.RegisterProposalTypeCodec(PauseContractProposal{}, "wasm/PauseContractProposal")
+	govtypes.RegisterProposalTypeCodec(UnpauseContractProposal{}, "wasm/UnpauseContractProposal")
+	govtypes.RegisterProposalTypeCodec(ExecuteContractProposal{}, "wasm/ExecuteContractProposal")
+	govtypes.RegisterProposalTypeCodec(SudoContractProposal{}, "wasm/SudoContractProposal")
+	govtypes.RegisterProposalTypeCodec(StoreAndInstantiateContractProposal{}, "wasm/StoreAndInstantiateContractProposal")
+	govtypes.RegisterProposalTypeCodec(UpdateInstantiateConfigProposal{}, "wasm/UpdateInstantiateConfigProposal")
+	govtypes.RegisterProposalTypeCodec(UpdateMigrationAllowlistProposal{}, "wasm/UpdateMigrationAllowlistProposal")
 }
 
 // ProposalRoute returns the routing key of a parameter change proposal.
@@ -417,6 +450,418 @@ func (p UnpinCodesProposal) String() string {
 `, p.Title, p.Description, p.CodeIDs)
 }
 
+// ProposalRoute returns the routing key of a parameter change proposal.
+func (p DeleteCodesProposal) ProposalRoute() string { return RouterKey }
+
+// GetTitle returns the title of the proposal
+func (p *DeleteCodesProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the human readable description of the proposal
+func (p DeleteCodesProposal) GetDescription() string { return p.Description }
+
+// ProposalType returns the type
+func (p DeleteCodesProposal) ProposalType() string { return string(ProposalTypeDeleteCodes) }
+
+// ValidateBasic validates the proposal
+func (p DeleteCodesProposal) ValidateBasic() error {
+	if err := validateProposalCommons(p.Title, p.Description); err != nil {
+		return err
+	}
+	if len(p.CodeIDs) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "code ids")
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p DeleteCodesProposal) String() string {
+	return fmt.Sprintf(`Delete Wasm Codes Proposal:
+  Title:       %s
+  Description: %s
+  Codes:       %v
+`, p.Title, p.Description, p.CodeIDs)
+}
+
+// ProposalRoute returns the routing key of a parameter change proposal.
+func (p PauseContractProposal) ProposalRoute() string { return RouterKey }
+
+// GetTitle returns the title of the proposal
+func (p *PauseContractProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the human readable description of the proposal
+func (p PauseContractProposal) GetDescription() string { return p.Description }
+
+// ProposalType returns the type
+func (p PauseContractProposal) ProposalType() string { return string(ProposalTypePauseContract) }
+
+// ValidateBasic validates the proposal
+func (p PauseContractProposal) ValidateBasic() error {
+	if err := validateProposalCommons(p.Title, p.Description); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p PauseContractProposal) String() string {
+	return fmt.Sprintf(`Pause Contract Proposal:
+  Title:       %s
+  Description: %s
+  Contract:    %s
+`, p.Title, p.Description, p.Contract)
+}
+
+// ProposalRoute returns the routing key of a parameter change proposal.
+func (p UnpauseContractProposal) ProposalRoute() string { return RouterKey }
+
+// GetTitle returns the title of the proposal
+func (p *UnpauseContractProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the human readable description of the proposal
+func (p UnpauseContractProposal) GetDescription() string { return p.Description }
+
+// ProposalType returns the type
+func (p UnpauseContractProposal) ProposalType() string { return string(ProposalTypeUnpauseContract) }
+
+// ValidateBasic validates the proposal
+func (p UnpauseContractProposal) ValidateBasic() error {
+	if err := validateProposalCommons(p.Title, p.Description); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p UnpauseContractProposal) String() string {
+	return fmt.Sprintf(`Unpause Contract Proposal:
+  Title:       %s
+  Description: %s
+  Contract:    %s
+`, p.Title, p.Description, p.Contract)
+}
+
+// ProposalRoute returns the routing key of a parameter change proposal.
+func (p ExecuteContractProposal) ProposalRoute() string { return RouterKey }
+
+// GetTitle returns the title of the proposal
+func (p *ExecuteContractProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the human readable description of the proposal
+func (p ExecuteContractProposal) GetDescription() string { return p.Description }
+
+// ProposalType returns the type
+func (p ExecuteContractProposal) ProposalType() string { return string(ProposalTypeExecuteContract) }
+
+// ValidateBasic validates the proposal
+func (p ExecuteContractProposal) ValidateBasic() error {
+	if err := validateProposalCommons(p.Title, p.Description); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	if _, err := sdk.AccAddressFromBech32(p.RunAs); err != nil {
+		return sdkerrors.Wrap(err, "run as")
+	}
+	if !json.Valid(p.Msg) {
+		return sdkerrors.Wrap(ErrInvalid, "msg json")
+	}
+	if !p.Funds.IsValid() {
+		return sdkerrors.ErrInvalidCoins
+	}
+	if !p.Funds.IsZero() && !p.FromCommunityPool {
+		return sdkerrors.Wrap(ErrInvalid, "funds must be paid by the community pool")
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p ExecuteContractProposal) String() string {
+	return fmt.Sprintf(`Execute Contract Proposal:
+  Title:               %s
+  Description:         %s
+  Contract:            %s
+  Run as:              %s
+  Msg:                 %q
+  Funds:               %s
+  From community pool: %t
+`, p.Title, p.Description, p.Contract, p.RunAs, p.Msg, p.Funds, p.FromCommunityPool)
+}
+
+// MarshalYAML pretty prints the execute message
+func (p ExecuteContractProposal) MarshalYAML() (interface{}, error) {
+	return struct {
+		Title             string    `yaml:"title"`
+		Description       string    `yaml:"description"`
+		Contract          string    `yaml:"contract"`
+		RunAs             string    `yaml:"run_as"`
+		Msg               string    `yaml:"msg"`
+		Funds             sdk.Coins `yaml:"funds"`
+		FromCommunityPool bool      `yaml:"from_community_pool"`
+	}{
+		Title:             p.Title,
+		Description:       p.Description,
+		Contract:          p.Contract,
+		RunAs:             p.RunAs,
+		Msg:               string(p.Msg),
+		Funds:             p.Funds,
+		FromCommunityPool: p.FromCommunityPool,
+	}, nil
+}
+
+// ProposalRoute returns the routing key of a parameter change proposal.
+func (p SudoContractProposal) ProposalRoute() string { return RouterKey }
+
+// GetTitle returns the title of the proposal
+func (p *SudoContractProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the human readable description of the proposal
+func (p SudoContractProposal) GetDescription() string { return p.Description }
+
+// ProposalType returns the type
+func (p SudoContractProposal) ProposalType() string { return string(ProposalTypeSudoContract) }
+
+// ValidateBasic validates the proposal
+func (p SudoContractProposal) ValidateBasic() error {
+	if err := validateProposalCommons(p.Title, p.Description); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	if !json.Valid(p.Msg) {
+		return sdkerrors.Wrap(ErrInvalid, "msg json")
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p SudoContractProposal) String() string {
+	return fmt.Sprintf(`Sudo Contract Proposal:
+  Title:       %s
+  Description: %s
+  Contract:    %s
+  Msg:         %q
+`, p.Title, p.Description, p.Contract, p.Msg)
+}
+
+// MarshalYAML pretty prints the sudo message
+func (p SudoContractProposal) MarshalYAML() (interface{}, error) {
+	return struct {
+		Title       string `yaml:"title"`
+		Description string `yaml:"description"`
+		Contract    string `yaml:"contract"`
+		Msg         string `yaml:"msg"`
+	}{
+		Title:       p.Title,
+		Description: p.Description,
+		Contract:    p.Contract,
+		Msg:         string(p.Msg),
+	}, nil
+}
+
+// ProposalRoute returns the routing key of a parameter change proposal.
+func (p StoreAndInstantiateContractProposal) ProposalRoute() string { return RouterKey }
+
+// GetTitle returns the title of the proposal
+func (p *StoreAndInstantiateContractProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the human readable description of the proposal
+func (p StoreAndInstantiateContractProposal) GetDescription() string { return p.Description }
+
+// ProposalType returns the type
+func (p StoreAndInstantiateContractProposal) ProposalType() string {
+	return string(ProposalTypeStoreAndInstantiateContract)
+}
+
+// ValidateBasic validates the proposal
+func (p StoreAndInstantiateContractProposal) ValidateBasic() error {
+	if err := validateProposalCommons(p.Title, p.Description); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(p.RunAs); err != nil {
+		return sdkerrors.Wrap(err, "run as")
+	}
+
+	if err := validateWasmCode(p.WASMByteCode); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "code bytes %s", err.Error())
+	}
+
+	if err := validateSourceURL(p.Source); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "source %s", err.Error())
+	}
+
+	if err := validateBuilder(p.Builder); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "builder %s", err.Error())
+	}
+	if p.InstantiatePermission != nil {
+		if err := p.InstantiatePermission.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "instantiate permission")
+		}
+	}
+
+	if err := validateLabel(p.Label); err != nil {
+		return err
+	}
+
+	if !p.Funds.IsValid() {
+		return sdkerrors.ErrInvalidCoins
+	}
+
+	if len(p.Admin) != 0 {
+		if _, err := sdk.AccAddressFromBech32(p.Admin); err != nil {
+			return err
+		}
+	}
+	if !json.Valid(p.InitMsg) {
+		return sdkerrors.Wrap(ErrInvalid, "init msg json")
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p StoreAndInstantiateContractProposal) String() string {
+	return fmt.Sprintf(`Store And Instantiate Contract Proposal:
+  Title:       %s
+  Description: %s
+  Run as:      %s
+  WasmCode:    %X
+  Source:      %s
+  Builder:     %s
+  Admin:       %s
+  Label:       %s
+  InitMsg:     %q
+  Funds:       %s
+`, p.Title, p.Description, p.RunAs, p.WASMByteCode, p.Source, p.Builder, p.Admin, p.Label, p.InitMsg, p.Funds)
+}
+
+// MarshalYAML pretty prints the wasm byte code and the init message
+func (p StoreAndInstantiateContractProposal) MarshalYAML() (interface{}, error) {
+	return struct {
+		Title                 string        `yaml:"title"`
+		Description           string        `yaml:"description"`
+		RunAs                 string        `yaml:"run_as"`
+		WASMByteCode          string        `yaml:"wasm_byte_code"`
+		Source                string        `yaml:"source"`
+		Builder               string        `yaml:"builder"`
+		InstantiatePermission *AccessConfig `yaml:"instantiate_permission"`
+		Admin                 string        `yaml:"admin"`
+		Label                 string        `yaml:"label"`
+		InitMsg               string        `yaml:"init_msg"`
+		Funds                 sdk.Coins     `yaml:"funds"`
+	}{
+		Title:                 p.Title,
+		Description:           p.Description,
+		RunAs:                 p.RunAs,
+		WASMByteCode:          base64.StdEncoding.EncodeToString(p.WASMByteCode),
+		Source:                p.Source,
+		Builder:               p.Builder,
+		InstantiatePermission: p.InstantiatePermission,
+		Admin:                 p.Admin,
+		Label:                 p.Label,
+		InitMsg:               string(p.InitMsg),
+		Funds:                 p.Funds,
+	}, nil
+}
+
+// ProposalRoute returns the routing key of a parameter change proposal.
+func (p UpdateInstantiateConfigProposal) ProposalRoute() string { return RouterKey }
+
+// GetTitle returns the title of the proposal
+func (p *UpdateInstantiateConfigProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the human readable description of the proposal
+func (p UpdateInstantiateConfigProposal) GetDescription() string { return p.Description }
+
+// ProposalType returns the type
+func (p UpdateInstantiateConfigProposal) ProposalType() string {
+	return string(ProposalTypeUpdateInstantiateConfig)
+}
+
+// ValidateBasic validates the proposal
+func (p UpdateInstantiateConfigProposal) ValidateBasic() error {
+	if err := validateProposalCommons(p.Title, p.Description); err != nil {
+		return err
+	}
+	if len(p.AccessConfigUpdates) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "code updates")
+	}
+	dedup := make(map[uint64]bool)
+	for _, update := range p.AccessConfigUpdates {
+		if update.CodeID == 0 {
+			return sdkerrors.Wrap(ErrEmpty, "code id")
+		}
+		if dedup[update.CodeID] {
+			return sdkerrors.Wrapf(ErrDuplicate, "duplicate code: %d", update.CodeID)
+		}
+		dedup[update.CodeID] = true
+		if err := update.InstantiatePermission.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "instantiate permission of code: %d", update.CodeID)
+		}
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p UpdateInstantiateConfigProposal) String() string {
+	return fmt.Sprintf(`Update Instantiate Config Proposal:
+  Title:       %s
+  Description: %s
+  AccessConfigUpdates: %v
+`, p.Title, p.Description, p.AccessConfigUpdates)
+}
+
+// String implements the Stringer interface.
+func (c AccessConfigUpdate) String() string {
+	return fmt.Sprintf("{CodeID: %d, InstantiatePermission: %s}", c.CodeID, c.InstantiatePermission.String())
+}
+
+// ProposalRoute returns the routing key of a parameter change proposal.
+func (p UpdateMigrationAllowlistProposal) ProposalRoute() string { return RouterKey }
+
+// GetTitle returns the title of the proposal
+func (p *UpdateMigrationAllowlistProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the human readable description of the proposal
+func (p UpdateMigrationAllowlistProposal) GetDescription() string { return p.Description }
+
+// ProposalType returns the type
+func (p UpdateMigrationAllowlistProposal) ProposalType() string {
+	return string(ProposalTypeUpdateMigrationAllowlist)
+}
+
+// ValidateBasic validates the proposal
+func (p UpdateMigrationAllowlistProposal) ValidateBasic() error {
+	if err := validateProposalCommons(p.Title, p.Description); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	if p.Allowlist != nil {
+		if err := p.Allowlist.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "allowlist")
+		}
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p UpdateMigrationAllowlistProposal) String() string {
+	return fmt.Sprintf(`Update Migration Allowlist Proposal:
+  Title:       %s
+  Description: %s
+  Contract:    %s
+  Allowlist:   %v
+`, p.Title, p.Description, p.Contract, p.Allowlist)
+}
+
 func validateProposalCommons(title, description string) error {
 	if strings.TrimSpace(title) != title {
 		return sdkerrors.Wrap(govtypes.ErrInvalidProposalContent, "proposal title must not start/end with white spaces")
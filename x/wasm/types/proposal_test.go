@@ -266,6 +266,96 @@ func TestValidateInstantiateContractProposal(t *testing.T) {
 	}
 }
 
+func TestValidateStoreAndInstantiateContractProposal(t *testing.T) {
+	var (
+		invalidAddress = "invalid address"
+	)
+
+	specs := map[string]struct {
+		src    *StoreAndInstantiateContractProposal
+		expErr bool
+	}{
+		"all good": {
+			src: StoreAndInstantiateContractProposalFixture(),
+		},
+		"with instantiate permission": {
+			src: StoreAndInstantiateContractProposalFixture(func(p *StoreAndInstantiateContractProposal) {
+				accessConfig := AccessTypeOnlyAddress.With(bytes.Repeat([]byte{0x1}, sdk.AddrLen))
+				p.InstantiatePermission = &accessConfig
+			}),
+		},
+		"without admin": {
+			src: StoreAndInstantiateContractProposalFixture(func(p *StoreAndInstantiateContractProposal) {
+				p.Admin = ""
+			}),
+		},
+		"base data missing": {
+			src: StoreAndInstantiateContractProposalFixture(func(p *StoreAndInstantiateContractProposal) {
+				p.Title = ""
+			}),
+			expErr: true,
+		},
+		"run_as invalid": {
+			src: StoreAndInstantiateContractProposalFixture(func(p *StoreAndInstantiateContractProposal) {
+				p.RunAs = invalidAddress
+			}),
+			expErr: true,
+		},
+		"wasm code missing": {
+			src: StoreAndInstantiateContractProposalFixture(func(p *StoreAndInstantiateContractProposal) {
+				p.WASMByteCode = nil
+			}),
+			expErr: true,
+		},
+		"source invalid": {
+			src: StoreAndInstantiateContractProposalFixture(func(p *StoreAndInstantiateContractProposal) {
+				p.Source = "not an url"
+			}),
+			expErr: true,
+		},
+		"instantiate permission invalid": {
+			src: StoreAndInstantiateContractProposalFixture(func(p *StoreAndInstantiateContractProposal) {
+				p.InstantiatePermission = &AccessConfig{Permission: AccessTypeOnlyAddress, Address: invalidAddress}
+			}),
+			expErr: true,
+		},
+		"admin invalid": {
+			src: StoreAndInstantiateContractProposalFixture(func(p *StoreAndInstantiateContractProposal) {
+				p.Admin = invalidAddress
+			}),
+			expErr: true,
+		},
+		"label empty": {
+			src: StoreAndInstantiateContractProposalFixture(func(p *StoreAndInstantiateContractProposal) {
+				p.Label = ""
+			}),
+			expErr: true,
+		},
+		"init msg not json": {
+			src: StoreAndInstantiateContractProposalFixture(func(p *StoreAndInstantiateContractProposal) {
+				p.InitMsg = []byte("invalid")
+			}),
+			expErr: true,
+		},
+		"init funds negative": {
+			src: StoreAndInstantiateContractProposalFixture(func(p *StoreAndInstantiateContractProposal) {
+				p.Funds = sdk.Coins{{Denom: "foo", Amount: sdk.NewInt(-1)}}
+			}),
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestValidateMigrateContractProposal(t *testing.T) {
 	var (
 		invalidAddress = "invalid address2"
@@ -430,6 +520,202 @@ func TestValidateClearAdminProposal(t *testing.T) {
 	}
 }
 
+func TestValidatePauseContractProposals(t *testing.T) {
+	const anyAddress = "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du"
+
+	specs := map[string]struct {
+		src    govtypes.Content
+		expErr bool
+	}{
+		"pause all good": {
+			src: &PauseContractProposal{Title: "Foo", Description: "Bar", Contract: anyAddress},
+		},
+		"pause base data missing": {
+			src:    &PauseContractProposal{Description: "Bar", Contract: anyAddress},
+			expErr: true,
+		},
+		"pause contract invalid": {
+			src:    &PauseContractProposal{Title: "Foo", Description: "Bar", Contract: "invalid address"},
+			expErr: true,
+		},
+		"unpause all good": {
+			src: &UnpauseContractProposal{Title: "Foo", Description: "Bar", Contract: anyAddress},
+		},
+		"unpause base data missing": {
+			src:    &UnpauseContractProposal{Description: "Bar", Contract: anyAddress},
+			expErr: true,
+		},
+		"unpause contract missing": {
+			src:    &UnpauseContractProposal{Title: "Foo", Description: "Bar"},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateUpdateInstantiateConfigProposal(t *testing.T) {
+	specs := map[string]struct {
+		src    *UpdateInstantiateConfigProposal
+		expErr bool
+	}{
+		"all good": {
+			src: &UpdateInstantiateConfigProposal{Title: "Foo", Description: "Bar", AccessConfigUpdates: []AccessConfigUpdate{
+				{CodeID: 1, InstantiatePermission: AllowNobody},
+				{CodeID: 2, InstantiatePermission: AllowEverybody},
+			}},
+		},
+		"base data missing": {
+			src:    &UpdateInstantiateConfigProposal{Description: "Bar", AccessConfigUpdates: []AccessConfigUpdate{{CodeID: 1, InstantiatePermission: AllowNobody}}},
+			expErr: true,
+		},
+		"updates empty": {
+			src:    &UpdateInstantiateConfigProposal{Title: "Foo", Description: "Bar"},
+			expErr: true,
+		},
+		"code id missing": {
+			src:    &UpdateInstantiateConfigProposal{Title: "Foo", Description: "Bar", AccessConfigUpdates: []AccessConfigUpdate{{InstantiatePermission: AllowNobody}}},
+			expErr: true,
+		},
+		"duplicate code id": {
+			src: &UpdateInstantiateConfigProposal{Title: "Foo", Description: "Bar", AccessConfigUpdates: []AccessConfigUpdate{
+				{CodeID: 1, InstantiatePermission: AllowNobody},
+				{CodeID: 1, InstantiatePermission: AllowEverybody},
+			}},
+			expErr: true,
+		},
+		"invalid permission": {
+			src:    &UpdateInstantiateConfigProposal{Title: "Foo", Description: "Bar", AccessConfigUpdates: []AccessConfigUpdate{{CodeID: 1, InstantiatePermission: AccessConfig{Permission: AccessTypeOnlyAddress}}}},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateUpdateMigrationAllowlistProposal(t *testing.T) {
+	const anyAddress = "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du"
+
+	specs := map[string]struct {
+		src    *UpdateMigrationAllowlistProposal
+		expErr bool
+	}{
+		"all good": {
+			src: &UpdateMigrationAllowlistProposal{Title: "Foo", Description: "Bar", Contract: anyAddress,
+				Allowlist: &MigrationAllowlist{CodeIDs: []uint64{1}, CodeCreators: []string{anyAddress}}},
+		},
+		"allowlist removed": {
+			src: &UpdateMigrationAllowlistProposal{Title: "Foo", Description: "Bar", Contract: anyAddress},
+		},
+		"base data missing": {
+			src:    &UpdateMigrationAllowlistProposal{Description: "Bar", Contract: anyAddress},
+			expErr: true,
+		},
+		"contract invalid": {
+			src:    &UpdateMigrationAllowlistProposal{Title: "Foo", Description: "Bar", Contract: "invalid address"},
+			expErr: true,
+		},
+		"allowlist invalid": {
+			src:    &UpdateMigrationAllowlistProposal{Title: "Foo", Description: "Bar", Contract: anyAddress, Allowlist: &MigrationAllowlist{CodeIDs: []uint64{0}}},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateExecuteAndSudoContractProposals(t *testing.T) {
+	const anyAddress = "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du"
+
+	specs := map[string]struct {
+		src    govtypes.Content
+		expErr bool
+	}{
+		"execute all good": {
+			src: &ExecuteContractProposal{Title: "Foo", Description: "Bar", Contract: anyAddress, RunAs: anyAddress, Msg: []byte(`{}`)},
+		},
+		"execute with funds from community pool": {
+			src: &ExecuteContractProposal{Title: "Foo", Description: "Bar", Contract: anyAddress, RunAs: anyAddress, Msg: []byte(`{}`),
+				Funds: sdk.NewCoins(sdk.NewInt64Coin("denom", 1)), FromCommunityPool: true},
+		},
+		"execute with funds not from community pool": {
+			src: &ExecuteContractProposal{Title: "Foo", Description: "Bar", Contract: anyAddress, RunAs: anyAddress, Msg: []byte(`{}`),
+				Funds: sdk.NewCoins(sdk.NewInt64Coin("denom", 1))},
+			expErr: true,
+		},
+		"execute base data missing": {
+			src:    &ExecuteContractProposal{Description: "Bar", Contract: anyAddress, RunAs: anyAddress, Msg: []byte(`{}`)},
+			expErr: true,
+		},
+		"execute contract invalid": {
+			src:    &ExecuteContractProposal{Title: "Foo", Description: "Bar", Contract: "invalid address", RunAs: anyAddress, Msg: []byte(`{}`)},
+			expErr: true,
+		},
+		"execute run as missing": {
+			src:    &ExecuteContractProposal{Title: "Foo", Description: "Bar", Contract: anyAddress, Msg: []byte(`{}`)},
+			expErr: true,
+		},
+		"execute msg not json": {
+			src:    &ExecuteContractProposal{Title: "Foo", Description: "Bar", Contract: anyAddress, RunAs: anyAddress, Msg: []byte("invalid")},
+			expErr: true,
+		},
+		"execute invalid funds": {
+			src: &ExecuteContractProposal{Title: "Foo", Description: "Bar", Contract: anyAddress, RunAs: anyAddress, Msg: []byte(`{}`),
+				Funds: sdk.Coins{sdk.Coin{Denom: "denom", Amount: sdk.NewInt(-1)}}},
+			expErr: true,
+		},
+		"sudo all good": {
+			src: &SudoContractProposal{Title: "Foo", Description: "Bar", Contract: anyAddress, Msg: []byte(`{}`)},
+		},
+		"sudo base data missing": {
+			src:    &SudoContractProposal{Description: "Bar", Contract: anyAddress, Msg: []byte(`{}`)},
+			expErr: true,
+		},
+		"sudo contract missing": {
+			src:    &SudoContractProposal{Title: "Foo", Description: "Bar", Msg: []byte(`{}`)},
+			expErr: true,
+		},
+		"sudo msg missing": {
+			src:    &SudoContractProposal{Title: "Foo", Description: "Bar", Contract: anyAddress},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestProposalStrings(t *testing.T) {
 	specs := map[string]struct {
 		src govtypes.Content
@@ -8,6 +8,8 @@ import (
 	context "context"
 	encoding_json "encoding/json"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -319,6 +321,172 @@ func (m *QueryContractsByCodeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryContractsByCodeResponse proto.InternalMessageInfo
 
+// QueryContractsByCreatorRequest is the request type for the
+// Query/ContractsByCreator RPC method
+type QueryContractsByCreatorRequest struct {
+	// creator_address is the address of the contract creator
+	CreatorAddress string `protobuf:"bytes,1,opt,name=creator_address,json=creatorAddress,proto3" json:"creator_address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryContractsByCreatorRequest) Reset()         { *m = QueryContractsByCreatorRequest{} }
+func (m *QueryContractsByCreatorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContractsByCreatorRequest) ProtoMessage()    {}
+func (*QueryContractsByCreatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8595715dfdf95d1, []int{7}
+}
+func (m *QueryContractsByCreatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractsByCreatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractsByCreatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractsByCreatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractsByCreatorRequest.Merge(m, src)
+}
+func (m *QueryContractsByCreatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractsByCreatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractsByCreatorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractsByCreatorRequest proto.InternalMessageInfo
+
+// QueryContractsByCreatorResponse is the response type for the
+// Query/ContractsByCreator RPC method
+type QueryContractsByCreatorResponse struct {
+	ContractInfos []ContractInfoWithAddress `protobuf:"bytes,1,rep,name=contract_infos,json=contractInfos,proto3" json:"contract_infos"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryContractsByCreatorResponse) Reset()         { *m = QueryContractsByCreatorResponse{} }
+func (m *QueryContractsByCreatorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContractsByCreatorResponse) ProtoMessage()    {}
+func (*QueryContractsByCreatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8595715dfdf95d1, []int{8}
+}
+func (m *QueryContractsByCreatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractsByCreatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractsByCreatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractsByCreatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractsByCreatorResponse.Merge(m, src)
+}
+func (m *QueryContractsByCreatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractsByCreatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractsByCreatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractsByCreatorResponse proto.InternalMessageInfo
+
+// QueryContractsByAdminRequest is the request type for the
+// Query/ContractsByAdmin RPC method
+type QueryContractsByAdminRequest struct {
+	// admin_address is the address of the contract admin
+	AdminAddress string `protobuf:"bytes,1,opt,name=admin_address,json=adminAddress,proto3" json:"admin_address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryContractsByAdminRequest) Reset()         { *m = QueryContractsByAdminRequest{} }
+func (m *QueryContractsByAdminRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContractsByAdminRequest) ProtoMessage()    {}
+func (*QueryContractsByAdminRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8595715dfdf95d1, []int{9}
+}
+func (m *QueryContractsByAdminRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractsByAdminRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractsByAdminRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractsByAdminRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractsByAdminRequest.Merge(m, src)
+}
+func (m *QueryContractsByAdminRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractsByAdminRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractsByAdminRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractsByAdminRequest proto.InternalMessageInfo
+
+// QueryContractsByAdminResponse is the response type for the
+// Query/ContractsByAdmin RPC method
+type QueryContractsByAdminResponse struct {
+	ContractInfos []ContractInfoWithAddress `protobuf:"bytes,1,rep,name=contract_infos,json=contractInfos,proto3" json:"contract_infos"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryContractsByAdminResponse) Reset()         { *m = QueryContractsByAdminResponse{} }
+func (m *QueryContractsByAdminResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContractsByAdminResponse) ProtoMessage()    {}
+func (*QueryContractsByAdminResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8595715dfdf95d1, []int{10}
+}
+func (m *QueryContractsByAdminResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractsByAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractsByAdminResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractsByAdminResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractsByAdminResponse.Merge(m, src)
+}
+func (m *QueryContractsByAdminResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractsByAdminResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractsByAdminResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractsByAdminResponse proto.InternalMessageInfo
+
 // QueryAllContractStateRequest is the request type for the
 // Query/AllContractState RPC method
 type QueryAllContractStateRequest struct {
@@ -332,7 +500,7 @@ func (m *QueryAllContractStateRequest) Reset()         { *m = QueryAllContractSt
 func (m *QueryAllContractStateRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllContractStateRequest) ProtoMessage()    {}
 func (*QueryAllContractStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e8595715dfdf95d1, []int{7}
+	return fileDescriptor_e8595715dfdf95d1, []int{11}
 }
 func (m *QueryAllContractStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -373,7 +541,7 @@ func (m *QueryAllContractStateResponse) Reset()         { *m = QueryAllContractS
 func (m *QueryAllContractStateResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllContractStateResponse) ProtoMessage()    {}
 func (*QueryAllContractStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e8595715dfdf95d1, []int{8}
+	return fileDescriptor_e8595715dfdf95d1, []int{12}
 }
 func (m *QueryAllContractStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -414,7 +582,7 @@ func (m *QueryRawContractStateRequest) Reset()         { *m = QueryRawContractSt
 func (m *QueryRawContractStateRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRawContractStateRequest) ProtoMessage()    {}
 func (*QueryRawContractStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e8595715dfdf95d1, []int{9}
+	return fileDescriptor_e8595715dfdf95d1, []int{13}
 }
 func (m *QueryRawContractStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -454,7 +622,7 @@ func (m *QueryRawContractStateResponse) Reset()         { *m = QueryRawContractS
 func (m *QueryRawContractStateResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRawContractStateResponse) ProtoMessage()    {}
 func (*QueryRawContractStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e8595715dfdf95d1, []int{10}
+	return fileDescriptor_e8595715dfdf95d1, []int{14}
 }
 func (m *QueryRawContractStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -496,7 +664,7 @@ func (m *QuerySmartContractStateRequest) Reset()         { *m = QuerySmartContra
 func (m *QuerySmartContractStateRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySmartContractStateRequest) ProtoMessage()    {}
 func (*QuerySmartContractStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e8595715dfdf95d1, []int{11}
+	return fileDescriptor_e8595715dfdf95d1, []int{15}
 }
 func (m *QuerySmartContractStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -536,7 +704,7 @@ func (m *QuerySmartContractStateResponse) Reset()         { *m = QuerySmartContr
 func (m *QuerySmartContractStateResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySmartContractStateResponse) ProtoMessage()    {}
 func (*QuerySmartContractStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e8595715dfdf95d1, []int{12}
+	return fileDescriptor_e8595715dfdf95d1, []int{16}
 }
 func (m *QuerySmartContractStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -574,7 +742,7 @@ func (m *QueryCodeRequest) Reset()         { *m = QueryCodeRequest{} }
 func (m *QueryCodeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCodeRequest) ProtoMessage()    {}
 func (*QueryCodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e8595715dfdf95d1, []int{13}
+	return fileDescriptor_e8595715dfdf95d1, []int{17}
 }
 func (m *QueryCodeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -616,7 +784,7 @@ func (m *CodeInfoResponse) Reset()         { *m = CodeInfoResponse{} }
 func (m *CodeInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*CodeInfoResponse) ProtoMessage()    {}
 func (*CodeInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e8595715dfdf95d1, []int{14}
+	return fileDescriptor_e8595715dfdf95d1, []int{18}
 }
 func (m *CodeInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -655,7 +823,7 @@ func (m *QueryCodeResponse) Reset()         { *m = QueryCodeResponse{} }
 func (m *QueryCodeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCodeResponse) ProtoMessage()    {}
 func (*QueryCodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e8595715dfdf95d1, []int{15}
+	return fileDescriptor_e8595715dfdf95d1, []int{19}
 }
 func (m *QueryCodeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -694,7 +862,7 @@ func (m *QueryCodesRequest) Reset()         { *m = QueryCodesRequest{} }
 func (m *QueryCodesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCodesRequest) ProtoMessage()    {}
 func (*QueryCodesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e8595715dfdf95d1, []int{16}
+	return fileDescriptor_e8595715dfdf95d1, []int{20}
 }
 func (m *QueryCodesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -734,7 +902,7 @@ func (m *QueryCodesResponse) Reset()         { *m = QueryCodesResponse{} }
 func (m *QueryCodesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCodesResponse) ProtoMessage()    {}
 func (*QueryCodesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e8595715dfdf95d1, []int{17}
+	return fileDescriptor_e8595715dfdf95d1, []int{21}
 }
 func (m *QueryCodesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -763,161 +931,615 @@ func (m *QueryCodesResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryCodesResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*QueryContractInfoRequest)(nil), "cosmwasm.wasm.v1beta1.QueryContractInfoRequest")
-	proto.RegisterType((*QueryContractInfoResponse)(nil), "cosmwasm.wasm.v1beta1.QueryContractInfoResponse")
-	proto.RegisterType((*QueryContractHistoryRequest)(nil), "cosmwasm.wasm.v1beta1.QueryContractHistoryRequest")
-	proto.RegisterType((*QueryContractHistoryResponse)(nil), "cosmwasm.wasm.v1beta1.QueryContractHistoryResponse")
-	proto.RegisterType((*QueryContractsByCodeRequest)(nil), "cosmwasm.wasm.v1beta1.QueryContractsByCodeRequest")
-	proto.RegisterType((*ContractInfoWithAddress)(nil), "cosmwasm.wasm.v1beta1.ContractInfoWithAddress")
-	proto.RegisterType((*QueryContractsByCodeResponse)(nil), "cosmwasm.wasm.v1beta1.QueryContractsByCodeResponse")
-	proto.RegisterType((*QueryAllContractStateRequest)(nil), "cosmwasm.wasm.v1beta1.QueryAllContractStateRequest")
-	proto.RegisterType((*QueryAllContractStateResponse)(nil), "cosmwasm.wasm.v1beta1.QueryAllContractStateResponse")
-	proto.RegisterType((*QueryRawContractStateRequest)(nil), "cosmwasm.wasm.v1beta1.QueryRawContractStateRequest")
-	proto.RegisterType((*QueryRawContractStateResponse)(nil), "cosmwasm.wasm.v1beta1.QueryRawContractStateResponse")
-	proto.RegisterType((*QuerySmartContractStateRequest)(nil), "cosmwasm.wasm.v1beta1.QuerySmartContractStateRequest")
-	proto.RegisterType((*QuerySmartContractStateResponse)(nil), "cosmwasm.wasm.v1beta1.QuerySmartContractStateResponse")
-	proto.RegisterType((*QueryCodeRequest)(nil), "cosmwasm.wasm.v1beta1.QueryCodeRequest")
-	proto.RegisterType((*CodeInfoResponse)(nil), "cosmwasm.wasm.v1beta1.CodeInfoResponse")
-	proto.RegisterType((*QueryCodeResponse)(nil), "cosmwasm.wasm.v1beta1.QueryCodeResponse")
-	proto.RegisterType((*QueryCodesRequest)(nil), "cosmwasm.wasm.v1beta1.QueryCodesRequest")
-	proto.RegisterType((*QueryCodesResponse)(nil), "cosmwasm.wasm.v1beta1.QueryCodesResponse")
+// QueryCodeByChecksumRequest is the request type for the Query/CodeByChecksum
+// RPC method
+type QueryCodeByChecksumRequest struct {
+	// checksum is the hex encoded sha256 hash of the wasm code
+	Checksum string `protobuf:"bytes,1,opt,name=checksum,proto3" json:"checksum,omitempty"`
 }
 
-func init() { proto.RegisterFile("cosmwasm/wasm/v1beta1/query.proto", fileDescriptor_e8595715dfdf95d1) }
+func (m *QueryCodeByChecksumRequest) Reset()         { *m = QueryCodeByChecksumRequest{} }
+func (m *QueryCodeByChecksumRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCodeByChecksumRequest) ProtoMessage()    {}
+func (*QueryCodeByChecksumRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8595715dfdf95d1, []int{22}
+}
+func (m *QueryCodeByChecksumRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCodeByChecksumRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCodeByChecksumRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCodeByChecksumRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCodeByChecksumRequest.Merge(m, src)
+}
+func (m *QueryCodeByChecksumRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCodeByChecksumRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCodeByChecksumRequest.DiscardUnknown(m)
+}
 
-var fileDescriptor_e8595715dfdf95d1 = []byte{
-	// 1105 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x97, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x3d, 0xa9, 0xe3, 0xc4, 0xd3, 0x14, 0xcc, 0xa8, 0x50, 0x63, 0xdc, 0x75, 0x58, 0x50,
-	0xea, 0x16, 0x69, 0x37, 0x89, 0x53, 0x04, 0xe5, 0x54, 0xa7, 0x40, 0x2a, 0x51, 0x7e, 0x6c, 0x84,
-	0x22, 0xe8, 0x21, 0x1a, 0xef, 0x4e, 0xed, 0x45, 0xf6, 0x8e, 0xbb, 0x33, 0x26, 0xb1, 0xa2, 0x50,
-	0xc4, 0x85, 0x13, 0x02, 0x89, 0x23, 0x17, 0x0e, 0x1c, 0x50, 0x81, 0x7b, 0x8f, 0x1c, 0x38, 0xe4,
-	0x18, 0x89, 0x0b, 0x27, 0x0b, 0x1c, 0x84, 0x50, 0xfe, 0x84, 0x9e, 0xd0, 0xce, 0xce, 0x3a, 0xbb,
-	0x8e, 0xd7, 0xeb, 0x20, 0x0b, 0x2e, 0xd6, 0x8e, 0xfd, 0xde, 0x9b, 0xcf, 0xfb, 0xbe, 0x99, 0xf7,
-	0xd6, 0xf0, 0x79, 0x93, 0xb2, 0xd6, 0x0e, 0x66, 0x2d, 0x5d, 0x7c, 0x7c, 0xbc, 0x52, 0x23, 0x1c,
-	0xaf, 0xe8, 0xf7, 0x3b, 0xc4, 0xed, 0x6a, 0x6d, 0x97, 0x72, 0x8a, 0x9e, 0x0e, 0x4c, 0x34, 0xf1,
-	0x21, 0x4d, 0x0a, 0x17, 0xeb, 0xb4, 0x4e, 0x85, 0x85, 0xee, 0x3d, 0xf9, 0xc6, 0x85, 0x98, 0x78,
-	0xbc, 0xdb, 0x26, 0x4c, 0x9a, 0x14, 0xeb, 0x94, 0xd6, 0x9b, 0x44, 0xc7, 0x6d, 0x5b, 0xc7, 0x8e,
-	0x43, 0x39, 0xe6, 0x36, 0x75, 0x82, 0x5f, 0xaf, 0x79, 0x01, 0x28, 0xd3, 0x6b, 0x98, 0x11, 0x1f,
-	0x63, 0x10, 0xa4, 0x8d, 0xeb, 0xb6, 0x23, 0x8c, 0x7d, 0x5b, 0x75, 0x0d, 0xe6, 0xdf, 0xf3, 0x2c,
-	0xd6, 0xa9, 0xc3, 0x5d, 0x6c, 0xf2, 0xdb, 0xce, 0x3d, 0x6a, 0x90, 0xfb, 0x1d, 0xc2, 0x38, 0xca,
-	0xc3, 0x39, 0x6c, 0x59, 0x2e, 0x61, 0x2c, 0x0f, 0x16, 0x41, 0x39, 0x6b, 0x04, 0x4b, 0xf5, 0x4b,
-	0x00, 0x9f, 0x1d, 0xe1, 0xc6, 0xda, 0xd4, 0x61, 0x24, 0xde, 0x0f, 0x19, 0xf0, 0x82, 0x29, 0x3d,
-	0xb6, 0x6d, 0xe7, 0x1e, 0xcd, 0xcf, 0x2c, 0x82, 0xf2, 0xf9, 0xd5, 0x17, 0xb4, 0x91, 0xfa, 0x68,
-	0xe1, 0xe8, 0xd5, 0xf9, 0xc3, 0x5e, 0x09, 0x1c, 0xf7, 0x4a, 0x29, 0x63, 0xc1, 0x0c, 0x7d, 0x7f,
-	0x23, 0xfd, 0xf7, 0xb7, 0x25, 0xa0, 0x3e, 0x80, 0xcf, 0x45, 0x80, 0x36, 0x6c, 0xc6, 0xa9, 0xdb,
-	0x4d, 0x4c, 0x05, 0xbd, 0x01, 0xe1, 0x89, 0x28, 0x92, 0x67, 0x49, 0xf3, 0x15, 0xd4, 0x3c, 0x05,
-	0x35, 0xbf, 0x90, 0x01, 0xd3, 0xbb, 0xb8, 0x4e, 0x64, 0x54, 0x23, 0xe4, 0xa9, 0x3e, 0x02, 0xb0,
-	0x38, 0x9a, 0x40, 0xaa, 0xf2, 0x0e, 0x9c, 0x23, 0x0e, 0x77, 0x6d, 0xe2, 0x21, 0x9c, 0x2b, 0x9f,
-	0x5f, 0xd5, 0x13, 0xb2, 0x5e, 0xa7, 0x16, 0x91, 0x41, 0x5e, 0x77, 0xb8, 0xdb, 0xad, 0xa6, 0x0f,
-	0xbc, 0xec, 0x83, 0x28, 0xe8, 0xcd, 0x11, 0xe4, 0x57, 0x12, 0xc9, 0x7d, 0x9a, 0x08, 0xfa, 0x27,
-	0x43, 0xda, 0xb1, 0x6a, 0xd7, 0xdb, 0x3b, 0xd0, 0xee, 0x12, 0x9c, 0x33, 0xa9, 0x45, 0xb6, 0x6d,
-	0x4b, 0x68, 0x97, 0x36, 0x32, 0xde, 0xf2, 0xb6, 0x35, 0x35, 0xe9, 0xbe, 0x00, 0xf0, 0x52, 0xb8,
-	0xd4, 0x5b, 0x36, 0x6f, 0xdc, 0x94, 0xe5, 0xf9, 0x3f, 0xce, 0xd2, 0x2f, 0xc3, 0xa5, 0x1c, 0x08,
-	0x22, 0x4b, 0x79, 0x17, 0x3e, 0x11, 0xd9, 0x3a, 0xa8, 0xa8, 0x36, 0xc1, 0xde, 0xa1, 0xe4, 0x64,
-	0x41, 0x2f, 0x84, 0x11, 0xa6, 0x58, 0xd6, 0x4f, 0x83, 0x34, 0x6e, 0x36, 0x9b, 0x01, 0xc1, 0x26,
-	0xc7, 0x9c, 0xfc, 0x77, 0x97, 0xe2, 0x3b, 0x00, 0x2f, 0xc7, 0x20, 0x48, 0x29, 0x6f, 0xc0, 0x4c,
-	0x8b, 0x5a, 0xa4, 0x19, 0x48, 0x58, 0x8c, 0x91, 0xf0, 0x8e, 0x67, 0x24, 0x05, 0x93, 0x1e, 0xd3,
-	0x53, 0x6a, 0x4b, 0x0a, 0x65, 0xe0, 0x9d, 0x33, 0x0a, 0x75, 0x19, 0x42, 0xb1, 0xc7, 0xb6, 0x85,
-	0x39, 0x16, 0x08, 0x0b, 0x46, 0x56, 0x7c, 0x73, 0x0b, 0x73, 0xac, 0x56, 0x64, 0xfa, 0xa7, 0x03,
-	0xcb, 0xf4, 0x11, 0x4c, 0x0b, 0x4f, 0x20, 0x3c, 0xc5, 0xb3, 0xfa, 0x01, 0x54, 0x84, 0xd3, 0x66,
-	0x0b, 0xbb, 0x7c, 0xba, 0x3c, 0x9b, 0xb0, 0x14, 0x1b, 0x5a, 0x12, 0x2d, 0x87, 0x89, 0xaa, 0xc5,
-	0xc7, 0xbd, 0x52, 0x9e, 0x38, 0x26, 0xb5, 0x6c, 0xa7, 0xae, 0x7f, 0xc4, 0xa8, 0xa3, 0x19, 0x78,
-	0xe7, 0x0e, 0x61, 0xcc, 0xd3, 0xd2, 0xe7, 0x7d, 0x09, 0xe6, 0xe4, 0x6d, 0x49, 0xee, 0x19, 0xea,
-	0x5f, 0x00, 0xe6, 0x3c, 0xc3, 0xc8, 0xc0, 0xb8, 0x3a, 0x64, 0x5d, 0xcd, 0xf5, 0x7b, 0xa5, 0x8c,
-	0x30, 0xbb, 0x75, 0xdc, 0x2b, 0xcd, 0xd8, 0xd6, 0xa0, 0xe7, 0xe4, 0xe1, 0x9c, 0xe9, 0x12, 0xcc,
-	0xa9, 0x2b, 0xb2, 0xcb, 0x1a, 0xc1, 0x12, 0xbd, 0x0f, 0xb3, 0x1e, 0xce, 0x76, 0x03, 0xb3, 0x46,
-	0xfe, 0x9c, 0xa0, 0x7f, 0xe5, 0x71, 0xaf, 0xb4, 0x56, 0xb7, 0x79, 0xa3, 0x53, 0xd3, 0x4c, 0xda,
-	0xd2, 0x39, 0x71, 0x2c, 0xe2, 0xb6, 0x6c, 0x87, 0x87, 0x1f, 0x9b, 0x76, 0x8d, 0xe9, 0xb5, 0x2e,
-	0x27, 0x4c, 0xdb, 0x20, 0xbb, 0x55, 0xef, 0xc1, 0x98, 0xf7, 0x42, 0x6d, 0x60, 0xd6, 0x40, 0xcf,
-	0xc0, 0x0c, 0xa3, 0x1d, 0xd7, 0x24, 0xf9, 0xb4, 0xd8, 0x4f, 0xae, 0x3c, 0x90, 0x5a, 0xc7, 0x6e,
-	0x5a, 0xc4, 0xcd, 0xcf, 0xfa, 0x20, 0x72, 0x29, 0x9b, 0xc8, 0xe7, 0x00, 0x3e, 0x15, 0x92, 0x45,
-	0x66, 0xfa, 0x36, 0xcc, 0xfa, 0x99, 0x7a, 0x0d, 0x0b, 0x84, 0x4e, 0xec, 0xa8, 0xa6, 0x11, 0x55,
-	0x29, 0xd4, 0xb4, 0xe6, 0x4d, 0xf9, 0x1b, 0x2a, 0xca, 0x6a, 0x89, 0x4a, 0x57, 0xe7, 0x8f, 0x7b,
-	0x25, 0xb1, 0xf6, 0x2b, 0x23, 0x49, 0xee, 0x86, 0x40, 0x58, 0x50, 0xa0, 0xe8, 0x0d, 0x07, 0xff,
-	0xfa, 0x86, 0xff, 0x00, 0x20, 0x0a, 0x47, 0x97, 0x79, 0xbe, 0x05, 0xe1, 0x20, 0xcf, 0xe0, 0x6a,
-	0x4f, 0x9c, 0xa8, 0x7f, 0xcb, 0xb3, 0x41, 0x92, 0xd3, 0xbb, 0xe8, 0xab, 0x0f, 0x21, 0x9c, 0x15,
-	0xb4, 0xe8, 0x1b, 0x00, 0x17, 0xc2, 0x6d, 0x19, 0xc5, 0x4d, 0xe3, 0xb8, 0xb7, 0xa3, 0xc2, 0xf2,
-	0xe4, 0x0e, 0x3e, 0x89, 0x5a, 0xfe, 0xec, 0xd7, 0x3f, 0xbf, 0x9e, 0x51, 0xd1, 0x62, 0xf4, 0xc5,
-	0x2e, 0x68, 0xff, 0xfa, 0x9e, 0xbc, 0xc5, 0xfb, 0xe8, 0x47, 0x00, 0x9f, 0x1c, 0x7a, 0x8f, 0x40,
-	0xab, 0x93, 0xec, 0x17, 0x7d, 0xed, 0x29, 0x54, 0xce, 0xe4, 0x23, 0x31, 0x97, 0x05, 0xe6, 0x35,
-	0x54, 0x4e, 0xc2, 0xd4, 0x1b, 0x12, 0xed, 0x61, 0x08, 0x57, 0xce, 0xca, 0xc9, 0x70, 0xa3, 0x6f,
-	0x1a, 0x93, 0xe1, 0x0e, 0x0d, 0x63, 0x55, 0x13, 0xb8, 0x65, 0xb4, 0x34, 0x8c, 0x6b, 0x11, 0x7d,
-	0x4f, 0xb6, 0x95, 0xfd, 0x01, 0x3d, 0x43, 0x3f, 0x01, 0x98, 0x1b, 0x1e, 0x47, 0x68, 0xec, 0xce,
-	0x31, 0xf3, 0xb3, 0xb0, 0x76, 0x36, 0xa7, 0x24, 0xde, 0x53, 0xf2, 0x32, 0x81, 0xf6, 0x08, 0xc0,
-	0xdc, 0xf0, 0xfc, 0x18, 0xcf, 0x1b, 0x33, 0xc6, 0xc6, 0xf3, 0xc6, 0x8d, 0x28, 0xf5, 0x55, 0xc1,
-	0x5b, 0x41, 0x2b, 0x89, 0xbc, 0x2e, 0xde, 0xd1, 0xf7, 0x4e, 0xc6, 0xcf, 0x3e, 0xfa, 0x19, 0x40,
-	0x74, 0x7a, 0xd4, 0xa0, 0xeb, 0xe3, 0x38, 0x62, 0xa7, 0x5e, 0xe1, 0xe5, 0xb3, 0xba, 0xc9, 0x04,
-	0x5e, 0x13, 0x09, 0x5c, 0x47, 0x95, 0x64, 0xc1, 0xbd, 0x20, 0xd1, 0x14, 0x1e, 0xc0, 0xb4, 0x38,
-	0xce, 0x57, 0xc6, 0x1f, 0xcd, 0x93, 0x33, 0x5c, 0x4e, 0x36, 0x94, 0x5c, 0x2f, 0x0a, 0x2e, 0x05,
-	0x15, 0xc7, 0x1d, 0x5c, 0xb4, 0x0b, 0x67, 0x45, 0x6b, 0x45, 0x89, 0x81, 0x83, 0xde, 0x5e, 0xb8,
-	0x3a, 0x81, 0xa5, 0x64, 0x28, 0x08, 0x86, 0x8b, 0x08, 0x9d, 0x66, 0xa8, 0x6e, 0x1c, 0xfc, 0xa1,
-	0xa4, 0xbe, 0xef, 0x2b, 0xa9, 0x83, 0xbe, 0x02, 0x0e, 0xfb, 0x0a, 0xf8, 0xbd, 0xaf, 0x80, 0xaf,
-	0x8e, 0x94, 0xd4, 0xe1, 0x91, 0x92, 0xfa, 0xed, 0x48, 0x49, 0x7d, 0xb8, 0x14, 0x9a, 0xad, 0xeb,
-	0x94, 0xb5, 0xb6, 0x82, 0x3f, 0xad, 0x96, 0xbe, 0xeb, 0x07, 0x14, 0x7f, 0x5a, 0x6b, 0x19, 0xf1,
-	0x5f, 0xb3, 0xf2, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x6e, 0x56, 0xdc, 0x6b, 0x2a, 0x0f, 0x00,
-	0x00,
+var xxx_messageInfo_QueryCodeByChecksumRequest proto.InternalMessageInfo
+
+// QueryCodeByChecksumResponse is the response type for the
+// Query/CodeByChecksum RPC method
+type QueryCodeByChecksumResponse struct {
+	CodeInfos []CodeInfoResponse `protobuf:"bytes,1,rep,name=code_infos,json=codeInfos,proto3" json:"code_infos"`
 }
 
-func (this *QueryContractInfoResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *QueryCodeByChecksumResponse) Reset()         { *m = QueryCodeByChecksumResponse{} }
+func (m *QueryCodeByChecksumResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCodeByChecksumResponse) ProtoMessage()    {}
+func (*QueryCodeByChecksumResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8595715dfdf95d1, []int{23}
+}
+func (m *QueryCodeByChecksumResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCodeByChecksumResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCodeByChecksumResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
+}
+func (m *QueryCodeByChecksumResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCodeByChecksumResponse.Merge(m, src)
+}
+func (m *QueryCodeByChecksumResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCodeByChecksumResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCodeByChecksumResponse.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*QueryContractInfoResponse)
-	if !ok {
-		that2, ok := that.(QueryContractInfoResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_QueryCodeByChecksumResponse proto.InternalMessageInfo
+
+// QueryScheduledCallbacksRequest is the request type for the
+// Query/ScheduledCallbacks RPC method
+type QueryScheduledCallbacksRequest struct {
+	// contract is an optional address to filter the callbacks by
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryScheduledCallbacksRequest) Reset()         { *m = QueryScheduledCallbacksRequest{} }
+func (m *QueryScheduledCallbacksRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledCallbacksRequest) ProtoMessage()    {}
+func (*QueryScheduledCallbacksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8595715dfdf95d1, []int{24}
+}
+func (m *QueryScheduledCallbacksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledCallbacksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledCallbacksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Address != that1.Address {
-		return false
-	}
-	if !this.ContractInfo.Equal(that1.ContractInfo) {
-		return false
-	}
-	return true
 }
-func (this *ContractInfoWithAddress) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *QueryScheduledCallbacksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledCallbacksRequest.Merge(m, src)
+}
+func (m *QueryScheduledCallbacksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledCallbacksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledCallbacksRequest.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*ContractInfoWithAddress)
-	if !ok {
-		that2, ok := that.(ContractInfoWithAddress)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_QueryScheduledCallbacksRequest proto.InternalMessageInfo
+
+// QueryScheduledCallbacksResponse is the response type for the
+// Query/ScheduledCallbacks RPC method
+type QueryScheduledCallbacksResponse struct {
+	Callbacks []ScheduledCallback `protobuf:"bytes,1,rep,name=callbacks,proto3" json:"callbacks"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryScheduledCallbacksResponse) Reset()         { *m = QueryScheduledCallbacksResponse{} }
+func (m *QueryScheduledCallbacksResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledCallbacksResponse) ProtoMessage()    {}
+func (*QueryScheduledCallbacksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8595715dfdf95d1, []int{25}
+}
+func (m *QueryScheduledCallbacksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledCallbacksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledCallbacksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Address != that1.Address {
-		return false
-	}
-	if !this.ContractInfo.Equal(that1.ContractInfo) {
-		return false
-	}
-	return true
 }
-func (this *CodeInfoResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *QueryScheduledCallbacksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledCallbacksResponse.Merge(m, src)
+}
+func (m *QueryScheduledCallbacksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledCallbacksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledCallbacksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduledCallbacksResponse proto.InternalMessageInfo
+
+// QueryAcceptedStargateQueriesRequest is the request type for the
+// Query/AcceptedStargateQueries RPC method
+type QueryAcceptedStargateQueriesRequest struct {
+}
+
+func (m *QueryAcceptedStargateQueriesRequest) Reset()         { *m = QueryAcceptedStargateQueriesRequest{} }
+func (m *QueryAcceptedStargateQueriesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAcceptedStargateQueriesRequest) ProtoMessage()    {}
+func (*QueryAcceptedStargateQueriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8595715dfdf95d1, []int{26}
+}
+func (m *QueryAcceptedStargateQueriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAcceptedStargateQueriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAcceptedStargateQueriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAcceptedStargateQueriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAcceptedStargateQueriesRequest.Merge(m, src)
+}
+func (m *QueryAcceptedStargateQueriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAcceptedStargateQueriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAcceptedStargateQueriesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAcceptedStargateQueriesRequest proto.InternalMessageInfo
+
+// QueryAcceptedStargateQueriesResponse is the response type for the
+// Query/AcceptedStargateQueries RPC method
+type QueryAcceptedStargateQueriesResponse struct {
+	// paths are the accepted gRPC query paths in sorted order
+	Paths []string `protobuf:"bytes,1,rep,name=paths,proto3" json:"paths,omitempty"`
+}
+
+func (m *QueryAcceptedStargateQueriesResponse) Reset()         { *m = QueryAcceptedStargateQueriesResponse{} }
+func (m *QueryAcceptedStargateQueriesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAcceptedStargateQueriesResponse) ProtoMessage()    {}
+func (*QueryAcceptedStargateQueriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8595715dfdf95d1, []int{27}
+}
+func (m *QueryAcceptedStargateQueriesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAcceptedStargateQueriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAcceptedStargateQueriesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAcceptedStargateQueriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAcceptedStargateQueriesResponse.Merge(m, src)
+}
+func (m *QueryAcceptedStargateQueriesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAcceptedStargateQueriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAcceptedStargateQueriesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAcceptedStargateQueriesResponse proto.InternalMessageInfo
+
+// QueryStargateMsgsRequest is the request type for the Query/StargateMsgs RPC
+// method
+type QueryStargateMsgsRequest struct {
+}
+
+func (m *QueryStargateMsgsRequest) Reset()         { *m = QueryStargateMsgsRequest{} }
+func (m *QueryStargateMsgsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStargateMsgsRequest) ProtoMessage()    {}
+func (*QueryStargateMsgsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8595715dfdf95d1, []int{28}
+}
+func (m *QueryStargateMsgsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStargateMsgsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStargateMsgsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStargateMsgsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStargateMsgsRequest.Merge(m, src)
+}
+func (m *QueryStargateMsgsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStargateMsgsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStargateMsgsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStargateMsgsRequest proto.InternalMessageInfo
+
+// QueryStargateMsgsResponse is the response type for the Query/StargateMsgs RPC
+// method
+type QueryStargateMsgsResponse struct {
+	// accepted are the message type URLs that contracts can dispatch
+	Accepted []string `protobuf:"bytes,1,rep,name=accepted,proto3" json:"accepted,omitempty"`
+	// denied are the message type URLs that are rejected, even when they are
+	// accepted
+	Denied []string `protobuf:"bytes,2,rep,name=denied,proto3" json:"denied,omitempty"`
+}
+
+func (m *QueryStargateMsgsResponse) Reset()         { *m = QueryStargateMsgsResponse{} }
+func (m *QueryStargateMsgsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStargateMsgsResponse) ProtoMessage()    {}
+func (*QueryStargateMsgsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8595715dfdf95d1, []int{29}
+}
+func (m *QueryStargateMsgsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStargateMsgsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStargateMsgsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStargateMsgsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStargateMsgsResponse.Merge(m, src)
+}
+func (m *QueryStargateMsgsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStargateMsgsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStargateMsgsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStargateMsgsResponse proto.InternalMessageInfo
+
+// QueryContractStorageStatsRequest is the request type for the
+// Query/ContractStorageStats RPC method
+type QueryContractStorageStatsRequest struct {
+	// address is the address of the contract
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryContractStorageStatsRequest) Reset()         { *m = QueryContractStorageStatsRequest{} }
+func (m *QueryContractStorageStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContractStorageStatsRequest) ProtoMessage()    {}
+func (*QueryContractStorageStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8595715dfdf95d1, []int{30}
+}
+func (m *QueryContractStorageStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractStorageStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractStorageStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractStorageStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractStorageStatsRequest.Merge(m, src)
+}
+func (m *QueryContractStorageStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractStorageStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractStorageStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractStorageStatsRequest proto.InternalMessageInfo
+
+// QueryContractStorageStatsResponse is the response type for the
+// Query/ContractStorageStats RPC method
+type QueryContractStorageStatsResponse struct {
+	Stats ContractStorageStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats"`
+	// MaxBytes is the storage limit of the contract. Zero for no limit.
+	MaxBytes uint64 `protobuf:"varint,2,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"`
+	// StorageDeposit is the amount locked for the contract state
+	StorageDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=storage_deposit,json=storageDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"storage_deposit"`
+}
+
+func (m *QueryContractStorageStatsResponse) Reset()         { *m = QueryContractStorageStatsResponse{} }
+func (m *QueryContractStorageStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContractStorageStatsResponse) ProtoMessage()    {}
+func (*QueryContractStorageStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8595715dfdf95d1, []int{31}
+}
+func (m *QueryContractStorageStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractStorageStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractStorageStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractStorageStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractStorageStatsResponse.Merge(m, src)
+}
+func (m *QueryContractStorageStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractStorageStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractStorageStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractStorageStatsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*QueryContractInfoRequest)(nil), "cosmwasm.wasm.v1beta1.QueryContractInfoRequest")
+	proto.RegisterType((*QueryContractInfoResponse)(nil), "cosmwasm.wasm.v1beta1.QueryContractInfoResponse")
+	proto.RegisterType((*QueryContractHistoryRequest)(nil), "cosmwasm.wasm.v1beta1.QueryContractHistoryRequest")
+	proto.RegisterType((*QueryContractHistoryResponse)(nil), "cosmwasm.wasm.v1beta1.QueryContractHistoryResponse")
+	proto.RegisterType((*QueryContractsByCodeRequest)(nil), "cosmwasm.wasm.v1beta1.QueryContractsByCodeRequest")
+	proto.RegisterType((*ContractInfoWithAddress)(nil), "cosmwasm.wasm.v1beta1.ContractInfoWithAddress")
+	proto.RegisterType((*QueryContractsByCodeResponse)(nil), "cosmwasm.wasm.v1beta1.QueryContractsByCodeResponse")
+	proto.RegisterType((*QueryContractsByCreatorRequest)(nil), "cosmwasm.wasm.v1beta1.QueryContractsByCreatorRequest")
+	proto.RegisterType((*QueryContractsByCreatorResponse)(nil), "cosmwasm.wasm.v1beta1.QueryContractsByCreatorResponse")
+	proto.RegisterType((*QueryContractsByAdminRequest)(nil), "cosmwasm.wasm.v1beta1.QueryContractsByAdminRequest")
+	proto.RegisterType((*QueryContractsByAdminResponse)(nil), "cosmwasm.wasm.v1beta1.QueryContractsByAdminResponse")
+	proto.RegisterType((*QueryAllContractStateRequest)(nil), "cosmwasm.wasm.v1beta1.QueryAllContractStateRequest")
+	proto.RegisterType((*QueryAllContractStateResponse)(nil), "cosmwasm.wasm.v1beta1.QueryAllContractStateResponse")
+	proto.RegisterType((*QueryRawContractStateRequest)(nil), "cosmwasm.wasm.v1beta1.QueryRawContractStateRequest")
+	proto.RegisterType((*QueryRawContractStateResponse)(nil), "cosmwasm.wasm.v1beta1.QueryRawContractStateResponse")
+	proto.RegisterType((*QuerySmartContractStateRequest)(nil), "cosmwasm.wasm.v1beta1.QuerySmartContractStateRequest")
+	proto.RegisterType((*QuerySmartContractStateResponse)(nil), "cosmwasm.wasm.v1beta1.QuerySmartContractStateResponse")
+	proto.RegisterType((*QueryCodeRequest)(nil), "cosmwasm.wasm.v1beta1.QueryCodeRequest")
+	proto.RegisterType((*CodeInfoResponse)(nil), "cosmwasm.wasm.v1beta1.CodeInfoResponse")
+	proto.RegisterType((*QueryCodeResponse)(nil), "cosmwasm.wasm.v1beta1.QueryCodeResponse")
+	proto.RegisterType((*QueryCodesRequest)(nil), "cosmwasm.wasm.v1beta1.QueryCodesRequest")
+	proto.RegisterType((*QueryCodesResponse)(nil), "cosmwasm.wasm.v1beta1.QueryCodesResponse")
+	proto.RegisterType((*QueryCodeByChecksumRequest)(nil), "cosmwasm.wasm.v1beta1.QueryCodeByChecksumRequest")
+	proto.RegisterType((*QueryCodeByChecksumResponse)(nil), "cosmwasm.wasm.v1beta1.QueryCodeByChecksumResponse")
+	proto.RegisterType((*QueryScheduledCallbacksRequest)(nil), "cosmwasm.wasm.v1beta1.QueryScheduledCallbacksRequest")
+	proto.RegisterType((*QueryScheduledCallbacksResponse)(nil), "cosmwasm.wasm.v1beta1.QueryScheduledCallbacksResponse")
+	proto.RegisterType((*QueryAcceptedStargateQueriesRequest)(nil), "cosmwasm.wasm.v1beta1.QueryAcceptedStargateQueriesRequest")
+	proto.RegisterType((*QueryAcceptedStargateQueriesResponse)(nil), "cosmwasm.wasm.v1beta1.QueryAcceptedStargateQueriesResponse")
+	proto.RegisterType((*QueryStargateMsgsRequest)(nil), "cosmwasm.wasm.v1beta1.QueryStargateMsgsRequest")
+	proto.RegisterType((*QueryStargateMsgsResponse)(nil), "cosmwasm.wasm.v1beta1.QueryStargateMsgsResponse")
+	proto.RegisterType((*QueryContractStorageStatsRequest)(nil), "cosmwasm.wasm.v1beta1.QueryContractStorageStatsRequest")
+	proto.RegisterType((*QueryContractStorageStatsResponse)(nil), "cosmwasm.wasm.v1beta1.QueryContractStorageStatsResponse")
+}
+
+func init() { proto.RegisterFile("cosmwasm/wasm/v1beta1/query.proto", fileDescriptor_e8595715dfdf95d1) }
+
+var fileDescriptor_e8595715dfdf95d1 = []byte{
+	// 1691 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x99, 0x4b, 0x6f, 0x1c, 0xc5,
+	0x16, 0xc7, 0x5d, 0x8e, 0x5f, 0x53, 0x71, 0x1c, 0xdf, 0x92, 0xef, 0xcd, 0xa4, 0x6d, 0xcf, 0x38,
+	0xe3, 0xdc, 0x78, 0x9c, 0x28, 0xd3, 0x7e, 0xc5, 0x37, 0x37, 0xc9, 0xc6, 0xe3, 0xdc, 0x1b, 0x47,
+	0x22, 0x04, 0xc6, 0x42, 0x11, 0x64, 0x31, 0xaa, 0xe9, 0xae, 0xcc, 0x34, 0x9e, 0xe9, 0x9e, 0x74,
+	0xb5, 0xb1, 0x2d, 0xcb, 0x04, 0x21, 0x24, 0x16, 0x08, 0x01, 0x42, 0x62, 0xc3, 0x26, 0x0b, 0x24,
+	0x50, 0x78, 0xac, 0x58, 0x64, 0x85, 0x40, 0x80, 0xc8, 0x32, 0x12, 0x1b, 0x56, 0x06, 0x1c, 0x84,
+	0x50, 0x3e, 0x42, 0x36, 0xa0, 0xae, 0x3e, 0x35, 0xee, 0x9e, 0x99, 0x9e, 0x47, 0x34, 0x80, 0xb2,
+	0x71, 0xba, 0xaa, 0xeb, 0x9c, 0xfa, 0x9d, 0x7f, 0x3d, 0xfa, 0x9c, 0x0c, 0x3e, 0xa6, 0x59, 0xbc,
+	0xb4, 0x41, 0x79, 0x49, 0x15, 0x7f, 0x5e, 0x9a, 0xcd, 0x31, 0x87, 0xce, 0xaa, 0x37, 0xd7, 0x99,
+	0xbd, 0x95, 0x2a, 0xdb, 0x96, 0x63, 0x91, 0x7f, 0xca, 0x21, 0x29, 0xf1, 0x07, 0x86, 0x28, 0x23,
+	0x79, 0x2b, 0x6f, 0x89, 0x11, 0xaa, 0xfb, 0xe4, 0x0d, 0x56, 0x42, 0xfc, 0x39, 0x5b, 0x65, 0xc6,
+	0x61, 0xc8, 0x58, 0xde, 0xb2, 0xf2, 0x45, 0xa6, 0xd2, 0xb2, 0xa1, 0x52, 0xd3, 0xb4, 0x1c, 0xea,
+	0x18, 0x96, 0x29, 0xdf, 0x9e, 0x74, 0x1d, 0x58, 0x5c, 0xcd, 0x51, 0xce, 0x3c, 0x8c, 0x8a, 0x93,
+	0x32, 0xcd, 0x1b, 0xa6, 0x18, 0x0c, 0x63, 0x63, 0xfe, 0xb1, 0x72, 0x94, 0x66, 0x19, 0xf0, 0x3e,
+	0xb1, 0x80, 0xa3, 0xcf, 0xba, 0x1e, 0x96, 0x2d, 0xd3, 0xb1, 0xa9, 0xe6, 0x5c, 0x36, 0x6f, 0x58,
+	0x19, 0x76, 0x73, 0x9d, 0x71, 0x87, 0x44, 0x71, 0x3f, 0xd5, 0x75, 0x9b, 0x71, 0x1e, 0x45, 0x13,
+	0x28, 0x19, 0xc9, 0xc8, 0x66, 0xe2, 0x2d, 0x84, 0x8f, 0xd6, 0x31, 0xe3, 0x65, 0xcb, 0xe4, 0x2c,
+	0xdc, 0x8e, 0x64, 0xf0, 0x21, 0x0d, 0x2c, 0xb2, 0x86, 0x79, 0xc3, 0x8a, 0x76, 0x4f, 0xa0, 0xe4,
+	0xc1, 0xb9, 0xc9, 0x54, 0x5d, 0xfd, 0x52, 0x7e, 0xef, 0xe9, 0x81, 0xfb, 0xbb, 0x71, 0xf4, 0x70,
+	0x37, 0xde, 0x95, 0x19, 0xd4, 0x7c, 0xfd, 0xe7, 0x7a, 0x7e, 0xbb, 0x1d, 0x47, 0x89, 0x5b, 0x78,
+	0x34, 0x00, 0xb4, 0x62, 0x70, 0xc7, 0xb2, 0xb7, 0x9a, 0x86, 0x42, 0xfe, 0x8f, 0xf1, 0xbe, 0x68,
+	0xc0, 0x73, 0x22, 0xe5, 0xa9, 0x96, 0x72, 0x55, 0x4b, 0x79, 0x0b, 0x2d, 0x99, 0x9e, 0xa1, 0x79,
+	0x06, 0x5e, 0x33, 0x3e, 0xcb, 0xc4, 0x5d, 0x84, 0xc7, 0xea, 0x13, 0x80, 0x2a, 0x57, 0x71, 0x3f,
+	0x33, 0x1d, 0xdb, 0x60, 0x2e, 0xc2, 0x81, 0xe4, 0xc1, 0x39, 0xb5, 0x49, 0xd4, 0xcb, 0x96, 0xce,
+	0xc0, 0xc9, 0xff, 0x4c, 0xc7, 0xde, 0x4a, 0xf7, 0xdc, 0x73, 0xa3, 0x97, 0x5e, 0xc8, 0xa5, 0x3a,
+	0xe4, 0x53, 0x4d, 0xc9, 0x3d, 0x9a, 0x00, 0xfa, 0xcb, 0x55, 0xda, 0xf1, 0xf4, 0x96, 0x3b, 0xb7,
+	0xd4, 0xee, 0x08, 0xee, 0xd7, 0x2c, 0x9d, 0x65, 0x0d, 0x5d, 0x68, 0xd7, 0x93, 0xe9, 0x73, 0x9b,
+	0x97, 0xf5, 0x8e, 0x49, 0xf7, 0x26, 0xc2, 0x47, 0xfc, 0x4b, 0x7d, 0xcd, 0x70, 0x0a, 0x4b, 0xb0,
+	0x3c, 0x7f, 0xc7, 0x5e, 0xfa, 0xa6, 0x7a, 0x29, 0x2b, 0x82, 0xc0, 0x52, 0x5e, 0xc7, 0x43, 0x81,
+	0xa9, 0xe5, 0x8a, 0xa6, 0x5a, 0x98, 0xdb, 0x17, 0x1c, 0x2c, 0xe8, 0x21, 0x3f, 0x42, 0x07, 0x97,
+	0xf5, 0x1d, 0x84, 0x63, 0x35, 0x61, 0xd8, 0x8c, 0x3a, 0x96, 0x2d, 0x97, 0x76, 0x0a, 0x1f, 0xd6,
+	0xbc, 0x9e, 0x6c, 0x50, 0xe5, 0x21, 0xe8, 0x5e, 0xea, 0xf0, 0x29, 0xf9, 0x0e, 0xe1, 0x78, 0x28,
+	0xd3, 0x13, 0xa5, 0xee, 0x1b, 0x75, 0x36, 0xc9, 0x92, 0x5e, 0x32, 0x4c, 0xa9, 0xed, 0x24, 0x3e,
+	0x44, 0xdd, 0x76, 0x95, 0xb2, 0x83, 0xa2, 0xb3, 0xd3, 0xba, 0x7e, 0x8b, 0xf0, 0x78, 0x08, 0xcd,
+	0x13, 0xa5, 0xea, 0x2b, 0x52, 0xd5, 0xa5, 0x62, 0x51, 0x12, 0xac, 0x3a, 0xd4, 0x61, 0x7f, 0xdd,
+	0x45, 0xfe, 0x81, 0x94, 0xb2, 0x16, 0x01, 0xa4, 0x3c, 0x87, 0xfb, 0x4a, 0x96, 0xce, 0x8a, 0x52,
+	0xc2, 0xb1, 0x10, 0x09, 0xaf, 0xb8, 0x83, 0x40, 0x30, 0xb0, 0xe8, 0x9c, 0x52, 0xd7, 0x40, 0xa8,
+	0x0c, 0xdd, 0x68, 0x53, 0xa8, 0x71, 0x8c, 0xc5, 0x1c, 0x59, 0x9d, 0x3a, 0x54, 0x20, 0x0c, 0x66,
+	0x22, 0xa2, 0xe7, 0x22, 0x75, 0x68, 0x62, 0x1e, 0x8f, 0x87, 0x38, 0x86, 0xf0, 0x09, 0xee, 0x11,
+	0x96, 0x48, 0x58, 0x8a, 0xe7, 0xc4, 0xf3, 0x70, 0xd5, 0xac, 0x96, 0xa8, 0xed, 0x74, 0x96, 0x67,
+	0x15, 0xc7, 0x43, 0x5d, 0x03, 0xd1, 0x8c, 0x9f, 0x28, 0x3d, 0xf6, 0x68, 0x37, 0x1e, 0x65, 0xa6,
+	0x66, 0xe9, 0x86, 0x99, 0x57, 0x5f, 0xe4, 0x96, 0x99, 0xca, 0xd0, 0x8d, 0x2b, 0x8c, 0x73, 0x57,
+	0x4b, 0x8f, 0xf7, 0x14, 0x1e, 0x86, 0xe3, 0xd2, 0xfc, 0x3b, 0x97, 0xf8, 0x15, 0xe1, 0x61, 0x77,
+	0x60, 0x20, 0xc9, 0x99, 0xae, 0x1a, 0x9d, 0x1e, 0xde, 0xdb, 0x8d, 0xf7, 0x89, 0x61, 0x17, 0x1f,
+	0xee, 0xc6, 0xbb, 0x0d, 0xbd, 0xf2, 0x9d, 0x8c, 0xe2, 0x7e, 0xb8, 0x4e, 0x45, 0x74, 0x91, 0x8c,
+	0x6c, 0x92, 0xe7, 0x70, 0xc4, 0xc5, 0xc9, 0x16, 0x28, 0x2f, 0x44, 0x0f, 0x08, 0xfa, 0xb3, 0x8f,
+	0x76, 0xe3, 0x0b, 0x79, 0xc3, 0x29, 0xac, 0xe7, 0x52, 0x9a, 0x55, 0x52, 0x1d, 0x66, 0xea, 0xcc,
+	0x2e, 0x19, 0xa6, 0xe3, 0x7f, 0x2c, 0x1a, 0x39, 0xae, 0xe6, 0xb6, 0x1c, 0xc6, 0x53, 0x2b, 0x6c,
+	0x33, 0xed, 0x3e, 0x64, 0x06, 0x5c, 0x57, 0x2b, 0x94, 0x17, 0xc8, 0xbf, 0x70, 0x1f, 0xb7, 0xd6,
+	0x6d, 0x8d, 0x45, 0x7b, 0xc4, 0x7c, 0xd0, 0x72, 0x41, 0x72, 0xeb, 0x46, 0x51, 0x67, 0x76, 0xb4,
+	0xd7, 0x03, 0x81, 0x26, 0x7c, 0xf8, 0x5e, 0x47, 0xf8, 0x1f, 0x3e, 0x59, 0x20, 0xd2, 0xa7, 0x71,
+	0xc4, 0x8b, 0xd4, 0xfd, 0xc8, 0x22, 0xdf, 0x8e, 0xad, 0x77, 0x69, 0x04, 0x55, 0xf2, 0x7d, 0x68,
+	0x07, 0x34, 0x78, 0x47, 0xc6, 0x60, 0xb5, 0xc4, 0x4a, 0xa7, 0x07, 0x1e, 0xee, 0xc6, 0x45, 0xdb,
+	0x5b, 0x19, 0x20, 0xb9, 0xee, 0x03, 0xe1, 0x72, 0x81, 0x82, 0x27, 0x1c, 0x3d, 0xf6, 0x09, 0xff,
+	0x18, 0x61, 0xe2, 0xf7, 0x0e, 0x71, 0x3e, 0x85, 0x71, 0x25, 0x4e, 0x79, 0xb4, 0x5b, 0x0e, 0xd4,
+	0x3b, 0xe5, 0x11, 0x19, 0x64, 0x07, 0x0f, 0xfa, 0x59, 0xac, 0x54, 0x60, 0xd3, 0x5b, 0xcb, 0x05,
+	0xa6, 0xad, 0xf1, 0xf5, 0x92, 0xd4, 0x44, 0xc1, 0x03, 0x1a, 0x74, 0xc1, 0xb9, 0xaa, 0xb4, 0x13,
+	0x6b, 0x78, 0xb4, 0xae, 0xe5, 0x9f, 0x11, 0x6f, 0xe2, 0x35, 0x99, 0x6d, 0xac, 0x6a, 0x05, 0xa6,
+	0xaf, 0x17, 0x99, 0xbe, 0x4c, 0x8b, 0xc5, 0x1c, 0xd5, 0xd6, 0xb8, 0x9f, 0x15, 0xce, 0x6f, 0x85,
+	0x15, 0xda, 0x9d, 0x4c, 0xc3, 0xe3, 0xa1, 0x18, 0x95, 0xc0, 0x23, 0x9a, 0xec, 0x84, 0xb8, 0x93,
+	0x21, 0x71, 0xd7, 0x78, 0xa9, 0x04, 0x2e, 0x1d, 0x74, 0x6e, 0xa1, 0xff, 0x8d, 0x27, 0xbd, 0xef,
+	0x8e, 0xa6, 0xb1, 0xb2, 0xc3, 0xf4, 0x55, 0x87, 0xda, 0x79, 0xea, 0x30, 0xb7, 0xd3, 0xa8, 0x9c,
+	0x82, 0xc4, 0x05, 0x7c, 0xbc, 0xf1, 0x30, 0x88, 0x72, 0x04, 0xf7, 0x96, 0xa9, 0x53, 0xf0, 0x22,
+	0x8c, 0x64, 0xbc, 0x46, 0x42, 0x81, 0x7a, 0x4f, 0x5a, 0x5d, 0xe1, 0xf9, 0x8a, 0xe7, 0xab, 0xf8,
+	0x68, 0x9d, 0x77, 0xe0, 0x4e, 0xc1, 0x03, 0x14, 0x66, 0x04, 0x8f, 0x95, 0xb6, 0x7b, 0xdf, 0xe8,
+	0xcc, 0x34, 0x98, 0x1e, 0xed, 0x16, 0x6f, 0xa0, 0x95, 0xb8, 0x80, 0x27, 0x02, 0x49, 0xc9, 0xaa,
+	0x63, 0xd9, 0x34, 0xcf, 0xdc, 0xcb, 0x9b, 0x37, 0x2f, 0x32, 0x7f, 0x47, 0xf8, 0x58, 0x03, 0x73,
+	0xe0, 0xba, 0x84, 0x7b, 0xb9, 0xdb, 0x01, 0xf7, 0xc1, 0xa9, 0x26, 0xe9, 0x8c, 0xdf, 0x07, 0xac,
+	0xa5, 0x67, 0x4f, 0x46, 0x71, 0xa4, 0x44, 0x37, 0xb3, 0xe2, 0x52, 0x15, 0xcb, 0xd8, 0x93, 0x19,
+	0x28, 0x51, 0xef, 0x6e, 0x25, 0x0e, 0x3e, 0xcc, 0x3d, 0xcb, 0xac, 0xce, 0xca, 0x16, 0x37, 0x9c,
+	0xe8, 0x01, 0xb1, 0x71, 0x8e, 0x06, 0x56, 0x7a, 0x7f, 0x36, 0xc3, 0x4c, 0xcf, 0xb8, 0xde, 0xef,
+	0xfc, 0x18, 0x4f, 0xfa, 0x6e, 0x73, 0x6f, 0x30, 0xfc, 0x73, 0x9a, 0xeb, 0x6b, 0x50, 0xf6, 0xbb,
+	0x06, 0x3c, 0x33, 0x04, 0x73, 0x5c, 0xf4, 0xa6, 0x98, 0xbb, 0x3d, 0x82, 0x7b, 0x85, 0x02, 0xe4,
+	0x7d, 0x84, 0x07, 0xfd, 0x19, 0x19, 0x09, 0x2b, 0x1e, 0xc3, 0x8a, 0x79, 0x65, 0xa6, 0x75, 0x03,
+	0x4f, 0xd9, 0x44, 0xf2, 0xd5, 0xef, 0x7f, 0x79, 0xb7, 0x3b, 0x41, 0x26, 0x82, 0xff, 0x4f, 0x21,
+	0x8f, 0xac, 0xba, 0x0d, 0x0b, 0xb5, 0x43, 0x3e, 0x41, 0xf8, 0x70, 0x55, 0xd9, 0x4b, 0xe6, 0x5a,
+	0x99, 0x2f, 0x58, 0xa5, 0x2b, 0xf3, 0x6d, 0xd9, 0x00, 0xe6, 0x8c, 0xc0, 0x3c, 0x49, 0x92, 0xcd,
+	0x30, 0xd5, 0x02, 0xa0, 0xdd, 0xf1, 0xe1, 0x42, 0x69, 0xd7, 0x1a, 0x6e, 0xb0, 0x30, 0x56, 0xe6,
+	0xdb, 0xb2, 0x01, 0xdc, 0x94, 0xc0, 0x4d, 0x92, 0x13, 0xd5, 0xb8, 0x3a, 0x53, 0xb7, 0x21, 0xa3,
+	0xd8, 0xa9, 0xd0, 0x73, 0xf2, 0x05, 0xc2, 0xa4, 0xb6, 0x58, 0x22, 0x67, 0x5a, 0x9d, 0x3b, 0x50,
+	0xf0, 0x29, 0x8b, 0xed, 0x9a, 0x01, 0xf5, 0x7f, 0x05, 0xf5, 0x3c, 0x99, 0xad, 0x2f, 0x32, 0x57,
+	0x21, 0xa3, 0x51, 0xb7, 0xab, 0xea, 0xc9, 0x1d, 0xf2, 0xb9, 0xc8, 0x9e, 0x82, 0x55, 0x09, 0x69,
+	0x55, 0x3a, 0x7f, 0x45, 0xa5, 0x2c, 0xb4, 0x67, 0x04, 0xe8, 0x67, 0x04, 0xba, 0x4a, 0x4e, 0x87,
+	0xa1, 0x8b, 0x82, 0x4c, 0xdd, 0x0e, 0x14, 0x6b, 0x3b, 0xe4, 0x53, 0x84, 0x87, 0xab, 0x2b, 0x80,
+	0xc6, 0xd8, 0x21, 0x25, 0x8b, 0xb2, 0xd0, 0x9e, 0x51, 0xb3, 0x7d, 0x52, 0xb3, 0xad, 0xb9, 0x40,
+	0xbb, 0x8b, 0xf0, 0x70, 0x75, 0xca, 0xde, 0x98, 0x37, 0xa4, 0x72, 0x50, 0x16, 0xda, 0x33, 0x6a,
+	0x6d, 0x87, 0xf8, 0x78, 0x6d, 0xba, 0xa1, 0x6e, 0xef, 0x67, 0xfc, 0x3b, 0xe4, 0x4b, 0x84, 0x49,
+	0x6d, 0x76, 0xdf, 0x78, 0x8b, 0x87, 0x16, 0x1a, 0xca, 0x62, 0xbb, 0x66, 0x10, 0xc0, 0x79, 0x11,
+	0xc0, 0x19, 0x32, 0xdf, 0x5c, 0x70, 0xd7, 0x49, 0x30, 0x84, 0x5b, 0xb8, 0x47, 0x5c, 0x23, 0x53,
+	0x8d, 0xb7, 0xe8, 0xfe, 0xdd, 0x91, 0x6c, 0x3e, 0x10, 0xb8, 0x8e, 0x0b, 0xae, 0x18, 0x19, 0x6b,
+	0x74, 0x61, 0x90, 0x4d, 0xdc, 0xeb, 0x5a, 0x71, 0xd2, 0xd4, 0xb1, 0xfc, 0xf2, 0x2a, 0xd3, 0x2d,
+	0x8c, 0x04, 0x06, 0x45, 0x30, 0x8c, 0x10, 0x52, 0xcb, 0x40, 0x3e, 0x44, 0x78, 0x28, 0x98, 0x61,
+	0x92, 0xd9, 0x66, 0x9e, 0x6b, 0xf2, 0x58, 0x65, 0xae, 0x1d, 0x93, 0x16, 0xae, 0x52, 0x99, 0x04,
+	0xab, 0xdb, 0xf2, 0x69, 0x87, 0x7c, 0xe6, 0xee, 0xb3, 0x9a, 0xb4, 0xb0, 0xc9, 0x3e, 0x0b, 0xcb,
+	0x66, 0x95, 0xc5, 0x76, 0xcd, 0x80, 0x7a, 0x5a, 0x50, 0x4f, 0x92, 0x63, 0x41, 0x6a, 0x2e, 0x2d,
+	0xb2, 0xfb, 0xa9, 0xe5, 0xd7, 0x08, 0x1f, 0x09, 0x49, 0xf3, 0xc8, 0xb9, 0x86, 0xb7, 0x4a, 0xc3,
+	0x14, 0x52, 0x39, 0xff, 0x58, 0xb6, 0xc0, 0xaf, 0x0a, 0xfe, 0x69, 0x32, 0x15, 0xe4, 0x97, 0xc9,
+	0x60, 0x96, 0x83, 0x5d, 0xf6, 0x26, 0x90, 0xbe, 0x87, 0xf0, 0xa0, 0x3f, 0xa5, 0x6c, 0x9c, 0xbb,
+	0xd4, 0x49, 0x4c, 0x95, 0x99, 0xd6, 0x0d, 0x00, 0x72, 0x52, 0x40, 0x8e, 0x93, 0xd1, 0x2a, 0x91,
+	0x25, 0x5b, 0xc9, 0xe5, 0xf8, 0x0a, 0xe1, 0x91, 0x7a, 0x79, 0x21, 0xf9, 0x4f, 0x2b, 0x1f, 0x9a,
+	0x3a, 0xc9, 0xac, 0x72, 0xb6, 0x7d, 0x43, 0x00, 0x5e, 0x14, 0xc0, 0x33, 0x24, 0xd5, 0xc2, 0x75,
+	0xef, 0xe5, 0xa1, 0x22, 0x6b, 0x4d, 0xaf, 0xdc, 0xfb, 0x39, 0xd6, 0xf5, 0xd1, 0x5e, 0xac, 0xeb,
+	0xde, 0x5e, 0x0c, 0xdd, 0xdf, 0x8b, 0xa1, 0x9f, 0xf6, 0x62, 0xe8, 0xed, 0x07, 0xb1, 0xae, 0xfb,
+	0x0f, 0x62, 0x5d, 0x3f, 0x3c, 0x88, 0x75, 0xbd, 0x70, 0xc2, 0x97, 0x7e, 0x2e, 0x5b, 0xbc, 0x74,
+	0x4d, 0xfe, 0xf2, 0xa4, 0xab, 0x9b, 0xde, 0x64, 0x22, 0x05, 0xcd, 0xf5, 0x89, 0x1f, 0x84, 0xe6,
+	0xff, 0x18, 0x00, 0xdb, 0xb2, 0xca, 0xf5, 0xef, 0x1a, 0x00, 0x00,
+}
+
+func (this *QueryContractInfoResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueryContractInfoResponse)
+	if !ok {
+		that2, ok := that.(QueryContractInfoResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if !this.ContractInfo.Equal(that1.ContractInfo) {
+		return false
+	}
+	return true
+}
+func (this *ContractInfoWithAddress) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ContractInfoWithAddress)
+	if !ok {
+		that2, ok := that.(ContractInfoWithAddress)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if !this.ContractInfo.Equal(that1.ContractInfo) {
+		return false
+	}
+	return true
+}
+func (this *CodeInfoResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
 	that1, ok := that.(*CodeInfoResponse)
 	if !ok {
@@ -996,6 +1618,11 @@ type QueryClient interface {
 	ContractHistory(ctx context.Context, in *QueryContractHistoryRequest, opts ...grpc.CallOption) (*QueryContractHistoryResponse, error)
 	// ContractsByCode lists all smart contracts for a code id
 	ContractsByCode(ctx context.Context, in *QueryContractsByCodeRequest, opts ...grpc.CallOption) (*QueryContractsByCodeResponse, error)
+	// ContractsByCreator lists all smart contracts that were instantiated by an
+	// address
+	ContractsByCreator(ctx context.Context, in *QueryContractsByCreatorRequest, opts ...grpc.CallOption) (*QueryContractsByCreatorResponse, error)
+	// ContractsByAdmin lists all smart contracts that an address is the admin of
+	ContractsByAdmin(ctx context.Context, in *QueryContractsByAdminRequest, opts ...grpc.CallOption) (*QueryContractsByAdminResponse, error)
 	// AllContractState gets all raw store data for a single contract
 	AllContractState(ctx context.Context, in *QueryAllContractStateRequest, opts ...grpc.CallOption) (*QueryAllContractStateResponse, error)
 	// RawContractState gets single key from the raw store data of a contract
@@ -1006,6 +1633,17 @@ type QueryClient interface {
 	Code(ctx context.Context, in *QueryCodeRequest, opts ...grpc.CallOption) (*QueryCodeResponse, error)
 	// Codes gets the metadata for all stored wasm codes
 	Codes(ctx context.Context, in *QueryCodesRequest, opts ...grpc.CallOption) (*QueryCodesResponse, error)
+	// CodeByChecksum gets the metadata of all codes with the checksum
+	CodeByChecksum(ctx context.Context, in *QueryCodeByChecksumRequest, opts ...grpc.CallOption) (*QueryCodeByChecksumResponse, error)
+	// ScheduledCallbacks lists all pending scheduled callbacks
+	ScheduledCallbacks(ctx context.Context, in *QueryScheduledCallbacksRequest, opts ...grpc.CallOption) (*QueryScheduledCallbacksResponse, error)
+	// AcceptedStargateQueries lists the gRPC query paths that contracts can call
+	AcceptedStargateQueries(ctx context.Context, in *QueryAcceptedStargateQueriesRequest, opts ...grpc.CallOption) (*QueryAcceptedStargateQueriesResponse, error)
+	// StargateMsgs lists the message type URLs that contracts can or can not
+	// dispatch
+	StargateMsgs(ctx context.Context, in *QueryStargateMsgsRequest, opts ...grpc.CallOption) (*QueryStargateMsgsResponse, error)
+	// ContractStorageStats gets the storage usage and limit of a contract
+	ContractStorageStats(ctx context.Context, in *QueryContractStorageStatsRequest, opts ...grpc.CallOption) (*QueryContractStorageStatsResponse, error)
 }
 
 type queryClient struct {
@@ -1043,6 +1681,24 @@ func (c *queryClient) ContractsByCode(ctx context.Context, in *QueryContractsByC
 	return out, nil
 }
 
+func (c *queryClient) ContractsByCreator(ctx context.Context, in *QueryContractsByCreatorRequest, opts ...grpc.CallOption) (*QueryContractsByCreatorResponse, error) {
+	out := new(QueryContractsByCreatorResponse)
+	err := c.cc.Invoke(ctx, "/cosmwasm.wasm.v1beta1.Query/ContractsByCreator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContractsByAdmin(ctx context.Context, in *QueryContractsByAdminRequest, opts ...grpc.CallOption) (*QueryContractsByAdminResponse, error) {
+	out := new(QueryContractsByAdminResponse)
+	err := c.cc.Invoke(ctx, "/cosmwasm.wasm.v1beta1.Query/ContractsByAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) AllContractState(ctx context.Context, in *QueryAllContractStateRequest, opts ...grpc.CallOption) (*QueryAllContractStateResponse, error) {
 	out := new(QueryAllContractStateResponse)
 	err := c.cc.Invoke(ctx, "/cosmwasm.wasm.v1beta1.Query/AllContractState", in, out, opts...)
@@ -1088,28 +1744,89 @@ func (c *queryClient) Codes(ctx context.Context, in *QueryCodesRequest, opts ...
 	return out, nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// ContractInfo gets the contract meta data
-	ContractInfo(context.Context, *QueryContractInfoRequest) (*QueryContractInfoResponse, error)
-	// ContractHistory gets the contract code history
-	ContractHistory(context.Context, *QueryContractHistoryRequest) (*QueryContractHistoryResponse, error)
-	// ContractsByCode lists all smart contracts for a code id
-	ContractsByCode(context.Context, *QueryContractsByCodeRequest) (*QueryContractsByCodeResponse, error)
-	// AllContractState gets all raw store data for a single contract
-	AllContractState(context.Context, *QueryAllContractStateRequest) (*QueryAllContractStateResponse, error)
-	// RawContractState gets single key from the raw store data of a contract
-	RawContractState(context.Context, *QueryRawContractStateRequest) (*QueryRawContractStateResponse, error)
-	// SmartContractState get smart query result from the contract
-	SmartContractState(context.Context, *QuerySmartContractStateRequest) (*QuerySmartContractStateResponse, error)
-	// Code gets the binary code and metadata for a singe wasm code
-	Code(context.Context, *QueryCodeRequest) (*QueryCodeResponse, error)
-	// Codes gets the metadata for all stored wasm codes
-	Codes(context.Context, *QueryCodesRequest) (*QueryCodesResponse, error)
+func (c *queryClient) CodeByChecksum(ctx context.Context, in *QueryCodeByChecksumRequest, opts ...grpc.CallOption) (*QueryCodeByChecksumResponse, error) {
+	out := new(QueryCodeByChecksumResponse)
+	err := c.cc.Invoke(ctx, "/cosmwasm.wasm.v1beta1.Query/CodeByChecksum", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (c *queryClient) ScheduledCallbacks(ctx context.Context, in *QueryScheduledCallbacksRequest, opts ...grpc.CallOption) (*QueryScheduledCallbacksResponse, error) {
+	out := new(QueryScheduledCallbacksResponse)
+	err := c.cc.Invoke(ctx, "/cosmwasm.wasm.v1beta1.Query/ScheduledCallbacks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AcceptedStargateQueries(ctx context.Context, in *QueryAcceptedStargateQueriesRequest, opts ...grpc.CallOption) (*QueryAcceptedStargateQueriesResponse, error) {
+	out := new(QueryAcceptedStargateQueriesResponse)
+	err := c.cc.Invoke(ctx, "/cosmwasm.wasm.v1beta1.Query/AcceptedStargateQueries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StargateMsgs(ctx context.Context, in *QueryStargateMsgsRequest, opts ...grpc.CallOption) (*QueryStargateMsgsResponse, error) {
+	out := new(QueryStargateMsgsResponse)
+	err := c.cc.Invoke(ctx, "/cosmwasm.wasm.v1beta1.Query/StargateMsgs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContractStorageStats(ctx context.Context, in *QueryContractStorageStatsRequest, opts ...grpc.CallOption) (*QueryContractStorageStatsResponse, error) {
+	out := new(QueryContractStorageStatsResponse)
+	err := c.cc.Invoke(ctx, "/cosmwasm.wasm.v1beta1.Query/ContractStorageStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// ContractInfo gets the contract meta data
+	ContractInfo(context.Context, *QueryContractInfoRequest) (*QueryContractInfoResponse, error)
+	// ContractHistory gets the contract code history
+	ContractHistory(context.Context, *QueryContractHistoryRequest) (*QueryContractHistoryResponse, error)
+	// ContractsByCode lists all smart contracts for a code id
+	ContractsByCode(context.Context, *QueryContractsByCodeRequest) (*QueryContractsByCodeResponse, error)
+	// ContractsByCreator lists all smart contracts that were instantiated by an
+	// address
+	ContractsByCreator(context.Context, *QueryContractsByCreatorRequest) (*QueryContractsByCreatorResponse, error)
+	// ContractsByAdmin lists all smart contracts that an address is the admin of
+	ContractsByAdmin(context.Context, *QueryContractsByAdminRequest) (*QueryContractsByAdminResponse, error)
+	// AllContractState gets all raw store data for a single contract
+	AllContractState(context.Context, *QueryAllContractStateRequest) (*QueryAllContractStateResponse, error)
+	// RawContractState gets single key from the raw store data of a contract
+	RawContractState(context.Context, *QueryRawContractStateRequest) (*QueryRawContractStateResponse, error)
+	// SmartContractState get smart query result from the contract
+	SmartContractState(context.Context, *QuerySmartContractStateRequest) (*QuerySmartContractStateResponse, error)
+	// Code gets the binary code and metadata for a singe wasm code
+	Code(context.Context, *QueryCodeRequest) (*QueryCodeResponse, error)
+	// Codes gets the metadata for all stored wasm codes
+	Codes(context.Context, *QueryCodesRequest) (*QueryCodesResponse, error)
+	// CodeByChecksum gets the metadata of all codes with the checksum
+	CodeByChecksum(context.Context, *QueryCodeByChecksumRequest) (*QueryCodeByChecksumResponse, error)
+	// ScheduledCallbacks lists all pending scheduled callbacks
+	ScheduledCallbacks(context.Context, *QueryScheduledCallbacksRequest) (*QueryScheduledCallbacksResponse, error)
+	// AcceptedStargateQueries lists the gRPC query paths that contracts can call
+	AcceptedStargateQueries(context.Context, *QueryAcceptedStargateQueriesRequest) (*QueryAcceptedStargateQueriesResponse, error)
+	// StargateMsgs lists the message type URLs that contracts can or can not
+	// dispatch
+	StargateMsgs(context.Context, *QueryStargateMsgsRequest) (*QueryStargateMsgsResponse, error)
+	// ContractStorageStats gets the storage usage and limit of a contract
+	ContractStorageStats(context.Context, *QueryContractStorageStatsRequest) (*QueryContractStorageStatsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
 func (*UnimplementedQueryServer) ContractInfo(ctx context.Context, req *QueryContractInfoRequest) (*QueryContractInfoResponse, error) {
@@ -1121,6 +1838,12 @@ func (*UnimplementedQueryServer) ContractHistory(ctx context.Context, req *Query
 func (*UnimplementedQueryServer) ContractsByCode(ctx context.Context, req *QueryContractsByCodeRequest) (*QueryContractsByCodeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ContractsByCode not implemented")
 }
+func (*UnimplementedQueryServer) ContractsByCreator(ctx context.Context, req *QueryContractsByCreatorRequest) (*QueryContractsByCreatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractsByCreator not implemented")
+}
+func (*UnimplementedQueryServer) ContractsByAdmin(ctx context.Context, req *QueryContractsByAdminRequest) (*QueryContractsByAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractsByAdmin not implemented")
+}
 func (*UnimplementedQueryServer) AllContractState(ctx context.Context, req *QueryAllContractStateRequest) (*QueryAllContractStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllContractState not implemented")
 }
@@ -1136,6 +1859,21 @@ func (*UnimplementedQueryServer) Code(ctx context.Context, req *QueryCodeRequest
 func (*UnimplementedQueryServer) Codes(ctx context.Context, req *QueryCodesRequest) (*QueryCodesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Codes not implemented")
 }
+func (*UnimplementedQueryServer) CodeByChecksum(ctx context.Context, req *QueryCodeByChecksumRequest) (*QueryCodeByChecksumResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CodeByChecksum not implemented")
+}
+func (*UnimplementedQueryServer) ScheduledCallbacks(ctx context.Context, req *QueryScheduledCallbacksRequest) (*QueryScheduledCallbacksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledCallbacks not implemented")
+}
+func (*UnimplementedQueryServer) AcceptedStargateQueries(ctx context.Context, req *QueryAcceptedStargateQueriesRequest) (*QueryAcceptedStargateQueriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptedStargateQueries not implemented")
+}
+func (*UnimplementedQueryServer) StargateMsgs(ctx context.Context, req *QueryStargateMsgsRequest) (*QueryStargateMsgsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StargateMsgs not implemented")
+}
+func (*UnimplementedQueryServer) ContractStorageStats(ctx context.Context, req *QueryContractStorageStatsRequest) (*QueryContractStorageStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractStorageStats not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1195,6 +1933,42 @@ func _Query_ContractsByCode_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ContractsByCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractsByCreatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractsByCreator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmwasm.wasm.v1beta1.Query/ContractsByCreator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractsByCreator(ctx, req.(*QueryContractsByCreatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractsByAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractsByAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractsByAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmwasm.wasm.v1beta1.Query/ContractsByAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractsByAdmin(ctx, req.(*QueryContractsByAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_AllContractState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryAllContractStateRequest)
 	if err := dec(in); err != nil {
@@ -1285,6 +2059,96 @@ func _Query_Codes_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CodeByChecksum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCodeByChecksumRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CodeByChecksum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmwasm.wasm.v1beta1.Query/CodeByChecksum",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CodeByChecksum(ctx, req.(*QueryCodeByChecksumRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledCallbacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledCallbacksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledCallbacks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmwasm.wasm.v1beta1.Query/ScheduledCallbacks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledCallbacks(ctx, req.(*QueryScheduledCallbacksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AcceptedStargateQueries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAcceptedStargateQueriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AcceptedStargateQueries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmwasm.wasm.v1beta1.Query/AcceptedStargateQueries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AcceptedStargateQueries(ctx, req.(*QueryAcceptedStargateQueriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StargateMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStargateMsgsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StargateMsgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmwasm.wasm.v1beta1.Query/StargateMsgs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StargateMsgs(ctx, req.(*QueryStargateMsgsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractStorageStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractStorageStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractStorageStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmwasm.wasm.v1beta1.Query/ContractStorageStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractStorageStats(ctx, req.(*QueryContractStorageStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmwasm.wasm.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1301,6 +2165,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ContractsByCode",
 			Handler:    _Query_ContractsByCode_Handler,
 		},
+		{
+			MethodName: "ContractsByCreator",
+			Handler:    _Query_ContractsByCreator_Handler,
+		},
+		{
+			MethodName: "ContractsByAdmin",
+			Handler:    _Query_ContractsByAdmin_Handler,
+		},
 		{
 			MethodName: "AllContractState",
 			Handler:    _Query_AllContractState_Handler,
@@ -1321,6 +2193,26 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Codes",
 			Handler:    _Query_Codes_Handler,
 		},
+		{
+			MethodName: "CodeByChecksum",
+			Handler:    _Query_CodeByChecksum_Handler,
+		},
+		{
+			MethodName: "ScheduledCallbacks",
+			Handler:    _Query_ScheduledCallbacks_Handler,
+		},
+		{
+			MethodName: "AcceptedStargateQueries",
+			Handler:    _Query_AcceptedStargateQueries_Handler,
+		},
+		{
+			MethodName: "StargateMsgs",
+			Handler:    _Query_StargateMsgs_Handler,
+		},
+		{
+			MethodName: "ContractStorageStats",
+			Handler:    _Query_ContractStorageStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmwasm/wasm/v1beta1/query.proto",
@@ -1620,7 +2512,7 @@ func (m *QueryContractsByCodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllContractStateRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryContractsByCreatorRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1630,12 +2522,12 @@ func (m *QueryAllContractStateRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllContractStateRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryContractsByCreatorRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllContractStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryContractsByCreatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1652,17 +2544,17 @@ func (m *QueryAllContractStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+	if len(m.CreatorAddress) > 0 {
+		i -= len(m.CreatorAddress)
+		copy(dAtA[i:], m.CreatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CreatorAddress)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllContractStateResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryContractsByCreatorResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1672,12 +2564,12 @@ func (m *QueryAllContractStateResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllContractStateResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryContractsByCreatorResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllContractStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryContractsByCreatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1694,10 +2586,10 @@ func (m *QueryAllContractStateResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Models) > 0 {
-		for iNdEx := len(m.Models) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ContractInfos) > 0 {
+		for iNdEx := len(m.ContractInfos) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Models[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ContractInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1711,7 +2603,7 @@ func (m *QueryAllContractStateResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryRawContractStateRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryContractsByAdminRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1721,34 +2613,39 @@ func (m *QueryRawContractStateRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryRawContractStateRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryContractsByAdminRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRawContractStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryContractsByAdminRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.QueryData) > 0 {
-		i -= len(m.QueryData)
-		copy(dAtA[i:], m.QueryData)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.QueryData)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+	if len(m.AdminAddress) > 0 {
+		i -= len(m.AdminAddress)
+		copy(dAtA[i:], m.AdminAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AdminAddress)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryRawContractStateResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryContractsByAdminResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1758,27 +2655,46 @@ func (m *QueryRawContractStateResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryRawContractStateResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryContractsByAdminResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRawContractStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryContractsByAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractInfos) > 0 {
+		for iNdEx := len(m.ContractInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySmartContractStateRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllContractStateRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1788,20 +2704,25 @@ func (m *QuerySmartContractStateRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySmartContractStateRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllContractStateRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySmartContractStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllContractStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.QueryData) > 0 {
-		i -= len(m.QueryData)
-		copy(dAtA[i:], m.QueryData)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.QueryData)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1815,7 +2736,7 @@ func (m *QuerySmartContractStateRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySmartContractStateResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllContractStateResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1825,21 +2746,174 @@ func (m *QuerySmartContractStateResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySmartContractStateResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllContractStateResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySmartContractStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllContractStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
-		i--
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Models) > 0 {
+		for iNdEx := len(m.Models) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Models[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRawContractStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRawContractStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRawContractStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.QueryData) > 0 {
+		i -= len(m.QueryData)
+		copy(dAtA[i:], m.QueryData)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QueryData)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRawContractStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRawContractStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRawContractStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySmartContractStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySmartContractStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySmartContractStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.QueryData) > 0 {
+		i -= len(m.QueryData)
+		copy(dAtA[i:], m.QueryData)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QueryData)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySmartContractStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySmartContractStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySmartContractStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
@@ -2055,294 +3129,576 @@ func (m *QueryCodesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryCodeByChecksumRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryContractInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QueryCodeByChecksumRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryContractInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryCodeByChecksumRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.ContractInfo != nil {
-		l = m.ContractInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryContractHistoryRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryCodeByChecksumResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryContractHistoryResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryCodeByChecksumResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCodeByChecksumResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Entries) > 0 {
-		for _, e := range m.Entries {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.CodeInfos) > 0 {
+		for iNdEx := len(m.CodeInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CodeInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryContractsByCodeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CodeId != 0 {
-		n += 1 + sovQuery(uint64(m.CodeId))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryScheduledCallbacksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ContractInfoWithAddress) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryScheduledCallbacksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledCallbacksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.ContractInfo != nil {
-		l = m.ContractInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryContractsByCodeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryScheduledCallbacksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledCallbacksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledCallbacksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ContractInfos) > 0 {
-		for _, e := range m.ContractInfos {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Callbacks) > 0 {
+		for iNdEx := len(m.Callbacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Callbacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllContractStateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAcceptedStargateQueriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAcceptedStargateQueriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAcceptedStargateQueriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllContractStateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAcceptedStargateQueriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAcceptedStargateQueriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAcceptedStargateQueriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Models) > 0 {
-		for _, e := range m.Models {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Paths) > 0 {
+		for iNdEx := len(m.Paths) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Paths[iNdEx])
+			copy(dAtA[i:], m.Paths[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Paths[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRawContractStateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.QueryData)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryStargateMsgsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryRawContractStateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryStargateMsgsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStargateMsgsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySmartContractStateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryStargateMsgsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStargateMsgsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStargateMsgsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Denied) > 0 {
+		for iNdEx := len(m.Denied) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denied[iNdEx])
+			copy(dAtA[i:], m.Denied[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Denied[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = len(m.QueryData)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Accepted) > 0 {
+		for iNdEx := len(m.Accepted) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Accepted[iNdEx])
+			copy(dAtA[i:], m.Accepted[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Accepted[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySmartContractStateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryContractStorageStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryCodeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryContractStorageStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractStorageStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.CodeId != 0 {
-		n += 1 + sovQuery(uint64(m.CodeId))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CodeInfoResponse) Size() (n int) {
-	if m == nil {
+func (m *QueryContractStorageStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractStorageStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractStorageStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StorageDeposit) > 0 {
+		for iNdEx := len(m.StorageDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StorageDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.MaxBytes != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxBytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryContractInfoRequest) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.CodeID != 0 {
-		n += 1 + sovQuery(uint64(m.CodeID))
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
 	}
-	l = len(m.Creator)
+	return n
+}
+
+func (m *QueryContractInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	l = len(m.DataHash)
+	if m.ContractInfo != nil {
+		l = m.ContractInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	l = len(m.Source)
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractsByCodeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeId != 0 {
+		n += 1 + sovQuery(uint64(m.CodeId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractInfoWithAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	l = len(m.Builder)
+	if m.ContractInfo != nil {
+		l = m.ContractInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractsByCodeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ContractInfos) > 0 {
+		for _, e := range m.ContractInfos {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractsByCreatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CreatorAddress)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryCodeResponse) Size() (n int) {
+func (m *QueryContractsByCreatorResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.CodeInfoResponse != nil {
-		l = m.CodeInfoResponse.Size()
+	if len(m.ContractInfos) > 0 {
+		for _, e := range m.ContractInfos {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	l = len(m.Data)
+	return n
+}
+
+func (m *QueryContractsByAdminRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AdminAddress)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryCodesRequest) Size() (n int) {
+func (m *QueryContractsByAdminResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ContractInfos) > 0 {
+		for _, e := range m.ContractInfos {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllContractStateRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	if m.Pagination != nil {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
@@ -2350,14 +3706,14 @@ func (m *QueryCodesRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryCodesResponse) Size() (n int) {
+func (m *QueryAllContractStateResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.CodeInfos) > 0 {
-		for _, e := range m.CodeInfos {
+	if len(m.Models) > 0 {
+		for _, e := range m.Models {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
@@ -2369,13 +3725,1924 @@ func (m *QueryCodesResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryRawContractStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QueryData)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryRawContractStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySmartContractStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QueryData)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySmartContractStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCodeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeId != 0 {
+		n += 1 + sovQuery(uint64(m.CodeId))
+	}
+	return n
+}
+
+func (m *CodeInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeID != 0 {
+		n += 1 + sovQuery(uint64(m.CodeID))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DataHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Builder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCodeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeInfoResponse != nil {
+		l = m.CodeInfoResponse.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCodesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCodesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CodeInfos) > 0 {
+		for _, e := range m.CodeInfos {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCodeByChecksumRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCodeByChecksumResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CodeInfos) > 0 {
+		for _, e := range m.CodeInfos {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryScheduledCallbacksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScheduledCallbacksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Callbacks) > 0 {
+		for _, e := range m.Callbacks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAcceptedStargateQueriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAcceptedStargateQueriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Paths) > 0 {
+		for _, s := range m.Paths {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryStargateMsgsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryStargateMsgsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accepted) > 0 {
+		for _, s := range m.Accepted {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Denied) > 0 {
+		for _, s := range m.Denied {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryContractStorageStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractStorageStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.MaxBytes != 0 {
+		n += 1 + sovQuery(uint64(m.MaxBytes))
+	}
+	if len(m.StorageDeposit) > 0 {
+		for _, e := range m.StorageDeposit {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryContractInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ContractInfo == nil {
+				m.ContractInfo = &ContractInfo{}
+			}
+			if err := m.ContractInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, ContractCodeHistoryEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractsByCodeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractsByCodeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractsByCodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeId", wireType)
+			}
+			m.CodeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractInfoWithAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractInfoWithAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractInfoWithAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ContractInfo == nil {
+				m.ContractInfo = &ContractInfo{}
+			}
+			if err := m.ContractInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractsByCodeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractsByCodeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractsByCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractInfos = append(m.ContractInfos, ContractInfoWithAddress{})
+			if err := m.ContractInfos[len(m.ContractInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractsByCreatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractsByCreatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractsByCreatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractsByCreatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractsByCreatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractsByCreatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractInfos = append(m.ContractInfos, ContractInfoWithAddress{})
+			if err := m.ContractInfos[len(m.ContractInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractsByAdminRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractsByAdminRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractsByAdminRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdminAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractsByAdminResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractsByAdminResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractsByAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractInfos = append(m.ContractInfos, ContractInfoWithAddress{})
+			if err := m.ContractInfos[len(m.ContractInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllContractStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllContractStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllContractStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllContractStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllContractStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllContractStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Models", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Models = append(m.Models, Model{})
+			if err := m.Models[len(m.Models)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRawContractStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRawContractStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRawContractStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryData = append(m.QueryData[:0], dAtA[iNdEx:postIndex]...)
+			if m.QueryData == nil {
+				m.QueryData = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryContractInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRawContractStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2398,17 +5665,17 @@ func (m *QueryContractInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryContractInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRawContractStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryContractInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRawContractStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2418,23 +5685,25 @@ func (m *QueryContractInfoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2457,7 +5726,7 @@ func (m *QueryContractInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryContractInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySmartContractStateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2480,10 +5749,10 @@ func (m *QueryContractInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryContractInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySmartContractStateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryContractInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySmartContractStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2520,9 +5789,9 @@ func (m *QueryContractInfoResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryData", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2532,26 +5801,24 @@ func (m *QueryContractInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ContractInfo == nil {
-				m.ContractInfo = &ContractInfo{}
-			}
-			if err := m.ContractInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.QueryData = append(m.QueryData[:0], dAtA[iNdEx:postIndex]...)
+			if m.QueryData == nil {
+				m.QueryData = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -2575,7 +5842,7 @@ func (m *QueryContractInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryContractHistoryRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySmartContractStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2598,49 +5865,17 @@ func (m *QueryContractHistoryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryContractHistoryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySmartContractStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryContractHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySmartContractStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2650,26 +5885,24 @@ func (m *QueryContractHistoryRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -2693,7 +5926,7 @@ func (m *QueryContractHistoryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryContractHistoryResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2716,51 +5949,17 @@ func (m *QueryContractHistoryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryContractHistoryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCodeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryContractHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Entries = append(m.Entries, ContractCodeHistoryEntry{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeId", wireType)
 			}
-			var msglen int
+			m.CodeId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2770,28 +5969,11 @@ func (m *QueryContractHistoryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.CodeId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2813,7 +5995,7 @@ func (m *QueryContractHistoryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryContractsByCodeRequest) Unmarshal(dAtA []byte) error {
+func (m *CodeInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2836,17 +6018,17 @@ func (m *QueryContractsByCodeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryContractsByCodeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CodeInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryContractsByCodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CodeInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
 			}
-			m.CodeId = 0
+			m.CodeID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2856,16 +6038,16 @@ func (m *QueryContractsByCodeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CodeId |= uint64(b&0x7F) << shift
+				m.CodeID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2875,81 +6057,61 @@ func (m *QueryContractsByCodeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataHash", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ContractInfoWithAddress) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ContractInfoWithAddress: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractInfoWithAddress: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.DataHash = append(m.DataHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.DataHash == nil {
+				m.DataHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2977,13 +6139,13 @@ func (m *ContractInfoWithAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Builder", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2993,27 +6155,23 @@ func (m *ContractInfoWithAddress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ContractInfo == nil {
-				m.ContractInfo = &ContractInfo{}
-			}
-			if err := m.ContractInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Builder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3036,7 +6194,7 @@ func (m *ContractInfoWithAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryContractsByCodeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3059,15 +6217,15 @@ func (m *QueryContractsByCodeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryContractsByCodeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCodeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryContractsByCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractInfos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeInfoResponse", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3094,16 +6252,18 @@ func (m *QueryContractsByCodeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractInfos = append(m.ContractInfos, ContractInfoWithAddress{})
-			if err := m.ContractInfos[len(m.ContractInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.CodeInfoResponse == nil {
+				m.CodeInfoResponse = &CodeInfoResponse{}
+			}
+			if err := m.CodeInfoResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3113,26 +6273,24 @@ func (m *QueryContractsByCodeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -3156,7 +6314,7 @@ func (m *QueryContractsByCodeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllContractStateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCodesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3179,45 +6337,13 @@ func (m *QueryAllContractStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllContractStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCodesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllContractStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCodesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -3274,7 +6400,7 @@ func (m *QueryAllContractStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllContractStateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCodesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3297,15 +6423,15 @@ func (m *QueryAllContractStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllContractStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCodesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllContractStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCodesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Models", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeInfos", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3332,8 +6458,8 @@ func (m *QueryAllContractStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Models = append(m.Models, Model{})
-			if err := m.Models[len(m.Models)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CodeInfos = append(m.CodeInfos, CodeInfoResponse{})
+			if err := m.CodeInfos[len(m.CodeInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3394,7 +6520,7 @@ func (m *QueryAllContractStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRawContractStateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCodeByChecksumRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3417,15 +6543,15 @@ func (m *QueryRawContractStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRawContractStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCodeByChecksumRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRawContractStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCodeByChecksumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3453,41 +6579,7 @@ func (m *QueryRawContractStateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueryData", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.QueryData = append(m.QueryData[:0], dAtA[iNdEx:postIndex]...)
-			if m.QueryData == nil {
-				m.QueryData = []byte{}
-			}
+			m.Checksum = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3510,7 +6602,7 @@ func (m *QueryRawContractStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRawContractStateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCodeByChecksumResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3533,17 +6625,17 @@ func (m *QueryRawContractStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRawContractStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCodeByChecksumResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRawContractStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCodeByChecksumResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeInfos", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3553,24 +6645,24 @@ func (m *QueryRawContractStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			m.CodeInfos = append(m.CodeInfos, CodeInfoResponse{})
+			if err := m.CodeInfos[len(m.CodeInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -3594,7 +6686,7 @@ func (m *QueryRawContractStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySmartContractStateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryScheduledCallbacksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3617,15 +6709,15 @@ func (m *QuerySmartContractStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySmartContractStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScheduledCallbacksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySmartContractStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScheduledCallbacksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3653,13 +6745,13 @@ func (m *QuerySmartContractStateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueryData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3669,24 +6761,26 @@ func (m *QuerySmartContractStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QueryData = append(m.QueryData[:0], dAtA[iNdEx:postIndex]...)
-			if m.QueryData == nil {
-				m.QueryData = []byte{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -3710,7 +6804,7 @@ func (m *QuerySmartContractStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySmartContractStateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryScheduledCallbacksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3733,17 +6827,17 @@ func (m *QuerySmartContractStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySmartContractStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScheduledCallbacksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySmartContractStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScheduledCallbacksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Callbacks", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3753,24 +6847,60 @@ func (m *QuerySmartContractStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			m.Callbacks = append(m.Callbacks, ScheduledCallback{})
+			if err := m.Callbacks[len(m.Callbacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -3794,7 +6924,7 @@ func (m *QuerySmartContractStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAcceptedStargateQueriesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3817,31 +6947,12 @@ func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCodeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAcceptedStargateQueriesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAcceptedStargateQueriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeId", wireType)
-			}
-			m.CodeId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CodeId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3863,7 +6974,7 @@ func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CodeInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAcceptedStargateQueriesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3886,34 +6997,15 @@ func (m *CodeInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CodeInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAcceptedStargateQueriesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CodeInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAcceptedStargateQueriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
-			}
-			m.CodeID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CodeID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Paths", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3941,106 +7033,58 @@ func (m *CodeInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Paths = append(m.Paths, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataHash", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DataHash = append(m.DataHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.DataHash == nil {
-				m.DataHash = []byte{}
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStargateMsgsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Builder", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.Builder = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStargateMsgsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStargateMsgsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4062,7 +7106,7 @@ func (m *CodeInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStargateMsgsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4085,17 +7129,17 @@ func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCodeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStargateMsgsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStargateMsgsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeInfoResponse", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accepted", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4105,33 +7149,29 @@ func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CodeInfoResponse == nil {
-				m.CodeInfoResponse = &CodeInfoResponse{}
-			}
-			if err := m.CodeInfoResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Accepted = append(m.Accepted, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denied", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4141,25 +7181,23 @@ func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
+			m.Denied = append(m.Denied, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4182,7 +7220,7 @@ func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCodesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryContractStorageStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4205,17 +7243,17 @@ func (m *QueryCodesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCodesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryContractStorageStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCodesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryContractStorageStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4225,27 +7263,23 @@ func (m *QueryCodesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4268,7 +7302,7 @@ func (m *QueryCodesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCodesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryContractStorageStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4291,15 +7325,15 @@ func (m *QueryCodesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCodesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryContractStorageStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCodesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryContractStorageStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeInfos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4326,14 +7360,32 @@ func (m *QueryCodesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CodeInfos = append(m.CodeInfos, CodeInfoResponse{})
-			if err := m.CodeInfos[len(m.CodeInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBytes", wireType)
+			}
+			m.MaxBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageDeposit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4360,10 +7412,8 @@ func (m *QueryCodesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.StorageDeposit = append(m.StorageDeposit, types.Coin{})
+			if err := m.StorageDeposit[len(m.StorageDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
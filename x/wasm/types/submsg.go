@@ -0,0 +1,78 @@
+package types
+
+import (
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ReplyOn defines when the result of a submessage is returned to the calling contract via `reply`
+type ReplyOn string
+
+const (
+	// ReplyAlways calls `reply` with the submessage result on success and on failure
+	ReplyAlways ReplyOn = "always"
+	// ReplySuccess calls `reply` on success only. A failure aborts the whole transaction.
+	ReplySuccess ReplyOn = "success"
+	// ReplyError calls `reply` on failure only. On success the state changes are committed without a reply.
+	ReplyError ReplyOn = "error"
+	// ReplyNever does not call `reply`. A failure aborts the whole transaction.
+	ReplyNever ReplyOn = "never"
+)
+
+// ValidateBasic returns an error for unknown reply modes
+func (r ReplyOn) ValidateBasic() error {
+	switch r {
+	case ReplyAlways, ReplySuccess, ReplyError, ReplyNever:
+		return nil
+	default:
+		return sdkerrors.Wrapf(ErrInvalid, "reply on: %q", r)
+	}
+}
+
+// OnSuccess returns true when the contract expects a reply for a successful execution
+func (r ReplyOn) OnSuccess() bool {
+	return r == ReplyAlways || r == ReplySuccess
+}
+
+// OnError returns true when the contract expects a reply for a failed execution
+func (r ReplyOn) OnError() bool {
+	return r == ReplyAlways || r == ReplyError
+}
+
+// SubMsg is a message that is dispatched in a sandbox on behalf of the contract, with the reply mode that
+// defines if and when the result is returned to the contract. A contract response is a single ordered list of
+// submessages that is dispatched in sequence, messages without a reply use ReplyNever.
+type SubMsg struct {
+	ID       uint64
+	Msg      wasmvmtypes.CosmosMsg
+	GasLimit *uint64
+	ReplyOn  ReplyOn
+}
+
+// NewSubMsgs converts the submessages returned by the wasmvm. The VM version in use has no reply mode and the
+// contracts always expect a reply, so that ReplyAlways is set.
+func NewSubMsgs(msgs []wasmvmtypes.SubMsg) []SubMsg {
+	if len(msgs) == 0 {
+		return nil
+	}
+	res := make([]SubMsg, len(msgs))
+	for i, m := range msgs {
+		res[i] = SubMsg{
+			ID:       m.ID,
+			Msg:      m.Msg,
+			GasLimit: m.GasLimit,
+			ReplyOn:  ReplyAlways,
+		}
+	}
+	return res
+}
+
+// NewOrderedMsgs converts the legacy response format into a single ordered list. All submessages are dispatched
+// before the normal messages, which do not get a reply.
+func NewOrderedMsgs(subMsgs []wasmvmtypes.SubMsg, msgs []wasmvmtypes.CosmosMsg) []SubMsg {
+	res := NewSubMsgs(subMsgs)
+	for _, m := range msgs {
+		res = append(res, SubMsg{Msg: m, ReplyOn: ReplyNever})
+	}
+	return res
+}
@@ -0,0 +1,34 @@
+package types
+
+import (
+	"testing"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewOrderedMsgs(t *testing.T) {
+	var gasLimit uint64 = 1
+	subMsgs := []wasmvmtypes.SubMsg{
+		{ID: 1, Msg: wasmvmtypes.CosmosMsg{Custom: []byte(`{"a":1}`)}, GasLimit: &gasLimit},
+		{ID: 2, Msg: wasmvmtypes.CosmosMsg{Custom: []byte(`{"b":1}`)}},
+	}
+	msgs := []wasmvmtypes.CosmosMsg{{Custom: []byte(`{"c":1}`)}}
+
+	got := NewOrderedMsgs(subMsgs, msgs)
+	exp := []SubMsg{
+		{ID: 1, Msg: wasmvmtypes.CosmosMsg{Custom: []byte(`{"a":1}`)}, GasLimit: &gasLimit, ReplyOn: ReplyAlways},
+		{ID: 2, Msg: wasmvmtypes.CosmosMsg{Custom: []byte(`{"b":1}`)}, ReplyOn: ReplyAlways},
+		{Msg: wasmvmtypes.CosmosMsg{Custom: []byte(`{"c":1}`)}, ReplyOn: ReplyNever},
+	}
+	assert.Equal(t, exp, got)
+	assert.Empty(t, NewOrderedMsgs(nil, nil))
+}
+
+func TestReplyOnValidateBasic(t *testing.T) {
+	for _, r := range []ReplyOn{ReplyAlways, ReplySuccess, ReplyError, ReplyNever} {
+		assert.NoError(t, r.ValidateBasic(), string(r))
+	}
+	assert.Error(t, ReplyOn("").ValidateBasic())
+	assert.Error(t, ReplyOn("sometimes").ValidateBasic())
+}
@@ -104,6 +104,118 @@ func (msg MsgInstantiateContract) GetSigners() []sdk.AccAddress {
 
 }
 
+func (msg MsgInstantiateContract2) Route() string {
+	return RouterKey
+}
+
+func (msg MsgInstantiateContract2) Type() string {
+	return "instantiate2"
+}
+
+func (msg MsgInstantiateContract2) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+
+	if msg.CodeID == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "code id is required")
+	}
+
+	if err := validateLabel(msg.Label); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "label is required")
+	}
+
+	if !msg.Funds.IsValid() {
+		return sdkerrors.ErrInvalidCoins
+	}
+
+	if len(msg.Admin) != 0 {
+		if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
+			return sdkerrors.Wrap(err, "admin")
+		}
+	}
+	if !json.Valid(msg.InitMsg) {
+		return sdkerrors.Wrap(ErrInvalid, "init msg json")
+	}
+	if err := validateSalt(msg.Salt); err != nil {
+		return sdkerrors.Wrap(err, "salt")
+	}
+	return nil
+}
+
+func (msg MsgInstantiateContract2) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgInstantiateContract2) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgStoreAndInstantiateContract) Route() string {
+	return RouterKey
+}
+
+func (msg MsgStoreAndInstantiateContract) Type() string {
+	return "store-and-instantiate"
+}
+
+func (msg MsgStoreAndInstantiateContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+
+	if err := validateWasmCode(msg.WASMByteCode); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "code bytes %s", err.Error())
+	}
+
+	if err := validateSourceURL(msg.Source); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "source %s", err.Error())
+	}
+
+	if err := validateBuilder(msg.Builder); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "builder %s", err.Error())
+	}
+	if msg.InstantiatePermission != nil {
+		if err := msg.InstantiatePermission.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "instantiate permission")
+		}
+	}
+
+	if err := validateLabel(msg.Label); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "label is required")
+	}
+
+	if !msg.Funds.IsValid() {
+		return sdkerrors.ErrInvalidCoins
+	}
+
+	if len(msg.Admin) != 0 {
+		if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
+			return sdkerrors.Wrap(err, "admin")
+		}
+	}
+	if !json.Valid(msg.InitMsg) {
+		return sdkerrors.Wrap(ErrInvalid, "init msg json")
+	}
+	return nil
+}
+
+func (msg MsgStoreAndInstantiateContract) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgStoreAndInstantiateContract) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
 func (msg MsgExecuteContract) Route() string {
 	return RouterKey
 }
@@ -252,6 +364,276 @@ func (msg MsgClearAdmin) GetSigners() []sdk.AccAddress {
 
 }
 
+func (msg MsgProposeAdmin) Route() string {
+	return RouterKey
+}
+
+func (msg MsgProposeAdmin) Type() string {
+	return "propose-contract-admin"
+}
+
+func (msg MsgProposeAdmin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.PendingAdmin); err != nil {
+		return sdkerrors.Wrap(err, "pending admin")
+	}
+	if strings.EqualFold(msg.Sender, msg.PendingAdmin) {
+		return sdkerrors.Wrap(ErrInvalidMsg, "pending admin is the current admin")
+	}
+	return nil
+}
+
+func (msg MsgProposeAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgProposeAdmin) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgAcceptAdmin) Route() string {
+	return RouterKey
+}
+
+func (msg MsgAcceptAdmin) Type() string {
+	return "accept-contract-admin"
+}
+
+func (msg MsgAcceptAdmin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return nil
+}
+
+func (msg MsgAcceptAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgAcceptAdmin) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgCancelProposedAdmin) Route() string {
+	return RouterKey
+}
+
+func (msg MsgCancelProposedAdmin) Type() string {
+	return "cancel-proposed-contract-admin"
+}
+
+func (msg MsgCancelProposedAdmin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return nil
+}
+
+func (msg MsgCancelProposedAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCancelProposedAdmin) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgDeleteCode) Route() string {
+	return RouterKey
+}
+
+func (msg MsgDeleteCode) Type() string {
+	return "delete-code"
+}
+
+func (msg MsgDeleteCode) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if msg.CodeID == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "code id is required")
+	}
+	return nil
+}
+
+func (msg MsgDeleteCode) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgDeleteCode) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgScheduleCallback) Route() string {
+	return RouterKey
+}
+
+func (msg MsgScheduleCallback) Type() string {
+	return "schedule-callback"
+}
+
+func (msg MsgScheduleCallback) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	if !json.Valid(msg.Msg) {
+		return sdkerrors.Wrap(ErrInvalid, "msg json")
+	}
+	if msg.Height == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "height")
+	}
+	if msg.GasLimit == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "gas limit")
+	}
+	if !msg.Fee.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "fee")
+	}
+	if msg.Fee.IsZero() {
+		return sdkerrors.Wrap(ErrEmpty, "fee")
+	}
+	return nil
+}
+
+func (msg MsgScheduleCallback) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgScheduleCallback) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgCancelCallback) Route() string {
+	return RouterKey
+}
+
+func (msg MsgCancelCallback) Type() string {
+	return "cancel-callback"
+}
+
+func (msg MsgCancelCallback) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if msg.ID == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "callback id is required")
+	}
+	return nil
+}
+
+func (msg MsgCancelCallback) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCancelCallback) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgUpdateInstantiateConfig) Route() string {
+	return RouterKey
+}
+
+func (msg MsgUpdateInstantiateConfig) Type() string {
+	return "update-instantiate-config"
+}
+
+func (msg MsgUpdateInstantiateConfig) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if msg.CodeID == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "code id is required")
+	}
+	if msg.NewInstantiatePermission == nil {
+		return sdkerrors.Wrap(ErrEmpty, "instantiate permission")
+	}
+	if err := msg.NewInstantiatePermission.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "instantiate permission")
+	}
+	return nil
+}
+
+func (msg MsgUpdateInstantiateConfig) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUpdateInstantiateConfig) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgUpdateMigrationAllowlist) Route() string {
+	return RouterKey
+}
+
+func (msg MsgUpdateMigrationAllowlist) Type() string {
+	return "update-migration-allowlist"
+}
+
+func (msg MsgUpdateMigrationAllowlist) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	if err := msg.Allowlist.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "allowlist")
+	}
+	return nil
+}
+
+func (msg MsgUpdateMigrationAllowlist) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUpdateMigrationAllowlist) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
 func (msg MsgIBCSend) Route() string {
 	return RouterKey
 }
@@ -239,6 +239,116 @@ func TestInstantiateContractValidation(t *testing.T) {
 	}
 }
 
+func TestInstantiateContract2Validation(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+
+	cases := map[string]struct {
+		msg   MsgInstantiateContract2
+		valid bool
+	}{
+		"empty": {
+			msg:   MsgInstantiateContract2{},
+			valid: false,
+		},
+		"correct minimal": {
+			msg: MsgInstantiateContract2{
+				Sender:  goodAddress,
+				CodeID:  firstCodeID,
+				Label:   "foo",
+				InitMsg: []byte("{}"),
+				Salt:    []byte{0},
+			},
+			valid: true,
+		},
+		"correct maximal": {
+			msg: MsgInstantiateContract2{
+				Sender:  goodAddress,
+				Admin:   goodAddress,
+				CodeID:  firstCodeID,
+				Label:   "foo",
+				InitMsg: []byte(`{"some": "data"}`),
+				Funds:   sdk.Coins{sdk.Coin{Denom: "foobar", Amount: sdk.NewInt(200)}},
+				Salt:    bytes.Repeat([]byte{1}, MaxSaltSize),
+			},
+			valid: true,
+		},
+		"missing salt": {
+			msg: MsgInstantiateContract2{
+				Sender:  goodAddress,
+				CodeID:  firstCodeID,
+				Label:   "foo",
+				InitMsg: []byte("{}"),
+			},
+			valid: false,
+		},
+		"salt too long": {
+			msg: MsgInstantiateContract2{
+				Sender:  goodAddress,
+				CodeID:  firstCodeID,
+				Label:   "foo",
+				InitMsg: []byte("{}"),
+				Salt:    bytes.Repeat([]byte{1}, MaxSaltSize+1),
+			},
+			valid: false,
+		},
+		"missing code": {
+			msg: MsgInstantiateContract2{
+				Sender:  goodAddress,
+				Label:   "foo",
+				InitMsg: []byte("{}"),
+				Salt:    []byte{0},
+			},
+			valid: false,
+		},
+		"bad sender minimal": {
+			msg: MsgInstantiateContract2{
+				Sender:  badAddress,
+				CodeID:  firstCodeID,
+				Label:   "foo",
+				InitMsg: []byte("{}"),
+				Salt:    []byte{0},
+			},
+			valid: false,
+		},
+		"bad admin": {
+			msg: MsgInstantiateContract2{
+				Sender:  goodAddress,
+				Admin:   badAddress,
+				CodeID:  firstCodeID,
+				Label:   "foo",
+				InitMsg: []byte("{}"),
+				Salt:    []byte{0},
+			},
+			valid: false,
+		},
+		"non json init msg": {
+			msg: MsgInstantiateContract2{
+				Sender:  goodAddress,
+				CodeID:  firstCodeID,
+				Label:   "foo",
+				InitMsg: []byte("invalid-json"),
+				Salt:    []byte{0},
+			},
+			valid: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
 func TestExecuteContractValidation(t *testing.T) {
 	bad, err := sdk.AccAddressFromHex("012345")
 	require.NoError(t, err)
@@ -420,6 +530,122 @@ func TestMsgUpdateAdministrator(t *testing.T) {
 	}
 }
 
+func TestMsgProposeAdmin(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	otherGoodAddress := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20)).String()
+	anotherGoodAddress := sdk.AccAddress(bytes.Repeat([]byte{0x2}, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgProposeAdmin
+		expErr bool
+	}{
+		"all good": {
+			src: MsgProposeAdmin{
+				Sender:       goodAddress,
+				PendingAdmin: otherGoodAddress,
+				Contract:     anotherGoodAddress,
+			},
+		},
+		"pending admin required": {
+			src: MsgProposeAdmin{
+				Sender:   goodAddress,
+				Contract: anotherGoodAddress,
+			},
+			expErr: true,
+		},
+		"bad sender": {
+			src: MsgProposeAdmin{
+				Sender:       badAddress,
+				PendingAdmin: otherGoodAddress,
+				Contract:     anotherGoodAddress,
+			},
+			expErr: true,
+		},
+		"bad pending admin": {
+			src: MsgProposeAdmin{
+				Sender:       goodAddress,
+				PendingAdmin: badAddress,
+				Contract:     anotherGoodAddress,
+			},
+			expErr: true,
+		},
+		"bad contract addr": {
+			src: MsgProposeAdmin{
+				Sender:       goodAddress,
+				PendingAdmin: otherGoodAddress,
+				Contract:     badAddress,
+			},
+			expErr: true,
+		},
+		"pending admin same as sender": {
+			src: MsgProposeAdmin{
+				Sender:       goodAddress,
+				PendingAdmin: goodAddress,
+				Contract:     anotherGoodAddress,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgAcceptAndCancelProposedAdmin(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	anotherGoodAddress := sdk.AccAddress(bytes.Repeat([]byte{0x2}, 20)).String()
+
+	specs := map[string]struct {
+		sender, contract string
+		expErr           bool
+	}{
+		"all good": {
+			sender:   goodAddress,
+			contract: anotherGoodAddress,
+		},
+		"bad sender": {
+			sender:   badAddress,
+			contract: anotherGoodAddress,
+			expErr:   true,
+		},
+		"bad contract addr": {
+			sender:   goodAddress,
+			contract: badAddress,
+			expErr:   true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			for _, src := range []sdk.Msg{
+				&MsgAcceptAdmin{Sender: spec.sender, Contract: spec.contract},
+				&MsgCancelProposedAdmin{Sender: spec.sender, Contract: spec.contract},
+			} {
+				err := src.ValidateBasic()
+				if spec.expErr {
+					require.Error(t, err)
+					continue
+				}
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestMsgClearAdministrator(t *testing.T) {
 	bad, err := sdk.AccAddressFromHex("012345")
 	require.NoError(t, err)
@@ -557,3 +783,441 @@ func TestMsgMigrateContract(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgDeleteCode(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgDeleteCode
+		expErr bool
+	}{
+		"all good": {
+			src: MsgDeleteCode{
+				Sender: goodAddress,
+				CodeID: firstCodeID,
+			},
+		},
+		"bad sender": {
+			src: MsgDeleteCode{
+				Sender: badAddress,
+				CodeID: firstCodeID,
+			},
+			expErr: true,
+		},
+		"code id missing": {
+			src: MsgDeleteCode{
+				Sender: goodAddress,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUpdateInstantiateConfig(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgUpdateInstantiateConfig
+		expErr bool
+	}{
+		"all good": {
+			src: MsgUpdateInstantiateConfig{
+				Sender:                   goodAddress,
+				CodeID:                   firstCodeID,
+				NewInstantiatePermission: &AllowEverybody,
+			},
+		},
+		"bad sender": {
+			src: MsgUpdateInstantiateConfig{
+				Sender:                   badAddress,
+				CodeID:                   firstCodeID,
+				NewInstantiatePermission: &AllowEverybody,
+			},
+			expErr: true,
+		},
+		"code id missing": {
+			src: MsgUpdateInstantiateConfig{
+				Sender:                   goodAddress,
+				NewInstantiatePermission: &AllowEverybody,
+			},
+			expErr: true,
+		},
+		"permission missing": {
+			src: MsgUpdateInstantiateConfig{
+				Sender: goodAddress,
+				CodeID: firstCodeID,
+			},
+			expErr: true,
+		},
+		"invalid permission": {
+			src: MsgUpdateInstantiateConfig{
+				Sender:                   goodAddress,
+				CodeID:                   firstCodeID,
+				NewInstantiatePermission: &AccessConfig{Permission: AccessTypeOnlyAddress},
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUpdateMigrationAllowlist(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgUpdateMigrationAllowlist
+		expErr bool
+	}{
+		"all good": {
+			src: MsgUpdateMigrationAllowlist{
+				Sender:    goodAddress,
+				Contract:  goodAddress,
+				Allowlist: MigrationAllowlist{CodeIDs: []uint64{firstCodeID}, CodeCreators: []string{goodAddress}},
+			},
+		},
+		"empty allowlist": {
+			src: MsgUpdateMigrationAllowlist{
+				Sender:   goodAddress,
+				Contract: goodAddress,
+			},
+		},
+		"bad sender": {
+			src: MsgUpdateMigrationAllowlist{
+				Sender:   badAddress,
+				Contract: goodAddress,
+			},
+			expErr: true,
+		},
+		"bad contract": {
+			src: MsgUpdateMigrationAllowlist{
+				Sender:   goodAddress,
+				Contract: badAddress,
+			},
+			expErr: true,
+		},
+		"invalid allowlist": {
+			src: MsgUpdateMigrationAllowlist{
+				Sender:    goodAddress,
+				Contract:  goodAddress,
+				Allowlist: MigrationAllowlist{CodeCreators: []string{badAddress}},
+			},
+			expErr: true,
+		},
+		"upper case creator": {
+			src: MsgUpdateMigrationAllowlist{
+				Sender:    goodAddress,
+				Contract:  goodAddress,
+				Allowlist: MigrationAllowlist{CodeCreators: []string{strings.ToUpper(goodAddress)}},
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgScheduleCallback(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	anotherGoodAddress := sdk.AccAddress(bytes.Repeat([]byte{0x2}, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgScheduleCallback
+		expErr bool
+	}{
+		"all good": {
+			src: MsgScheduleCallback{
+				Sender:   goodAddress,
+				Contract: anotherGoodAddress,
+				Msg:      []byte(`{}`),
+				Height:   1,
+				GasLimit: 1,
+				Fee:      sdk.NewCoins(sdk.NewInt64Coin("denom", 1)),
+			},
+		},
+		"recurring": {
+			src: MsgScheduleCallback{
+				Sender:   goodAddress,
+				Contract: anotherGoodAddress,
+				Msg:      []byte(`{}`),
+				Height:   1,
+				Interval: 10,
+				GasLimit: 1,
+				Fee:      sdk.NewCoins(sdk.NewInt64Coin("denom", 1)),
+			},
+		},
+		"bad sender": {
+			src: MsgScheduleCallback{
+				Sender:   badAddress,
+				Contract: anotherGoodAddress,
+				Msg:      []byte(`{}`),
+				Height:   1,
+				GasLimit: 1,
+			},
+			expErr: true,
+		},
+		"bad contract": {
+			src: MsgScheduleCallback{
+				Sender:   goodAddress,
+				Contract: badAddress,
+				Msg:      []byte(`{}`),
+				Height:   1,
+				GasLimit: 1,
+			},
+			expErr: true,
+		},
+		"non json msg": {
+			src: MsgScheduleCallback{
+				Sender:   goodAddress,
+				Contract: anotherGoodAddress,
+				Msg:      []byte("invalid-json"),
+				Height:   1,
+				GasLimit: 1,
+			},
+			expErr: true,
+		},
+		"height missing": {
+			src: MsgScheduleCallback{
+				Sender:   goodAddress,
+				Contract: anotherGoodAddress,
+				Msg:      []byte(`{}`),
+				GasLimit: 1,
+			},
+			expErr: true,
+		},
+		"gas limit missing": {
+			src: MsgScheduleCallback{
+				Sender:   goodAddress,
+				Contract: anotherGoodAddress,
+				Msg:      []byte(`{}`),
+				Height:   1,
+			},
+			expErr: true,
+		},
+		"invalid fee": {
+			src: MsgScheduleCallback{
+				Sender:   goodAddress,
+				Contract: anotherGoodAddress,
+				Msg:      []byte(`{}`),
+				Height:   1,
+				GasLimit: 1,
+				Fee:      sdk.Coins{sdk.Coin{Denom: "denom", Amount: sdk.NewInt(-1)}},
+			},
+			expErr: true,
+		},
+		"fee missing": {
+			src: MsgScheduleCallback{
+				Sender:   goodAddress,
+				Contract: anotherGoodAddress,
+				Msg:      []byte(`{}`),
+				Height:   1,
+				GasLimit: 1,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgCancelCallback(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgCancelCallback
+		expErr bool
+	}{
+		"all good": {
+			src: MsgCancelCallback{
+				Sender: goodAddress,
+				ID:     1,
+			},
+		},
+		"bad sender": {
+			src: MsgCancelCallback{
+				Sender: badAddress,
+				ID:     1,
+			},
+			expErr: true,
+		},
+		"id missing": {
+			src: MsgCancelCallback{
+				Sender: goodAddress,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgStoreAndInstantiateContract(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgStoreAndInstantiateContract
+		expErr bool
+	}{
+		"all good": {
+			src: MsgStoreAndInstantiateContract{
+				Sender:       goodAddress,
+				WASMByteCode: []byte("foo"),
+				Label:        "foo",
+				InitMsg:      []byte(`{}`),
+			},
+		},
+		"all optional fields": {
+			src: MsgStoreAndInstantiateContract{
+				Sender:                goodAddress,
+				WASMByteCode:          []byte("foo"),
+				Source:                "https://example.com/foo",
+				Builder:               "confio/cosmwasm-opt:0.6.2",
+				InstantiatePermission: &AllowEverybody,
+				Admin:                 goodAddress,
+				Label:                 "foo",
+				InitMsg:               []byte(`{}`),
+				Funds:                 sdk.Coins{sdk.NewInt64Coin("denom", 200)},
+			},
+		},
+		"bad sender": {
+			src: MsgStoreAndInstantiateContract{
+				Sender:       badAddress,
+				WASMByteCode: []byte("foo"),
+				Label:        "foo",
+				InitMsg:      []byte(`{}`),
+			},
+			expErr: true,
+		},
+		"empty wasm code": {
+			src: MsgStoreAndInstantiateContract{
+				Sender:  goodAddress,
+				Label:   "foo",
+				InitMsg: []byte(`{}`),
+			},
+			expErr: true,
+		},
+		"invalid instantiate permission": {
+			src: MsgStoreAndInstantiateContract{
+				Sender:                goodAddress,
+				WASMByteCode:          []byte("foo"),
+				InstantiatePermission: &AccessConfig{Permission: AccessTypeOnlyAddress, Address: badAddress},
+				Label:                 "foo",
+				InitMsg:               []byte(`{}`),
+			},
+			expErr: true,
+		},
+		"empty label": {
+			src: MsgStoreAndInstantiateContract{
+				Sender:       goodAddress,
+				WASMByteCode: []byte("foo"),
+				InitMsg:      []byte(`{}`),
+			},
+			expErr: true,
+		},
+		"bad admin": {
+			src: MsgStoreAndInstantiateContract{
+				Sender:       goodAddress,
+				WASMByteCode: []byte("foo"),
+				Admin:        badAddress,
+				Label:        "foo",
+				InitMsg:      []byte(`{}`),
+			},
+			expErr: true,
+		},
+		"non json init msg": {
+			src: MsgStoreAndInstantiateContract{
+				Sender:       goodAddress,
+				WASMByteCode: []byte("foo"),
+				Label:        "foo",
+				InitMsg:      []byte("invalid-json"),
+			},
+			expErr: true,
+		},
+		"negative funds": {
+			src: MsgStoreAndInstantiateContract{
+				Sender:       goodAddress,
+				WASMByteCode: []byte("foo"),
+				Label:        "foo",
+				InitMsg:      []byte(`{}`),
+				Funds:        sdk.Coins{sdk.Coin{Denom: "denom", Amount: sdk.NewInt(-1)}},
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
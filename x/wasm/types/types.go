@@ -1,7 +1,9 @@
 package types
 
 import (
+	"encoding/json"
 	"fmt"
+	"strings"
 
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -80,6 +82,19 @@ func (c *ContractInfo) ValidateBasic() error {
 			return sdkerrors.Wrap(err, "admin")
 		}
 	}
+	if len(c.PendingAdmin) != 0 {
+		if len(c.Admin) == 0 {
+			return sdkerrors.Wrap(ErrInvalid, "pending admin without admin")
+		}
+		if _, err := sdk.AccAddressFromBech32(c.PendingAdmin); err != nil {
+			return sdkerrors.Wrap(err, "pending admin")
+		}
+	}
+	if c.MigrationAllowlist != nil {
+		if err := c.MigrationAllowlist.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "migration allowlist")
+		}
+	}
 	if err := validateLabel(c.Label); err != nil {
 		return sdkerrors.Wrap(err, "label")
 	}
@@ -116,6 +131,18 @@ func (c *ContractInfo) ResetFromGenesis(ctx sdk.Context) ContractCodeHistoryEntr
 	}
 }
 
+// CreatorAddr convert into sdk.AccAddress or nil when not set
+func (c *ContractInfo) CreatorAddr() sdk.AccAddress {
+	if c.Creator == "" {
+		return nil
+	}
+	creator, err := sdk.AccAddressFromBech32(c.Creator)
+	if err != nil { // should never happen
+		panic(err.Error())
+	}
+	return creator
+}
+
 // AdminAddr convert into sdk.AccAddress or nil when not set
 func (c *ContractInfo) AdminAddr() sdk.AccAddress {
 	if c.Admin == "" {
@@ -128,6 +155,116 @@ func (c *ContractInfo) AdminAddr() sdk.AccAddress {
 	return admin
 }
 
+// PendingAdminAddr returns the pending admin or nil when none is proposed
+func (c *ContractInfo) PendingAdminAddr() sdk.AccAddress {
+	if c.PendingAdmin == "" {
+		return nil
+	}
+	pendingAdmin, err := sdk.AccAddressFromBech32(c.PendingAdmin)
+	if err != nil { // should never happen
+		panic(err.Error())
+	}
+	return pendingAdmin
+}
+
+// ValidateBasic does stateless validation of the allowlist. An empty allowlist is valid and
+// prevents any migration that is not done by governance.
+func (a MigrationAllowlist) ValidateBasic() error {
+	codeIDs := make(map[uint64]bool, len(a.CodeIDs))
+	for _, id := range a.CodeIDs {
+		if id == 0 {
+			return sdkerrors.Wrap(ErrEmpty, "code id")
+		}
+		if codeIDs[id] {
+			return sdkerrors.Wrapf(ErrDuplicate, "code id: %d", id)
+		}
+		codeIDs[id] = true
+	}
+	creators := make(map[string]bool, len(a.CodeCreators))
+	for _, c := range a.CodeCreators {
+		addr, err := sdk.AccAddressFromBech32(c)
+		if err != nil {
+			return sdkerrors.Wrap(err, "code creator")
+		}
+		// the creators are compared as strings, so that only the canonical lower case form can match
+		if addr.String() != c {
+			return sdkerrors.Wrapf(ErrInvalid, "code creator not in canonical form: %s", c)
+		}
+		if creators[c] {
+			return sdkerrors.Wrapf(ErrDuplicate, "code creator: %s", c)
+		}
+		creators[c] = true
+	}
+	return nil
+}
+
+// Allows returns true when the code id or the creator of the code is listed
+func (a MigrationAllowlist) Allows(codeID uint64, codeCreator string) bool {
+	for _, id := range a.CodeIDs {
+		if id == codeID {
+			return true
+		}
+	}
+	for _, c := range a.CodeCreators {
+		if c == codeCreator {
+			return true
+		}
+	}
+	return false
+}
+
+// IsSubsetOf returns true when all entries are listed in the other allowlist
+func (a MigrationAllowlist) IsSubsetOf(o MigrationAllowlist) bool {
+	codeIDs := make(map[uint64]bool, len(o.CodeIDs))
+	for _, id := range o.CodeIDs {
+		codeIDs[id] = true
+	}
+	for _, id := range a.CodeIDs {
+		if !codeIDs[id] {
+			return false
+		}
+	}
+	creators := make(map[string]bool, len(o.CodeCreators))
+	for _, c := range o.CodeCreators {
+		creators[c] = true
+	}
+	for _, c := range a.CodeCreators {
+		if !creators[c] {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidateBasic does stateless validation of the scheduled callback
+func (c ScheduledCallback) ValidateBasic() error {
+	if c.ID == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "id")
+	}
+	if _, err := sdk.AccAddressFromBech32(c.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	if _, err := sdk.AccAddressFromBech32(c.Creator); err != nil {
+		return sdkerrors.Wrap(err, "creator")
+	}
+	if !json.Valid(c.Msg) {
+		return sdkerrors.Wrap(ErrInvalid, "msg json")
+	}
+	if c.Height == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "height")
+	}
+	if c.GasLimit == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "gas limit")
+	}
+	if !c.Fee.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "fee")
+	}
+	if c.Fee.IsZero() {
+		return sdkerrors.Wrap(ErrEmpty, "fee")
+	}
+	return nil
+}
+
 // NewAbsoluteTxPosition gets a block position from the context
 func NewAbsoluteTxPosition(ctx sdk.Context) *AbsoluteTxPosition {
 	// we must safely handle nil gas meters
@@ -219,13 +356,16 @@ func NewWasmCoins(cosmosCoins sdk.Coins) (wasmCoins []wasmvmtypes.Coin) {
 const CustomEventType = "wasm"
 const AttributeKeyContractAddr = "contract_address"
 
-// ParseEvents converts wasm LogAttributes into an sdk.Events
-func ParseEvents(wasmOutputAttrs []wasmvmtypes.EventAttribute, contractAddr sdk.AccAddress) sdk.Events {
+// ParseEvents converts wasm LogAttributes into an sdk.Events. Attribute keys with the reserved prefix are rejected.
+func ParseEvents(wasmOutputAttrs []wasmvmtypes.EventAttribute, contractAddr sdk.AccAddress) (sdk.Events, error) {
 	// we always tag with the contract address issuing this event
 	attrs := []sdk.Attribute{sdk.NewAttribute(AttributeKeyContractAddr, contractAddr.String())}
 
 	// append attributes from wasm to the sdk.Event
 	for _, l := range wasmOutputAttrs {
+		if strings.HasPrefix(strings.TrimSpace(l.Key), AttributeReservedPrefix) {
+			return nil, sdkerrors.Wrapf(ErrInvalidEvent, "attribute key starts with reserved prefix %q: %q", AttributeReservedPrefix, l.Key)
+		}
 		// and reserve the contract_address key for our use (not contract)
 		if l.Key != AttributeKeyContractAddr {
 			attr := sdk.NewAttribute(l.Key, l.Value)
@@ -234,7 +374,35 @@ func ParseEvents(wasmOutputAttrs []wasmvmtypes.EventAttribute, contractAddr sdk.
 	}
 
 	// each wasm invokation always returns one sdk.Event
-	return sdk.Events{sdk.NewEvent(CustomEventType, attrs...)}
+	return sdk.Events{sdk.NewEvent(CustomEventType, attrs...)}, nil
+}
+
+// eventTypeMinLength is the minimum length of a custom event type without the prefix
+const eventTypeMinLength = 2
+
+// NewCustomEvents converts the custom events of a contract into `wasm-<type>` sdk events. Each event is tagged with
+// the contract address. Attribute keys with the reserved prefix are rejected.
+func NewCustomEvents(evts []wasmvmtypes.Event, contractAddr sdk.AccAddress) (sdk.Events, error) {
+	res := make(sdk.Events, 0, len(evts))
+	for _, e := range evts {
+		typ := strings.TrimSpace(e.Type)
+		if len(typ) < eventTypeMinLength {
+			return nil, sdkerrors.Wrapf(ErrInvalidEvent, "event type too short: %q", e.Type)
+		}
+		attrs := []sdk.Attribute{sdk.NewAttribute(AttributeKeyContractAddrReserved, contractAddr.String())}
+		for _, a := range e.Attributes {
+			key := strings.TrimSpace(a.Key)
+			if len(key) == 0 {
+				return nil, sdkerrors.Wrapf(ErrInvalidEvent, "empty attribute key: event type %q", typ)
+			}
+			if strings.HasPrefix(key, AttributeReservedPrefix) {
+				return nil, sdkerrors.Wrapf(ErrInvalidEvent, "attribute key starts with reserved prefix %q: %q", AttributeReservedPrefix, key)
+			}
+			attrs = append(attrs, sdk.NewAttribute(key, a.Value))
+		}
+		res = append(res, sdk.NewEvent(CustomContractEventPrefix+typ, attrs...))
+	}
+	return res, nil
 }
 
 // WasmConfig is the extra config required for wasm
@@ -254,3 +422,17 @@ func DefaultWasmConfig() WasmConfig {
 		ContractDebugMode:  defaultContractDebugMode,
 	}
 }
+
+// Remove returns the stats without an entry of the given size. The counters do not underflow, as the stats of
+// contracts that were created before the accounting was introduced can be incomplete.
+func (s ContractStorageStats) Remove(size uint64) ContractStorageStats {
+	if s.KeyCount > 0 {
+		s.KeyCount--
+	}
+	if s.ByteCount > size {
+		s.ByteCount -= size
+	} else {
+		s.ByteCount = 0
+	}
+	return s
+}
@@ -7,6 +7,8 @@ import (
 	bytes "bytes"
 	encoding_json "encoding/json"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_tendermint_tendermint_libs_bytes "github.com/tendermint/tendermint/libs/bytes"
@@ -38,6 +40,10 @@ const (
 	AccessTypeOnlyAddress AccessType = 2
 	// AccessTypeEverybody unrestricted
 	AccessTypeEverybody AccessType = 3
+	// AccessTypeAnyOfAddresses restricted to a set of addresses
+	AccessTypeAnyOfAddresses AccessType = 4
+	// AccessTypeOnlyContract restricted to a contract
+	AccessTypeOnlyContract AccessType = 5
 )
 
 var AccessType_name = map[int32]string{
@@ -45,13 +51,17 @@ var AccessType_name = map[int32]string{
 	1: "ACCESS_TYPE_NOBODY",
 	2: "ACCESS_TYPE_ONLY_ADDRESS",
 	3: "ACCESS_TYPE_EVERYBODY",
+	4: "ACCESS_TYPE_ANY_OF_ADDRESSES",
+	5: "ACCESS_TYPE_ONLY_CONTRACT",
 }
 
 var AccessType_value = map[string]int32{
-	"ACCESS_TYPE_UNSPECIFIED":  0,
-	"ACCESS_TYPE_NOBODY":       1,
-	"ACCESS_TYPE_ONLY_ADDRESS": 2,
-	"ACCESS_TYPE_EVERYBODY":    3,
+	"ACCESS_TYPE_UNSPECIFIED":      0,
+	"ACCESS_TYPE_NOBODY":           1,
+	"ACCESS_TYPE_ONLY_ADDRESS":     2,
+	"ACCESS_TYPE_EVERYBODY":        3,
+	"ACCESS_TYPE_ANY_OF_ADDRESSES": 4,
+	"ACCESS_TYPE_ONLY_CONTRACT":    5,
 }
 
 func (AccessType) EnumDescriptor() ([]byte, []int) {
@@ -135,7 +145,10 @@ var xxx_messageInfo_AccessTypeParam proto.InternalMessageInfo
 // AccessConfig access control type.
 type AccessConfig struct {
 	Permission AccessType `protobuf:"varint,1,opt,name=permission,proto3,enum=cosmwasm.wasm.v1beta1.AccessType" json:"permission,omitempty" yaml:"permission"`
-	Address    string     `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	// Address is used by the OnlyAddress and OnlyContract types
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	// Addresses is used by the AnyOfAddresses type
+	Addresses []string `protobuf:"bytes,3,rep,name=addresses,proto3" json:"addresses,omitempty" yaml:"addresses"`
 }
 
 func (m *AccessConfig) Reset()         { *m = AccessConfig{} }
@@ -176,6 +189,66 @@ type Params struct {
 	CodeUploadAccess             AccessConfig `protobuf:"bytes,1,opt,name=code_upload_access,json=codeUploadAccess,proto3" json:"code_upload_access" yaml:"code_upload_access"`
 	InstantiateDefaultPermission AccessType   `protobuf:"varint,2,opt,name=instantiate_default_permission,json=instantiateDefaultPermission,proto3,enum=cosmwasm.wasm.v1beta1.AccessType" json:"instantiate_default_permission,omitempty" yaml:"instantiate_default_permission"`
 	MaxWasmCodeSize              uint64       `protobuf:"varint,3,opt,name=max_wasm_code_size,json=maxWasmCodeSize,proto3" json:"max_wasm_code_size,omitempty" yaml:"max_wasm_code_size"`
+	// AcceptedStargateQueries is the list of gRPC query paths that contracts can
+	// call via stargate queries. A path must also have a registered response type
+	// in the keeper to be accepted.
+	AcceptedStargateQueries []string `protobuf:"bytes,4,rep,name=accepted_stargate_queries,json=acceptedStargateQueries,proto3" json:"accepted_stargate_queries,omitempty" yaml:"accepted_stargate_queries"`
+	// AcceptedStargateMsgs is the list of message type URLs that contracts can
+	// dispatch via stargate messages
+	AcceptedStargateMsgs []string `protobuf:"bytes,5,rep,name=accepted_stargate_msgs,json=acceptedStargateMsgs,proto3" json:"accepted_stargate_msgs,omitempty" yaml:"accepted_stargate_msgs"`
+	// DeniedStargateMsgs is the list of message type URLs that contracts can not
+	// dispatch via stargate messages, even when they are accepted
+	DeniedStargateMsgs []string `protobuf:"bytes,6,rep,name=denied_stargate_msgs,json=deniedStargateMsgs,proto3" json:"denied_stargate_msgs,omitempty" yaml:"denied_stargate_msgs"`
+	// GasMultiplier is how many wasmvm gas points equal 1 sdk gas point
+	GasMultiplier uint64 `protobuf:"varint,7,opt,name=gas_multiplier,json=gasMultiplier,proto3" json:"gas_multiplier,omitempty" yaml:"gas_multiplier"`
+	// InstanceCost is the sdk gas charged each time a wasm instance is loaded
+	InstanceCost uint64 `protobuf:"varint,8,opt,name=instance_cost,json=instanceCost,proto3" json:"instance_cost,omitempty" yaml:"instance_cost"`
+	// CompileCost is the sdk gas charged per byte for compiling wasm code
+	CompileCost uint64 `protobuf:"varint,9,opt,name=compile_cost,json=compileCost,proto3" json:"compile_cost,omitempty" yaml:"compile_cost"`
+	// HumanizeCost is the sdk gas charged to convert a canonical address into
+	// the human readable format
+	HumanizeCost uint64 `protobuf:"varint,10,opt,name=humanize_cost,json=humanizeCost,proto3" json:"humanize_cost,omitempty" yaml:"humanize_cost"`
+	// CanonicalizeCost is the sdk gas charged to convert a human readable
+	// address into the canonical format
+	CanonicalizeCost uint64 `protobuf:"varint,11,opt,name=canonicalize_cost,json=canonicalizeCost,proto3" json:"canonicalize_cost,omitempty" yaml:"canonicalize_cost"`
+	// ContractMemoryLimit is the memory limit of each contract execution in MiB.
+	// A new value is applied at the end of the block in which it is set.
+	ContractMemoryLimit uint32 `protobuf:"varint,12,opt,name=contract_memory_limit,json=contractMemoryLimit,proto3" json:"contract_memory_limit,omitempty" yaml:"contract_memory_limit"`
+	// MaxContractStorageBytes is the max size of the keys and values that a
+	// contract can store. Zero for no limit.
+	MaxContractStorageBytes uint64 `protobuf:"varint,13,opt,name=max_contract_storage_bytes,json=maxContractStorageBytes,proto3" json:"max_contract_storage_bytes,omitempty" yaml:"max_contract_storage_bytes"`
+	// CodeStorageLimits override the max contract storage bytes for the
+	// contracts of a code
+	CodeStorageLimits []CodeStorageLimit `protobuf:"bytes,14,rep,name=code_storage_limits,json=codeStorageLimits,proto3" json:"code_storage_limits" yaml:"code_storage_limits"`
+	// StorageDepositPerByte is the deposit that is locked for every byte a
+	// contract stores. Empty to disable storage deposits.
+	StorageDepositPerByte github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,15,rep,name=storage_deposit_per_byte,json=storageDepositPerByte,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"storage_deposit_per_byte" yaml:"storage_deposit_per_byte"`
+	// StorageDepositFromContract charges the storage deposit from the contract
+	// balance instead of the sender
+	StorageDepositFromContract bool `protobuf:"varint,16,opt,name=storage_deposit_from_contract,json=storageDepositFromContract,proto3" json:"storage_deposit_from_contract,omitempty" yaml:"storage_deposit_from_contract"`
+	// DeduplicateCodeUploads makes uploads of an already stored wasm code with
+	// the same creator and instantiate permission return the existing code id
+	DeduplicateCodeUploads bool `protobuf:"varint,17,opt,name=deduplicate_code_uploads,json=deduplicateCodeUploads,proto3" json:"deduplicate_code_uploads,omitempty" yaml:"deduplicate_code_uploads"`
+	// MinCallbackFee is the min fee for each execution of a scheduled callback.
+	// A non-zero fee is required even when empty.
+	MinCallbackFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,18,rep,name=min_callback_fee,json=minCallbackFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_callback_fee" yaml:"min_callback_fee"`
+	// MaxScheduledCallbackGas is the max sum of the callback gas limits that is
+	// executed within a single block
+	MaxScheduledCallbackGas uint64 `protobuf:"varint,19,opt,name=max_scheduled_callback_gas,json=maxScheduledCallbackGas,proto3" json:"max_scheduled_callback_gas,omitempty" yaml:"max_scheduled_callback_gas"`
+	// StorageReadCostPerByte is the sdk gas charged for every byte of the key
+	// and value that a contract reads from its storage, on top of the sdk KV
+	// store costs
+	StorageReadCostPerByte uint64 `protobuf:"varint,20,opt,name=storage_read_cost_per_byte,json=storageReadCostPerByte,proto3" json:"storage_read_cost_per_byte,omitempty" yaml:"storage_read_cost_per_byte"`
+	// StorageWriteCostPerByte is the sdk gas charged for every byte of the key
+	// and value that a contract writes to its storage, on top of the sdk KV
+	// store costs
+	StorageWriteCostPerByte uint64 `protobuf:"varint,21,opt,name=storage_write_cost_per_byte,json=storageWriteCostPerByte,proto3" json:"storage_write_cost_per_byte,omitempty" yaml:"storage_write_cost_per_byte"`
+	// StorageIterNextCost is the sdk gas charged for every step of a contract
+	// storage iterator, in addition to the read costs of the entry
+	StorageIterNextCost uint64 `protobuf:"varint,22,opt,name=storage_iter_next_cost,json=storageIterNextCost,proto3" json:"storage_iter_next_cost,omitempty" yaml:"storage_iter_next_cost"`
+	// StorageDeleteCost is the sdk gas charged for every delete in the contract
+	// storage
+	StorageDeleteCost uint64 `protobuf:"varint,23,opt,name=storage_delete_cost,json=storageDeleteCost,proto3" json:"storage_delete_cost,omitempty" yaml:"storage_delete_cost"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -210,6 +283,48 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+// CodeStorageLimit is the max contract storage bytes for the contracts of a
+// code
+type CodeStorageLimit struct {
+	CodeID uint64 `protobuf:"varint,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	// MaxBytes is the max size of the keys and values that a contract can
+	// store. Zero for no limit.
+	MaxBytes uint64 `protobuf:"varint,2,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"`
+}
+
+func (m *CodeStorageLimit) Reset()         { *m = CodeStorageLimit{} }
+func (m *CodeStorageLimit) String() string { return proto.CompactTextString(m) }
+func (*CodeStorageLimit) ProtoMessage()    {}
+func (*CodeStorageLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2548aa229a1f29bc, []int{3}
+}
+func (m *CodeStorageLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CodeStorageLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CodeStorageLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CodeStorageLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CodeStorageLimit.Merge(m, src)
+}
+func (m *CodeStorageLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *CodeStorageLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_CodeStorageLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CodeStorageLimit proto.InternalMessageInfo
+
 // CodeInfo is data for the uploaded contract WASM code
 type CodeInfo struct {
 	// CodeHash is the unique identifier created by wasmvm
@@ -229,7 +344,7 @@ func (m *CodeInfo) Reset()         { *m = CodeInfo{} }
 func (m *CodeInfo) String() string { return proto.CompactTextString(m) }
 func (*CodeInfo) ProtoMessage()    {}
 func (*CodeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2548aa229a1f29bc, []int{3}
+	return fileDescriptor_2548aa229a1f29bc, []int{4}
 }
 func (m *CodeInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -273,13 +388,22 @@ type ContractInfo struct {
 	// use for sorting
 	Created   *AbsoluteTxPosition `protobuf:"bytes,5,opt,name=created,proto3" json:"created,omitempty"`
 	IBCPortID string              `protobuf:"bytes,6,opt,name=ibc_port_id,json=ibcPortId,proto3" json:"ibc_port_id,omitempty"`
+	// Paused is set by governance to block any contract execution except
+	// queries
+	Paused bool `protobuf:"varint,7,opt,name=paused,proto3" json:"paused,omitempty"`
+	// PendingAdmin is an optional address that was proposed by the admin and
+	// becomes the admin when it accepts
+	PendingAdmin string `protobuf:"bytes,8,opt,name=pending_admin,json=pendingAdmin,proto3" json:"pending_admin,omitempty"`
+	// MigrationAllowlist optionally restricts the codes that the contract can be
+	// migrated to
+	MigrationAllowlist *MigrationAllowlist `protobuf:"bytes,9,opt,name=migration_allowlist,json=migrationAllowlist,proto3" json:"migration_allowlist,omitempty"`
 }
 
 func (m *ContractInfo) Reset()         { *m = ContractInfo{} }
 func (m *ContractInfo) String() string { return proto.CompactTextString(m) }
 func (*ContractInfo) ProtoMessage()    {}
 func (*ContractInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2548aa229a1f29bc, []int{4}
+	return fileDescriptor_2548aa229a1f29bc, []int{5}
 }
 func (m *ContractInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -308,6 +432,49 @@ func (m *ContractInfo) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ContractInfo proto.InternalMessageInfo
 
+// MigrationAllowlist lists the codes that a contract can be migrated to. A code
+// is allowed when its id or its creator is listed.
+type MigrationAllowlist struct {
+	// CodeIDs of the allowed codes
+	CodeIDs []uint64 `protobuf:"varint,1,rep,packed,name=code_ids,json=codeIds,proto3" json:"code_ids,omitempty"`
+	// CodeCreators are the creator addresses of the allowed codes in the
+	// canonical lower case bech32 form
+	CodeCreators []string `protobuf:"bytes,2,rep,name=code_creators,json=codeCreators,proto3" json:"code_creators,omitempty"`
+}
+
+func (m *MigrationAllowlist) Reset()         { *m = MigrationAllowlist{} }
+func (m *MigrationAllowlist) String() string { return proto.CompactTextString(m) }
+func (*MigrationAllowlist) ProtoMessage()    {}
+func (*MigrationAllowlist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2548aa229a1f29bc, []int{6}
+}
+func (m *MigrationAllowlist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MigrationAllowlist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MigrationAllowlist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MigrationAllowlist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigrationAllowlist.Merge(m, src)
+}
+func (m *MigrationAllowlist) XXX_Size() int {
+	return m.Size()
+}
+func (m *MigrationAllowlist) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigrationAllowlist.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MigrationAllowlist proto.InternalMessageInfo
+
 // ContractCodeHistoryEntry metadata to a contract.
 type ContractCodeHistoryEntry struct {
 	Operation ContractCodeHistoryOperationType `protobuf:"varint,1,opt,name=operation,proto3,enum=cosmwasm.wasm.v1beta1.ContractCodeHistoryOperationType" json:"operation,omitempty"`
@@ -322,7 +489,7 @@ func (m *ContractCodeHistoryEntry) Reset()         { *m = ContractCodeHistoryEnt
 func (m *ContractCodeHistoryEntry) String() string { return proto.CompactTextString(m) }
 func (*ContractCodeHistoryEntry) ProtoMessage()    {}
 func (*ContractCodeHistoryEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2548aa229a1f29bc, []int{5}
+	return fileDescriptor_2548aa229a1f29bc, []int{7}
 }
 func (m *ContractCodeHistoryEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -365,7 +532,7 @@ func (m *AbsoluteTxPosition) Reset()         { *m = AbsoluteTxPosition{} }
 func (m *AbsoluteTxPosition) String() string { return proto.CompactTextString(m) }
 func (*AbsoluteTxPosition) ProtoMessage()    {}
 func (*AbsoluteTxPosition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2548aa229a1f29bc, []int{6}
+	return fileDescriptor_2548aa229a1f29bc, []int{8}
 }
 func (m *AbsoluteTxPosition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -406,7 +573,7 @@ func (m *Model) Reset()         { *m = Model{} }
 func (m *Model) String() string { return proto.CompactTextString(m) }
 func (*Model) ProtoMessage()    {}
 func (*Model) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2548aa229a1f29bc, []int{7}
+	return fileDescriptor_2548aa229a1f29bc, []int{9}
 }
 func (m *Model) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -435,94 +602,302 @@ func (m *Model) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Model proto.InternalMessageInfo
 
+// ScheduledCallback is a sudo call into a contract that the wasm module
+// executes in the end blocker once the block height is reached.
+type ScheduledCallback struct {
+	// ID is the unique identifier of the callback
+	ID uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Contract is the address of the smart contract that is called
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// Creator is the address that registered the callback and pays the fees
+	Creator string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+	// Msg json encoded message to be passed to the contract's sudo entry point
+	Msg encoding_json.RawMessage `protobuf:"bytes,4,opt,name=msg,proto3,casttype=encoding/json.RawMessage" json:"msg,omitempty"`
+	// Height is the next block height the callback is executed at
+	Height uint64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	// Interval is the number of blocks between repeated executions. Zero for a
+	// one time execution.
+	Interval uint64 `protobuf:"varint,6,opt,name=interval,proto3" json:"interval,omitempty"`
+	// GasLimit is the max gas that a single execution can consume
+	GasLimit uint64 `protobuf:"varint,7,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// Fee is the amount paid to the fee collector for each execution. It is
+	// charged when an execution is scheduled.
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+}
+
+func (m *ScheduledCallback) Reset()         { *m = ScheduledCallback{} }
+func (m *ScheduledCallback) String() string { return proto.CompactTextString(m) }
+func (*ScheduledCallback) ProtoMessage()    {}
+func (*ScheduledCallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2548aa229a1f29bc, []int{10}
+}
+func (m *ScheduledCallback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledCallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledCallback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledCallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledCallback.Merge(m, src)
+}
+func (m *ScheduledCallback) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledCallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledCallback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledCallback proto.InternalMessageInfo
+
+// ContractStorageStats is the storage usage of a contract
+type ContractStorageStats struct {
+	// KeyCount is the number of keys in the contract store
+	KeyCount uint64 `protobuf:"varint,1,opt,name=key_count,json=keyCount,proto3" json:"key_count,omitempty"`
+	// ByteCount is the sum of the sizes of all keys and values in the contract
+	// store
+	ByteCount uint64 `protobuf:"varint,2,opt,name=byte_count,json=byteCount,proto3" json:"byte_count,omitempty"`
+}
+
+func (m *ContractStorageStats) Reset()         { *m = ContractStorageStats{} }
+func (m *ContractStorageStats) String() string { return proto.CompactTextString(m) }
+func (*ContractStorageStats) ProtoMessage()    {}
+func (*ContractStorageStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2548aa229a1f29bc, []int{11}
+}
+func (m *ContractStorageStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractStorageStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractStorageStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractStorageStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractStorageStats.Merge(m, src)
+}
+func (m *ContractStorageStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractStorageStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractStorageStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractStorageStats proto.InternalMessageInfo
+
+// StorageDeposit is the amount that is locked for the storage of a contract
+type StorageDeposit struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *StorageDeposit) Reset()         { *m = StorageDeposit{} }
+func (m *StorageDeposit) String() string { return proto.CompactTextString(m) }
+func (*StorageDeposit) ProtoMessage()    {}
+func (*StorageDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2548aa229a1f29bc, []int{12}
+}
+func (m *StorageDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StorageDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StorageDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StorageDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageDeposit.Merge(m, src)
+}
+func (m *StorageDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *StorageDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageDeposit proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("cosmwasm.wasm.v1beta1.AccessType", AccessType_name, AccessType_value)
 	proto.RegisterEnum("cosmwasm.wasm.v1beta1.ContractCodeHistoryOperationType", ContractCodeHistoryOperationType_name, ContractCodeHistoryOperationType_value)
 	proto.RegisterType((*AccessTypeParam)(nil), "cosmwasm.wasm.v1beta1.AccessTypeParam")
 	proto.RegisterType((*AccessConfig)(nil), "cosmwasm.wasm.v1beta1.AccessConfig")
 	proto.RegisterType((*Params)(nil), "cosmwasm.wasm.v1beta1.Params")
+	proto.RegisterType((*CodeStorageLimit)(nil), "cosmwasm.wasm.v1beta1.CodeStorageLimit")
 	proto.RegisterType((*CodeInfo)(nil), "cosmwasm.wasm.v1beta1.CodeInfo")
 	proto.RegisterType((*ContractInfo)(nil), "cosmwasm.wasm.v1beta1.ContractInfo")
+	proto.RegisterType((*MigrationAllowlist)(nil), "cosmwasm.wasm.v1beta1.MigrationAllowlist")
 	proto.RegisterType((*ContractCodeHistoryEntry)(nil), "cosmwasm.wasm.v1beta1.ContractCodeHistoryEntry")
 	proto.RegisterType((*AbsoluteTxPosition)(nil), "cosmwasm.wasm.v1beta1.AbsoluteTxPosition")
 	proto.RegisterType((*Model)(nil), "cosmwasm.wasm.v1beta1.Model")
+	proto.RegisterType((*ScheduledCallback)(nil), "cosmwasm.wasm.v1beta1.ScheduledCallback")
+	proto.RegisterType((*ContractStorageStats)(nil), "cosmwasm.wasm.v1beta1.ContractStorageStats")
+	proto.RegisterType((*StorageDeposit)(nil), "cosmwasm.wasm.v1beta1.StorageDeposit")
 }
 
 func init() { proto.RegisterFile("cosmwasm/wasm/v1beta1/types.proto", fileDescriptor_2548aa229a1f29bc) }
 
 var fileDescriptor_2548aa229a1f29bc = []byte{
-	// 1123 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0xda, 0xce, 0xd7, 0x34, 0xb4, 0xee, 0xd0, 0x50, 0xd7, 0x14, 0xdb, 0xd9, 0xf2, 0x91,
-	0x7e, 0x60, 0xd3, 0x82, 0x28, 0xea, 0xcd, 0x5e, 0x2f, 0xcd, 0x56, 0xc4, 0xb6, 0xc6, 0x0e, 0x6d,
-	0x90, 0xd0, 0x6a, 0x76, 0x77, 0xe2, 0x0c, 0xdd, 0xdd, 0xb1, 0x76, 0xc6, 0xad, 0xdd, 0xbf, 0x00,
-	0x85, 0x0b, 0xe2, 0xc4, 0x81, 0x48, 0x48, 0x20, 0xd4, 0x3f, 0xa5, 0x17, 0xa4, 0x1e, 0x39, 0x59,
-	0x90, 0x0a, 0x09, 0xae, 0xe1, 0xd6, 0x13, 0xda, 0x19, 0x5b, 0x5e, 0xd1, 0x36, 0x31, 0x97, 0xd5,
-	0xbc, 0x37, 0xef, 0xf7, 0x7b, 0xef, 0xf7, 0x66, 0xde, 0x6a, 0xc0, 0xba, 0xcb, 0x78, 0xf0, 0x10,
-	0xf3, 0xa0, 0x2a, 0x3f, 0x0f, 0xae, 0x3b, 0x44, 0xe0, 0xeb, 0x55, 0x31, 0xea, 0x13, 0x5e, 0xe9,
-	0x47, 0x4c, 0x30, 0xb8, 0x36, 0x0d, 0xa9, 0xc8, 0xcf, 0x24, 0xa4, 0x70, 0xae, 0xc7, 0x7a, 0x4c,
-	0x46, 0x54, 0xe3, 0x95, 0x0a, 0xd6, 0x1d, 0x70, 0xa6, 0xe6, 0xba, 0x84, 0xf3, 0xee, 0xa8, 0x4f,
-	0xda, 0x38, 0xc2, 0x01, 0xb4, 0xc0, 0xc2, 0x03, 0xec, 0x0f, 0x48, 0x5e, 0x2b, 0x6b, 0x1b, 0xa7,
-	0x6f, 0xac, 0x57, 0x5e, 0xca, 0x57, 0x99, 0xc1, 0xea, 0xb9, 0xa3, 0x71, 0x69, 0x75, 0x84, 0x03,
-	0xff, 0x96, 0x2e, 0x91, 0x3a, 0x52, 0x0c, 0xb7, 0xb2, 0xdf, 0xff, 0x58, 0xd2, 0xf4, 0x1f, 0x34,
-	0xb0, 0xaa, 0xa2, 0x0d, 0x16, 0xee, 0xd2, 0x1e, 0xbc, 0x07, 0x40, 0x9f, 0x44, 0x01, 0xe5, 0x9c,
-	0xb2, 0x70, 0xfe, 0x34, 0x6b, 0x47, 0xe3, 0xd2, 0x59, 0x95, 0x66, 0x06, 0xd7, 0x51, 0x82, 0x0b,
-	0x5e, 0x03, 0x4b, 0xd8, 0xf3, 0x22, 0xc2, 0x79, 0x3e, 0x5d, 0xd6, 0x36, 0x56, 0xea, 0xf0, 0x68,
-	0x5c, 0x3a, 0xad, 0x30, 0x93, 0x0d, 0x1d, 0x4d, 0x43, 0x26, 0xe5, 0xfd, 0x99, 0x06, 0x8b, 0x52,
-	0x39, 0x87, 0x02, 0x40, 0x97, 0x79, 0xc4, 0x1e, 0xf4, 0x7d, 0x86, 0x3d, 0x1b, 0xcb, 0xdc, 0xb2,
-	0xc0, 0x53, 0x37, 0x2e, 0x1d, 0x5b, 0xa0, 0x52, 0x56, 0x5f, 0x7f, 0x32, 0x2e, 0xa5, 0x8e, 0xc6,
-	0xa5, 0x0b, 0x2a, 0xe5, 0x8b, 0x64, 0x3a, 0xca, 0xc5, 0xce, 0x6d, 0xe9, 0x53, 0x50, 0xf8, 0x9d,
-	0x06, 0x8a, 0x34, 0xe4, 0x02, 0x87, 0x82, 0x62, 0x41, 0x6c, 0x8f, 0xec, 0xe2, 0x81, 0x2f, 0xec,
-	0x44, 0x8f, 0xd2, 0xf3, 0xf6, 0xe8, 0xf2, 0xd1, 0xb8, 0xf4, 0x8e, 0x4a, 0x7e, 0x3c, 0xa5, 0x8e,
-	0x2e, 0x26, 0x02, 0x1a, 0x6a, 0xbf, 0x3d, 0xeb, 0xe4, 0x1d, 0x00, 0x03, 0x3c, 0xb4, 0xe3, 0x3c,
-	0xb6, 0x94, 0xc1, 0xe9, 0x23, 0x92, 0xcf, 0x94, 0xb5, 0x8d, 0x6c, 0xfd, 0xad, 0x99, 0xc2, 0x17,
-	0x63, 0x74, 0x74, 0x26, 0xc0, 0xc3, 0xbb, 0x98, 0x07, 0x06, 0xf3, 0x48, 0x87, 0x3e, 0x52, 0xd7,
-	0x20, 0xa5, 0xff, 0xaa, 0x81, 0xe5, 0xd8, 0x65, 0x85, 0xbb, 0x0c, 0xbe, 0x09, 0x56, 0x24, 0x62,
-	0x0f, 0xf3, 0x3d, 0xd9, 0xe0, 0x55, 0xb4, 0x1c, 0x3b, 0x36, 0x31, 0xdf, 0x83, 0x79, 0xb0, 0xe4,
-	0x46, 0x04, 0x0b, 0x16, 0xa9, 0x53, 0x44, 0x53, 0x13, 0xbe, 0x01, 0x16, 0x39, 0x1b, 0x44, 0xae,
-	0xaa, 0x64, 0x05, 0x4d, 0xac, 0x18, 0xe1, 0x0c, 0xa8, 0xef, 0x91, 0x28, 0x9f, 0x55, 0x88, 0x89,
-	0x09, 0xef, 0x01, 0x98, 0x6c, 0x84, 0x2b, 0xcf, 0x29, 0xbf, 0x30, 0xff, 0x91, 0x66, 0xe3, 0x23,
-	0x45, 0x67, 0x13, 0x24, 0x6a, 0x43, 0xff, 0x47, 0x03, 0xab, 0x06, 0x0b, 0x45, 0x84, 0x5d, 0x21,
-	0x35, 0x5d, 0x02, 0x4b, 0x52, 0x13, 0xf5, 0xa4, 0xa2, 0x6c, 0x1d, 0x1c, 0x8e, 0x4b, 0x8b, 0x52,
-	0x72, 0x03, 0x2d, 0xc6, 0x5b, 0x96, 0x77, 0x8c, 0xb6, 0x73, 0x60, 0x01, 0x7b, 0x01, 0x0d, 0x27,
-	0xd2, 0x94, 0x11, 0x7b, 0x7d, 0xec, 0x10, 0x7f, 0xa2, 0x4b, 0x19, 0xd0, 0x98, 0xb0, 0x10, 0x6f,
-	0x22, 0xe5, 0xf2, 0xab, 0xa4, 0x38, 0x9c, 0xf9, 0x03, 0x41, 0xba, 0xc3, 0x36, 0xe3, 0x54, 0x50,
-	0x16, 0xa2, 0x29, 0x12, 0xbe, 0x0f, 0x4e, 0x51, 0xc7, 0xb5, 0xfb, 0x2c, 0x12, 0x71, 0xcd, 0x8b,
-	0x72, 0x60, 0x5e, 0x3b, 0x1c, 0x97, 0x56, 0xac, 0xba, 0xd1, 0x66, 0x91, 0xb0, 0x1a, 0x68, 0x85,
-	0x3a, 0xae, 0x5c, 0x7a, 0xb7, 0xb2, 0x7f, 0xc5, 0xd3, 0xf2, 0x4d, 0x1a, 0xe4, 0xa7, 0xaa, 0x63,
-	0x69, 0x9b, 0x94, 0x0b, 0x16, 0x8d, 0xcc, 0x50, 0x44, 0x23, 0xb8, 0x0d, 0x56, 0x58, 0x9f, 0x44,
-	0x58, 0xcc, 0xe6, 0xfa, 0xe6, 0x2b, 0x0a, 0x7b, 0x09, 0x47, 0x6b, 0x0a, 0x8d, 0x6f, 0x32, 0x9a,
-	0x31, 0x25, 0x1b, 0x9b, 0x7e, 0x65, 0x63, 0x0d, 0xb0, 0x34, 0xe8, 0x7b, 0xb2, 0x25, 0x99, 0xff,
-	0xdd, 0x92, 0x09, 0x12, 0x56, 0x40, 0x26, 0xe0, 0x3d, 0xd9, 0xeb, 0xd5, 0xfa, 0xc5, 0xe7, 0xe3,
-	0x52, 0x9e, 0x84, 0x2e, 0xf3, 0x68, 0xd8, 0xab, 0x7e, 0xc5, 0x59, 0x58, 0x41, 0xf8, 0xe1, 0x16,
-	0xe1, 0x1c, 0xf7, 0x08, 0x8a, 0x03, 0x75, 0x04, 0xe0, 0x8b, 0x74, 0x70, 0x1d, 0xac, 0x3a, 0x3e,
-	0x73, 0xef, 0xdb, 0x7b, 0x84, 0xf6, 0xf6, 0x84, 0xba, 0x0d, 0xe8, 0x94, 0xf4, 0x6d, 0x4a, 0x17,
-	0xbc, 0x00, 0x96, 0xc5, 0xd0, 0xa6, 0xa1, 0x47, 0x86, 0x4a, 0x13, 0x5a, 0x12, 0x43, 0x2b, 0x36,
-	0x75, 0x0a, 0x16, 0xb6, 0x98, 0x47, 0x7c, 0x78, 0x07, 0x64, 0xee, 0x93, 0x91, 0x9a, 0x8e, 0xfa,
-	0x27, 0xcf, 0xc7, 0xa5, 0x8f, 0x7a, 0x54, 0xec, 0x0d, 0x9c, 0x8a, 0xcb, 0x82, 0xaa, 0x20, 0xa1,
-	0x17, 0x4f, 0x6b, 0x28, 0x92, 0x4b, 0x9f, 0x3a, 0xbc, 0xea, 0x8c, 0x04, 0xe1, 0x95, 0x4d, 0x32,
-	0xac, 0xc7, 0x0b, 0x14, 0x93, 0xc4, 0xd7, 0x48, 0xfd, 0xd4, 0xd3, 0x72, 0xd6, 0x94, 0x71, 0xe5,
-	0x6f, 0x0d, 0x80, 0xd9, 0xcf, 0x03, 0x7e, 0x0c, 0xce, 0xd7, 0x0c, 0xc3, 0xec, 0x74, 0xec, 0xee,
-	0x4e, 0xdb, 0xb4, 0xb7, 0x9b, 0x9d, 0xb6, 0x69, 0x58, 0x9f, 0x5a, 0x66, 0x23, 0x97, 0x2a, 0x5c,
-	0xd8, 0x3f, 0x28, 0xaf, 0xcd, 0x82, 0xb7, 0x43, 0xde, 0x27, 0x2e, 0xdd, 0xa5, 0xc4, 0x83, 0xd7,
-	0x00, 0x4c, 0xe2, 0x9a, 0xad, 0x7a, 0xab, 0xb1, 0x93, 0xd3, 0x0a, 0xe7, 0xf6, 0x0f, 0xca, 0xb9,
-	0x19, 0xa4, 0xc9, 0x1c, 0xe6, 0x8d, 0xe0, 0x4d, 0x90, 0x4f, 0x46, 0xb7, 0x9a, 0x9f, 0xed, 0xd8,
-	0xb5, 0x46, 0x03, 0x99, 0x9d, 0x4e, 0x2e, 0xfd, 0xdf, 0x34, 0xad, 0xd0, 0x1f, 0xd5, 0xd4, 0xef,
-	0x1a, 0xde, 0x00, 0x6b, 0x49, 0xa0, 0xf9, 0xb9, 0x89, 0x76, 0x64, 0xa6, 0x4c, 0xe1, 0xfc, 0xfe,
-	0x41, 0xf9, 0xf5, 0x19, 0xca, 0x7c, 0x40, 0xa2, 0x51, 0x9c, 0xac, 0xb0, 0xfc, 0xf5, 0x4f, 0xc5,
-	0xd4, 0xe3, 0x9f, 0x8b, 0xa9, 0x2b, 0xbf, 0x64, 0x40, 0xf9, 0xa4, 0x4b, 0x07, 0x09, 0xf8, 0xc0,
-	0x68, 0x35, 0xbb, 0xa8, 0x66, 0x74, 0x6d, 0xa3, 0xd5, 0x30, 0xed, 0x4d, 0xab, 0xd3, 0x6d, 0xa1,
-	0x1d, 0xbb, 0xd5, 0x36, 0x51, 0xad, 0x6b, 0xb5, 0x9a, 0x2f, 0x6b, 0x4d, 0x75, 0xff, 0xa0, 0x7c,
-	0xf5, 0x24, 0xee, 0x64, 0xc3, 0xee, 0x82, 0xcb, 0x73, 0xa5, 0xb1, 0x9a, 0x56, 0x37, 0xa7, 0x15,
-	0x36, 0xf6, 0x0f, 0xca, 0x6f, 0x9f, 0xc4, 0x6f, 0x85, 0x54, 0xc0, 0x2f, 0xc1, 0xb5, 0xb9, 0x88,
-	0xb7, 0xac, 0xdb, 0xa8, 0xd6, 0x35, 0x73, 0xe9, 0xc2, 0xd5, 0xfd, 0x83, 0xf2, 0x7b, 0x27, 0x71,
-	0x6f, 0xd1, 0x5e, 0x84, 0x05, 0x99, 0x9b, 0xfe, 0xb6, 0xd9, 0x34, 0x3b, 0x56, 0x27, 0x97, 0x99,
-	0x8f, 0xfe, 0x36, 0x09, 0x09, 0xa7, 0xbc, 0x90, 0x8d, 0x0f, 0xab, 0xbe, 0xf9, 0xe4, 0x8f, 0x62,
-	0xea, 0xf1, 0x61, 0x51, 0x7b, 0x72, 0x58, 0xd4, 0x9e, 0x1e, 0x16, 0xb5, 0xdf, 0x0f, 0x8b, 0xda,
-	0xb7, 0xcf, 0x8a, 0xa9, 0xa7, 0xcf, 0x8a, 0xa9, 0xdf, 0x9e, 0x15, 0x53, 0x5f, 0xbc, 0x9b, 0x98,
-	0x03, 0x83, 0xf1, 0xe0, 0xee, 0xf4, 0x3d, 0xe4, 0x55, 0x87, 0xea, 0x5d, 0x24, 0xdf, 0x43, 0xce,
-	0xa2, 0x7c, 0xe3, 0x7c, 0xf8, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd8, 0xd8, 0x83, 0x31, 0x35,
-	0x09, 0x00, 0x00,
+	// 2198 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcb, 0x6f, 0x1b, 0xc7,
+	0x19, 0x17, 0x1f, 0x92, 0xa8, 0xd1, 0x23, 0xd4, 0xe8, 0xb5, 0x62, 0x64, 0x2e, 0xb5, 0x4e, 0x1c,
+	0x39, 0x76, 0xa4, 0xc4, 0x2d, 0x9a, 0xd6, 0x40, 0x8a, 0x92, 0x14, 0x6d, 0x31, 0x8d, 0x44, 0x65,
+	0x28, 0xdb, 0x51, 0x80, 0x60, 0x3b, 0xdc, 0x1d, 0x51, 0x53, 0xed, 0x83, 0xdd, 0x59, 0xda, 0xa2,
+	0xfb, 0x0f, 0x14, 0x6a, 0x0f, 0x41, 0x4f, 0xbd, 0x08, 0x28, 0xd0, 0xa2, 0x08, 0x0a, 0xf4, 0x8f,
+	0xe8, 0xcd, 0x97, 0x02, 0xbe, 0x14, 0xe8, 0x69, 0xdb, 0xca, 0x3d, 0xf4, 0xcc, 0x63, 0x4e, 0xc5,
+	0x3c, 0x96, 0xa4, 0x24, 0x2a, 0x96, 0x81, 0x5e, 0x24, 0xce, 0xf7, 0xf8, 0x7d, 0x8f, 0xf9, 0xbe,
+	0x6f, 0x3e, 0x12, 0xac, 0x5a, 0x3e, 0x73, 0x9f, 0x61, 0xe6, 0x6e, 0x88, 0x3f, 0x4f, 0x3f, 0x6a,
+	0x90, 0x10, 0x7f, 0xb4, 0x11, 0x76, 0x5a, 0x84, 0xad, 0xb7, 0x02, 0x3f, 0xf4, 0xe1, 0x42, 0x2c,
+	0xb2, 0x2e, 0xfe, 0x28, 0x91, 0xdc, 0x7c, 0xd3, 0x6f, 0xfa, 0x42, 0x62, 0x83, 0x7f, 0x92, 0xc2,
+	0xb9, 0x3c, 0x17, 0xf6, 0xd9, 0x46, 0x03, 0x33, 0xd2, 0x43, 0xb3, 0x7c, 0xea, 0x49, 0xbe, 0xd1,
+	0x00, 0x6f, 0x15, 0x2d, 0x8b, 0x30, 0xb6, 0xd7, 0x69, 0x91, 0x5d, 0x1c, 0x60, 0x17, 0x56, 0xc1,
+	0xe8, 0x53, 0xec, 0xb4, 0x89, 0x96, 0x28, 0x24, 0xd6, 0x66, 0xee, 0xad, 0xae, 0x0f, 0xb5, 0xb7,
+	0xde, 0x57, 0x2b, 0x65, 0xbb, 0x91, 0x3e, 0xd5, 0xc1, 0xae, 0x73, 0xdf, 0x10, 0x9a, 0x06, 0x92,
+	0x08, 0xf7, 0xd3, 0xbf, 0xfb, 0xbd, 0x9e, 0x30, 0x5e, 0x26, 0xc0, 0x94, 0x94, 0x2e, 0xfb, 0xde,
+	0x01, 0x6d, 0xc2, 0x2f, 0x00, 0x68, 0x91, 0xc0, 0xa5, 0x8c, 0x51, 0xdf, 0xbb, 0xbe, 0x99, 0x85,
+	0x6e, 0xa4, 0xcf, 0x4a, 0x33, 0x7d, 0x75, 0x03, 0x0d, 0x60, 0xc1, 0xbb, 0x60, 0x1c, 0xdb, 0x76,
+	0x40, 0x18, 0xd3, 0x92, 0x85, 0xc4, 0xda, 0x44, 0x09, 0x76, 0x23, 0x7d, 0x46, 0xea, 0x28, 0x86,
+	0x81, 0x62, 0x11, 0x78, 0x0f, 0x4c, 0xa8, 0x8f, 0x84, 0x69, 0xa9, 0x42, 0x6a, 0x6d, 0xa2, 0x34,
+	0xdf, 0x8d, 0xf4, 0xec, 0x39, 0x79, 0xc2, 0x0c, 0xd4, 0x17, 0x53, 0x21, 0xfd, 0x7d, 0x16, 0x8c,
+	0x89, 0x6c, 0x31, 0x18, 0x02, 0x68, 0xf9, 0x36, 0x31, 0xdb, 0x2d, 0xc7, 0xc7, 0xb6, 0x89, 0x85,
+	0xbf, 0x22, 0xa8, 0xc9, 0x7b, 0x37, 0xbf, 0x33, 0x28, 0x99, 0x8d, 0xd2, 0xea, 0x8b, 0x48, 0x1f,
+	0xe9, 0x46, 0xfa, 0xb2, 0x34, 0x7b, 0x19, 0xcc, 0x40, 0x59, 0x4e, 0x7c, 0x24, 0x68, 0x52, 0x15,
+	0xfe, 0x36, 0x01, 0xf2, 0xd4, 0x63, 0x21, 0xf6, 0x42, 0x8a, 0x43, 0x62, 0xda, 0xe4, 0x00, 0xb7,
+	0x9d, 0xd0, 0x1c, 0xc8, 0x6b, 0xf2, 0xba, 0x79, 0xbd, 0xdd, 0x8d, 0xf4, 0x77, 0xa5, 0xf1, 0xef,
+	0x86, 0x34, 0xd0, 0xca, 0x80, 0xc0, 0xa6, 0xe4, 0xef, 0xf6, 0xb3, 0xff, 0x29, 0x80, 0x2e, 0x3e,
+	0x36, 0xb9, 0x1d, 0x53, 0x84, 0xc1, 0xe8, 0x73, 0xa2, 0xa5, 0x0a, 0x89, 0xb5, 0x74, 0xe9, 0x46,
+	0x3f, 0xc2, 0xcb, 0x32, 0x06, 0x7a, 0xcb, 0xc5, 0xc7, 0x4f, 0x30, 0x73, 0xcb, 0xbe, 0x4d, 0xea,
+	0xf4, 0x39, 0x81, 0x3f, 0x03, 0xcb, 0x3c, 0xfa, 0x56, 0x48, 0x6c, 0x93, 0x85, 0x38, 0x68, 0x72,
+	0x97, 0x7e, 0xd1, 0x26, 0x01, 0x25, 0x4c, 0x4b, 0x8b, 0xbb, 0x7a, 0xa7, 0x1b, 0xe9, 0x05, 0x75,
+	0x57, 0x57, 0x89, 0x1a, 0x68, 0x29, 0xe6, 0xd5, 0x15, 0xeb, 0x73, 0xc9, 0x81, 0x4f, 0xc0, 0xe2,
+	0x65, 0x35, 0x97, 0x35, 0x99, 0x36, 0x2a, 0xe0, 0x57, 0xbb, 0x91, 0x7e, 0xe3, 0x2a, 0x78, 0x2e,
+	0x67, 0xa0, 0xf9, 0x8b, 0xd8, 0xdb, 0xac, 0xc9, 0xe0, 0xe7, 0x60, 0xde, 0x26, 0x1e, 0xbd, 0x04,
+	0x3b, 0x26, 0x60, 0xf5, 0x6e, 0xa4, 0xbf, 0x2d, 0x61, 0x87, 0x49, 0x19, 0x08, 0x4a, 0xf2, 0x39,
+	0xc8, 0x9f, 0x80, 0x99, 0x26, 0x66, 0xa6, 0xdb, 0x76, 0x42, 0xda, 0x72, 0x28, 0x09, 0xb4, 0x71,
+	0x91, 0xd5, 0xe5, 0x6e, 0xa4, 0x2f, 0x48, 0xb0, 0xf3, 0x7c, 0x03, 0x4d, 0x37, 0x31, 0xdb, 0xee,
+	0x9d, 0xe1, 0x27, 0x60, 0x5a, 0xde, 0x9d, 0x45, 0x4c, 0xcb, 0x67, 0xa1, 0x96, 0x11, 0x00, 0x5a,
+	0x37, 0xd2, 0xe7, 0x07, 0xef, 0x5e, 0xb1, 0x0d, 0x34, 0x15, 0x9f, 0xcb, 0x3e, 0x0b, 0xe1, 0x7d,
+	0x30, 0x65, 0xf9, 0x6e, 0x8b, 0x3a, 0x4a, 0x7b, 0x42, 0x68, 0x2f, 0x75, 0x23, 0x7d, 0x2e, 0x2e,
+	0xdb, 0x3e, 0xd7, 0x40, 0x93, 0xea, 0x28, 0x74, 0x3f, 0x01, 0xd3, 0x87, 0x6d, 0x17, 0x7b, 0xf4,
+	0xb9, 0x52, 0x06, 0x17, 0x4d, 0x9f, 0x63, 0x1b, 0x68, 0x2a, 0x3e, 0x0b, 0xf5, 0x2a, 0x98, 0xb5,
+	0xb0, 0xe7, 0x7b, 0xd4, 0xc2, 0x4e, 0x0f, 0x62, 0x52, 0x40, 0xac, 0x74, 0x23, 0x5d, 0x53, 0xf6,
+	0x2f, 0x8a, 0xf0, 0xae, 0x19, 0xa0, 0x09, 0xa8, 0x3d, 0xb0, 0x60, 0xf9, 0x5e, 0x18, 0x60, 0x2b,
+	0x34, 0x5d, 0xe2, 0xfa, 0x41, 0xc7, 0x74, 0xa8, 0x4b, 0x43, 0x6d, 0xaa, 0x90, 0x58, 0x9b, 0x2e,
+	0x15, 0xba, 0x91, 0xbe, 0x12, 0x87, 0x33, 0x44, 0xcc, 0x40, 0x73, 0x31, 0x7d, 0x5b, 0x90, 0x3f,
+	0xe3, 0x54, 0xd8, 0x00, 0x39, 0x5e, 0xd2, 0x3d, 0x15, 0x16, 0xfa, 0x01, 0x6e, 0x12, 0xb3, 0xd1,
+	0x09, 0x09, 0xd3, 0xa6, 0x85, 0xa7, 0xef, 0x76, 0x23, 0x7d, 0xb5, 0x5f, 0xfe, 0xc3, 0x65, 0x0d,
+	0xb4, 0xe4, 0xe2, 0xe3, 0xb2, 0xe2, 0xd5, 0x25, 0xab, 0xc4, 0x39, 0xf0, 0x97, 0x60, 0x4e, 0x76,
+	0x8b, 0x92, 0x17, 0xfe, 0x30, 0x6d, 0xa6, 0x90, 0x5a, 0x9b, 0xbc, 0xf7, 0xde, 0x15, 0x3d, 0x2e,
+	0x9a, 0x49, 0x2a, 0x08, 0x4f, 0x4b, 0x86, 0x1a, 0x35, 0xb9, 0x81, 0x51, 0x73, 0x1e, 0xd1, 0x40,
+	0xb3, 0xd6, 0x05, 0x2d, 0x06, 0xff, 0x92, 0x00, 0x5a, 0x2c, 0x66, 0x93, 0x96, 0xcf, 0xa8, 0x98,
+	0x0a, 0xc2, 0x69, 0xed, 0x2d, 0xe1, 0xc2, 0xca, 0xba, 0x7c, 0x68, 0xd6, 0xf9, 0x43, 0xd3, 0x73,
+	0x60, 0x93, 0x58, 0x65, 0x9f, 0x7a, 0xa5, 0xc7, 0xca, 0xae, 0x2e, 0xed, 0x5e, 0x85, 0x65, 0xfc,
+	0xf9, 0x9f, 0xfa, 0x9d, 0x26, 0x0d, 0x0f, 0xdb, 0x8d, 0x75, 0xcb, 0x77, 0x37, 0xd4, 0xdb, 0x25,
+	0xff, 0x7d, 0xc0, 0xec, 0x23, 0xf5, 0x0e, 0x2a, 0x58, 0x86, 0x16, 0x14, 0xd2, 0xa6, 0x04, 0xda,
+	0x25, 0x01, 0xcf, 0x16, 0x3c, 0x02, 0x37, 0x2e, 0x9a, 0x38, 0x08, 0x7c, 0xb7, 0x97, 0x75, 0x2d,
+	0x5b, 0x48, 0xac, 0x65, 0x4a, 0x6b, 0xdd, 0x48, 0x7f, 0x67, 0xb8, 0x47, 0xe7, 0xc4, 0x0d, 0x94,
+	0x3b, 0x6f, 0xe7, 0x41, 0xe0, 0xbb, 0xf1, 0x2d, 0xc1, 0xaf, 0x80, 0x66, 0x13, 0xbb, 0xdd, 0x72,
+	0xa8, 0xc5, 0x7b, 0x78, 0x60, 0x7c, 0x33, 0x6d, 0x56, 0xd8, 0xb9, 0xd9, 0x8f, 0xfc, 0x2a, 0x49,
+	0x03, 0x2d, 0x0e, 0xb0, 0xca, 0xbd, 0x69, 0xcf, 0xe0, 0xd7, 0x09, 0x90, 0x75, 0xa9, 0x67, 0x5a,
+	0xd8, 0x71, 0x1a, 0xd8, 0x3a, 0x32, 0x0f, 0x08, 0xd1, 0xa0, 0xc8, 0xf9, 0xf2, 0xd0, 0x9c, 0x8b,
+	0x84, 0xff, 0x54, 0x25, 0x7c, 0x49, 0x95, 0xdc, 0x05, 0x00, 0x9e, 0xe8, 0xb5, 0x6b, 0x24, 0x5a,
+	0x66, 0x79, 0xc6, 0xa5, 0x5e, 0x59, 0x69, 0x3f, 0x20, 0x24, 0xae, 0x77, 0x66, 0x1d, 0x12, 0xbb,
+	0xed, 0x10, 0xbb, 0x0f, 0xdd, 0xc4, 0x4c, 0x9b, 0x1b, 0x56, 0xef, 0xc3, 0x65, 0x65, 0xbd, 0xd7,
+	0x63, 0x5e, 0x6c, 0xe3, 0x21, 0x66, 0x10, 0x83, 0x38, 0xe7, 0x66, 0x40, 0xb0, 0x2d, 0x3a, 0xba,
+	0x5f, 0x73, 0xf3, 0x17, 0x6d, 0x5c, 0x2d, 0x6b, 0xa0, 0x45, 0xc5, 0x44, 0x04, 0xdb, 0x7c, 0x0a,
+	0xc4, 0x55, 0x62, 0x83, 0xb7, 0x63, 0xb5, 0x67, 0x01, 0x0d, 0xc9, 0x05, 0x1b, 0x0b, 0xc2, 0xc6,
+	0xad, 0x6e, 0xa4, 0x1b, 0xe7, 0x6d, 0x0c, 0x11, 0x36, 0xd0, 0x92, 0xe2, 0x3e, 0xe1, 0xcc, 0x41,
+	0x2b, 0x8f, 0x41, 0x6c, 0xdf, 0xa4, 0x21, 0x09, 0x4c, 0x8f, 0x1c, 0x87, 0x72, 0x84, 0x2d, 0x0a,
+	0x03, 0x03, 0xaf, 0xcc, 0x70, 0x39, 0x03, 0xcd, 0x29, 0x46, 0x35, 0x24, 0xc1, 0x0e, 0x39, 0x0e,
+	0xc5, 0x28, 0xdb, 0x01, 0x73, 0xfd, 0xa2, 0x75, 0x88, 0xf2, 0x48, 0x5b, 0x12, 0xa0, 0xf9, 0x7e,
+	0x8f, 0x0f, 0x11, 0x32, 0xd0, 0x6c, 0xaf, 0x9e, 0x39, 0x91, 0xe3, 0x89, 0xbd, 0x66, 0xc4, 0xd8,
+	0x03, 0xd9, 0x8b, 0x43, 0x03, 0xde, 0x04, 0xe3, 0xa2, 0x54, 0xa9, 0x2d, 0xb6, 0x9a, 0x74, 0x09,
+	0x9c, 0x45, 0xfa, 0x18, 0x17, 0xab, 0x6e, 0xa2, 0x31, 0xce, 0xaa, 0xda, 0xf0, 0x6d, 0x30, 0xc1,
+	0xef, 0x59, 0x8e, 0x3c, 0xbe, 0x79, 0xa4, 0x51, 0xc6, 0xc5, 0xc7, 0x62, 0x78, 0x19, 0x7f, 0x4b,
+	0x80, 0x8c, 0x90, 0xf7, 0x0e, 0x7c, 0x2e, 0x29, 0xe0, 0x0e, 0x31, 0x3b, 0x14, 0x80, 0x53, 0x28,
+	0xc3, 0x09, 0x5b, 0x98, 0x1d, 0x42, 0x0d, 0x8c, 0x5b, 0x01, 0xc1, 0xa1, 0x1f, 0xc8, 0xfd, 0x0d,
+	0xc5, 0x47, 0xb8, 0x08, 0xc6, 0x98, 0xdf, 0x0e, 0x2c, 0xb9, 0x4f, 0x4c, 0x20, 0x75, 0xe2, 0x1a,
+	0x8d, 0x36, 0x75, 0x6c, 0x12, 0x68, 0x69, 0xa9, 0xa1, 0x8e, 0xf0, 0x0b, 0x00, 0x07, 0xd7, 0x19,
+	0x4b, 0x6c, 0x5b, 0xda, 0xe8, 0xf5, 0x17, 0xb3, 0x34, 0x6f, 0x22, 0x34, 0x3b, 0x00, 0x22, 0x19,
+	0xc6, 0x6f, 0x52, 0x60, 0x2a, 0xee, 0x7f, 0x11, 0xd3, 0xb5, 0x52, 0x74, 0x75, 0x6c, 0xf3, 0x60,
+	0x14, 0xdb, 0x2e, 0xf5, 0x54, 0x68, 0xf2, 0xc0, 0xa9, 0x0e, 0x6e, 0x10, 0x47, 0xc5, 0x25, 0x0f,
+	0xb0, 0xac, 0x50, 0x88, 0xad, 0x42, 0xb9, 0x7d, 0x55, 0x28, 0x0d, 0xe6, 0x3b, 0xed, 0x90, 0xec,
+	0x1d, 0xef, 0xf2, 0x99, 0x45, 0x7d, 0x0f, 0xc5, 0x9a, 0xf0, 0x03, 0x30, 0x49, 0x1b, 0x96, 0xd9,
+	0xf2, 0x83, 0x90, 0xfb, 0x3c, 0x26, 0x56, 0xe5, 0xe9, 0xb3, 0x48, 0x9f, 0xa8, 0x96, 0xca, 0xbb,
+	0x7e, 0x10, 0x56, 0x37, 0xd1, 0x04, 0x6d, 0x58, 0xe2, 0xa3, 0xcd, 0x73, 0xdf, 0xc2, 0x6d, 0x46,
+	0x6c, 0xb1, 0x75, 0x64, 0x90, 0x3a, 0xc1, 0x9b, 0x60, 0xba, 0x45, 0x3c, 0x9b, 0x7a, 0x4d, 0x53,
+	0xfa, 0x9f, 0x11, 0x9e, 0x4e, 0x29, 0x62, 0x51, 0x84, 0xf1, 0x25, 0x98, 0x73, 0x69, 0x33, 0xc0,
+	0xdc, 0x03, 0x13, 0x3b, 0x8e, 0xff, 0xcc, 0xa1, 0x6a, 0x81, 0xb8, 0xda, 0xf9, 0xed, 0x58, 0xa3,
+	0x18, 0x2b, 0x20, 0xe8, 0x5e, 0xa2, 0xdd, 0x4f, 0xff, 0x97, 0x2f, 0xe3, 0x18, 0xc0, 0xcb, 0xf2,
+	0xf0, 0x16, 0xc8, 0xa8, 0x3b, 0xe1, 0xdb, 0x78, 0x6a, 0x2d, 0x5d, 0x9a, 0x3c, 0x8b, 0xf4, 0x71,
+	0x79, 0x29, 0x0c, 0x8d, 0xcb, 0x5b, 0x61, 0x3c, 0x08, 0x21, 0xa7, 0x2e, 0x83, 0x57, 0x6f, 0x8a,
+	0x07, 0xc1, 0x89, 0x65, 0x45, 0x33, 0x7e, 0x9d, 0x04, 0x5a, 0x7c, 0xe3, 0x1c, 0x61, 0x8b, 0xf2,
+	0x0e, 0xea, 0x54, 0xbc, 0x30, 0xe8, 0xc0, 0x47, 0x60, 0xc2, 0x6f, 0x11, 0x69, 0x5f, 0x7d, 0x9b,
+	0xf9, 0xf8, 0xca, 0x17, 0xf9, 0x12, 0x46, 0x2d, 0x56, 0xe5, 0xbb, 0x38, 0xea, 0x23, 0x0d, 0x16,
+	0x55, 0xf2, 0xca, 0xa2, 0x2a, 0x83, 0xf1, 0x76, 0xcb, 0x16, 0xe5, 0x90, 0x7a, 0xe3, 0x72, 0x50,
+	0x9a, 0x70, 0x1d, 0xa4, 0x5c, 0xd6, 0x14, 0x75, 0x36, 0x55, 0x5a, 0xf9, 0x36, 0xd2, 0x35, 0xe2,
+	0x59, 0x3e, 0xbf, 0xc2, 0x8d, 0x9f, 0x33, 0xdf, 0x5b, 0x47, 0xf8, 0xd9, 0x36, 0x61, 0x8c, 0xcf,
+	0x50, 0x2e, 0x68, 0x20, 0x00, 0x2f, 0xc3, 0xc1, 0x55, 0x30, 0xd5, 0x70, 0x7c, 0xeb, 0xc8, 0x3c,
+	0x24, 0xb4, 0x79, 0x18, 0xca, 0x4e, 0x40, 0x93, 0x82, 0xb6, 0x25, 0x48, 0x70, 0x19, 0x64, 0xc2,
+	0x63, 0x93, 0x7a, 0x36, 0x39, 0x56, 0x43, 0x62, 0x3c, 0x3c, 0xae, 0xf2, 0xa3, 0x41, 0xc1, 0xe8,
+	0xb6, 0x6f, 0x13, 0x07, 0x7e, 0x0a, 0x52, 0x47, 0xa4, 0x23, 0x27, 0x43, 0xe9, 0x87, 0xdf, 0x46,
+	0xfa, 0xf7, 0x07, 0x1e, 0xaa, 0x90, 0x78, 0x36, 0xff, 0xbe, 0xe1, 0x85, 0x83, 0x1f, 0x1d, 0xda,
+	0x60, 0x1b, 0x62, 0xf4, 0xac, 0x6f, 0x11, 0x39, 0x73, 0x10, 0x07, 0xe1, 0x2d, 0x24, 0xbf, 0xca,
+	0x26, 0xc5, 0x9c, 0x91, 0x07, 0xe3, 0xaf, 0x49, 0x30, 0x7b, 0xe9, 0xd1, 0x81, 0x8b, 0x20, 0xd9,
+	0x6b, 0xdf, 0xb1, 0xb3, 0x48, 0x4f, 0x56, 0x37, 0x51, 0x92, 0xda, 0x30, 0xc7, 0xeb, 0x48, 0xed,
+	0x0d, 0xb2, 0x6f, 0x7b, 0xe7, 0xc1, 0x96, 0x4e, 0x9d, 0x6f, 0xe9, 0x37, 0x4c, 0x29, 0x6f, 0x31,
+	0x95, 0xb6, 0x51, 0x91, 0x17, 0x75, 0xe2, 0xd6, 0xa9, 0x17, 0x92, 0xe0, 0x29, 0x76, 0x44, 0x9b,
+	0xa6, 0x51, 0xef, 0xcc, 0x27, 0x29, 0x5f, 0xfa, 0xe5, 0x06, 0x3b, 0x2e, 0x99, 0x4d, 0xcc, 0xe4,
+	0xd4, 0xfe, 0x0a, 0xa4, 0xf8, 0xa6, 0x90, 0x79, 0xdd, 0xa6, 0xf0, 0x21, 0x1f, 0x72, 0x6f, 0xb4,
+	0x0e, 0x70, 0x5c, 0x03, 0x81, 0xf9, 0x0b, 0x7b, 0x6a, 0x3d, 0xc4, 0x21, 0xe3, 0x3e, 0x1d, 0x91,
+	0x8e, 0x69, 0xf9, 0x6d, 0x2f, 0xae, 0x80, 0xcc, 0x11, 0xe9, 0x94, 0xf9, 0x19, 0xde, 0x00, 0x80,
+	0xdf, 0x92, 0xe2, 0xca, 0x02, 0x98, 0xe0, 0x14, 0xc1, 0x36, 0xda, 0x60, 0xa6, 0x7e, 0x6e, 0xcf,
+	0x82, 0x16, 0x18, 0xc3, 0xae, 0x82, 0xfa, 0xbf, 0xc7, 0xa1, 0xa0, 0xdf, 0xff, 0x4f, 0x12, 0x80,
+	0xfe, 0xb7, 0x61, 0xf8, 0x03, 0xb0, 0x54, 0x2c, 0x97, 0x2b, 0xf5, 0xba, 0xb9, 0xb7, 0xbf, 0x5b,
+	0x31, 0x1f, 0xed, 0xd4, 0x77, 0x2b, 0xe5, 0xea, 0x83, 0x6a, 0x65, 0x33, 0x3b, 0x92, 0x5b, 0x3e,
+	0x39, 0x2d, 0x2c, 0xf4, 0x85, 0x1f, 0x79, 0xac, 0x45, 0x2c, 0x7a, 0x40, 0x89, 0x0d, 0xef, 0x02,
+	0x38, 0xa8, 0xb7, 0x53, 0x2b, 0xd5, 0x36, 0xf7, 0xb3, 0x89, 0xdc, 0xfc, 0xc9, 0x69, 0x21, 0xdb,
+	0x57, 0xd9, 0xf1, 0x1b, 0xbe, 0xdd, 0x81, 0x1f, 0x03, 0x6d, 0x50, 0xba, 0xb6, 0xf3, 0xd9, 0xbe,
+	0x59, 0xdc, 0xdc, 0x44, 0x95, 0x7a, 0x3d, 0x9b, 0xbc, 0x68, 0xa6, 0xe6, 0x39, 0x9d, 0x62, 0xef,
+	0x37, 0x8b, 0x85, 0x41, 0xc5, 0xca, 0xe3, 0x0a, 0xda, 0x17, 0x96, 0x52, 0xb9, 0xa5, 0x93, 0xd3,
+	0xc2, 0x5c, 0x5f, 0xab, 0xf2, 0x94, 0x04, 0x1d, 0x61, 0xec, 0xc7, 0x60, 0x65, 0x50, 0xa7, 0xb8,
+	0xb3, 0x6f, 0xd6, 0x1e, 0xc4, 0xe6, 0x2a, 0xf5, 0x6c, 0x3a, 0xb7, 0x72, 0x72, 0x5a, 0xd0, 0xfa,
+	0xaa, 0x45, 0xaf, 0x53, 0x3b, 0x28, 0xc6, 0xbf, 0x79, 0xc0, 0x1f, 0x81, 0xe5, 0x4b, 0xce, 0x96,
+	0x6b, 0x3b, 0x7b, 0xa8, 0x58, 0xde, 0xcb, 0x8e, 0xe6, 0x72, 0x27, 0xa7, 0x85, 0xc5, 0xf3, 0xde,
+	0xc6, 0xb5, 0x91, 0xcb, 0xfc, 0xea, 0x0f, 0xf9, 0x91, 0x6f, 0xfe, 0x98, 0x1f, 0x79, 0xff, 0x4f,
+	0x29, 0x50, 0x78, 0xdd, 0xf8, 0x83, 0x04, 0x7c, 0x18, 0x03, 0x9b, 0xe5, 0xda, 0x66, 0xc5, 0xdc,
+	0xaa, 0xd6, 0xf7, 0x6a, 0x68, 0xdf, 0xac, 0xed, 0x56, 0x50, 0x71, 0xaf, 0x5a, 0xdb, 0x19, 0x76,
+	0x2b, 0x1b, 0x27, 0xa7, 0x85, 0x3b, 0xaf, 0xc3, 0x1e, 0xbc, 0xab, 0x27, 0xe0, 0xf6, 0xb5, 0xcc,
+	0x54, 0x77, 0xaa, 0x7b, 0xd9, 0x44, 0x6e, 0xed, 0xe4, 0xb4, 0xf0, 0xce, 0xeb, 0xf0, 0xab, 0x9e,
+	0xe8, 0xba, 0xbb, 0xd7, 0x02, 0xde, 0xae, 0x3e, 0x44, 0xc5, 0xbd, 0x4a, 0x36, 0x99, 0xbb, 0x73,
+	0x72, 0x5a, 0x78, 0xef, 0x75, 0xd8, 0xf2, 0x79, 0x23, 0xd7, 0x86, 0x7f, 0x58, 0xd9, 0xa9, 0xd4,
+	0xab, 0xf5, 0x6c, 0xea, 0x7a, 0xf0, 0x0f, 0x89, 0x47, 0x18, 0x65, 0xb9, 0x34, 0xbf, 0xac, 0xd2,
+	0xd6, 0x8b, 0x7f, 0xe7, 0x47, 0xbe, 0x39, 0xcb, 0x27, 0x5e, 0x9c, 0xe5, 0x13, 0x2f, 0xcf, 0xf2,
+	0x89, 0x7f, 0x9d, 0xe5, 0x13, 0x5f, 0xbf, 0xca, 0x8f, 0xbc, 0x7c, 0x95, 0x1f, 0xf9, 0xc7, 0xab,
+	0xfc, 0xc8, 0x97, 0xb7, 0x06, 0x7a, 0xac, 0xec, 0x33, 0xf7, 0x49, 0xfc, 0x7b, 0xa5, 0xbd, 0x71,
+	0x2c, 0xfe, 0xcb, 0x3e, 0x6b, 0x8c, 0x89, 0xdf, 0x18, 0xbf, 0xf7, 0xbf, 0x01, 0x00, 0x03, 0xfb,
+	0x67, 0x1f, 0xd5, 0x14, 0x00, 0x00,
 }
 
 func (this *AccessTypeParam) Equal(that interface{}) bool {
@@ -574,6 +949,14 @@ func (this *AccessConfig) Equal(that interface{}) bool {
 	if this.Address != that1.Address {
 		return false
 	}
+	if len(this.Addresses) != len(that1.Addresses) {
+		return false
+	}
+	for i := range this.Addresses {
+		if this.Addresses[i] != that1.Addresses[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *Params) Equal(that interface{}) bool {
@@ -604,6 +987,123 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.MaxWasmCodeSize != that1.MaxWasmCodeSize {
 		return false
 	}
+	if len(this.AcceptedStargateQueries) != len(that1.AcceptedStargateQueries) {
+		return false
+	}
+	for i := range this.AcceptedStargateQueries {
+		if this.AcceptedStargateQueries[i] != that1.AcceptedStargateQueries[i] {
+			return false
+		}
+	}
+	if len(this.AcceptedStargateMsgs) != len(that1.AcceptedStargateMsgs) {
+		return false
+	}
+	for i := range this.AcceptedStargateMsgs {
+		if this.AcceptedStargateMsgs[i] != that1.AcceptedStargateMsgs[i] {
+			return false
+		}
+	}
+	if len(this.DeniedStargateMsgs) != len(that1.DeniedStargateMsgs) {
+		return false
+	}
+	for i := range this.DeniedStargateMsgs {
+		if this.DeniedStargateMsgs[i] != that1.DeniedStargateMsgs[i] {
+			return false
+		}
+	}
+	if this.GasMultiplier != that1.GasMultiplier {
+		return false
+	}
+	if this.InstanceCost != that1.InstanceCost {
+		return false
+	}
+	if this.CompileCost != that1.CompileCost {
+		return false
+	}
+	if this.HumanizeCost != that1.HumanizeCost {
+		return false
+	}
+	if this.CanonicalizeCost != that1.CanonicalizeCost {
+		return false
+	}
+	if this.ContractMemoryLimit != that1.ContractMemoryLimit {
+		return false
+	}
+	if this.MaxContractStorageBytes != that1.MaxContractStorageBytes {
+		return false
+	}
+	if len(this.CodeStorageLimits) != len(that1.CodeStorageLimits) {
+		return false
+	}
+	for i := range this.CodeStorageLimits {
+		if !this.CodeStorageLimits[i].Equal(&that1.CodeStorageLimits[i]) {
+			return false
+		}
+	}
+	if len(this.StorageDepositPerByte) != len(that1.StorageDepositPerByte) {
+		return false
+	}
+	for i := range this.StorageDepositPerByte {
+		if !this.StorageDepositPerByte[i].Equal(&that1.StorageDepositPerByte[i]) {
+			return false
+		}
+	}
+	if this.StorageDepositFromContract != that1.StorageDepositFromContract {
+		return false
+	}
+	if this.DeduplicateCodeUploads != that1.DeduplicateCodeUploads {
+		return false
+	}
+	if len(this.MinCallbackFee) != len(that1.MinCallbackFee) {
+		return false
+	}
+	for i := range this.MinCallbackFee {
+		if !this.MinCallbackFee[i].Equal(&that1.MinCallbackFee[i]) {
+			return false
+		}
+	}
+	if this.MaxScheduledCallbackGas != that1.MaxScheduledCallbackGas {
+		return false
+	}
+	if this.StorageReadCostPerByte != that1.StorageReadCostPerByte {
+		return false
+	}
+	if this.StorageWriteCostPerByte != that1.StorageWriteCostPerByte {
+		return false
+	}
+	if this.StorageIterNextCost != that1.StorageIterNextCost {
+		return false
+	}
+	if this.StorageDeleteCost != that1.StorageDeleteCost {
+		return false
+	}
+	return true
+}
+func (this *CodeStorageLimit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CodeStorageLimit)
+	if !ok {
+		that2, ok := that.(CodeStorageLimit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.CodeID != that1.CodeID {
+		return false
+	}
+	if this.MaxBytes != that1.MaxBytes {
+		return false
+	}
 	return true
 }
 func (this *CodeInfo) Equal(that interface{}) bool {
@@ -679,6 +1179,52 @@ func (this *ContractInfo) Equal(that interface{}) bool {
 	if this.IBCPortID != that1.IBCPortID {
 		return false
 	}
+	if this.Paused != that1.Paused {
+		return false
+	}
+	if this.PendingAdmin != that1.PendingAdmin {
+		return false
+	}
+	if !this.MigrationAllowlist.Equal(that1.MigrationAllowlist) {
+		return false
+	}
+	return true
+}
+func (this *MigrationAllowlist) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MigrationAllowlist)
+	if !ok {
+		that2, ok := that.(MigrationAllowlist)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.CodeIDs) != len(that1.CodeIDs) {
+		return false
+	}
+	for i := range this.CodeIDs {
+		if this.CodeIDs[i] != that1.CodeIDs[i] {
+			return false
+		}
+	}
+	if len(this.CodeCreators) != len(that1.CodeCreators) {
+		return false
+	}
+	for i := range this.CodeCreators {
+		if this.CodeCreators[i] != that1.CodeCreators[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *ContractCodeHistoryEntry) Equal(that interface{}) bool {
@@ -768,26 +1314,132 @@ func (this *Model) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (m *AccessTypeParam) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *ScheduledCallback) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AccessTypeParam) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *AccessTypeParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
+	that1, ok := that.(*ScheduledCallback)
+	if !ok {
+		that2, ok := that.(ScheduledCallback)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ID != that1.ID {
+		return false
+	}
+	if this.Contract != that1.Contract {
+		return false
+	}
+	if this.Creator != that1.Creator {
+		return false
+	}
+	if !bytes.Equal(this.Msg, that1.Msg) {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if this.Interval != that1.Interval {
+		return false
+	}
+	if this.GasLimit != that1.GasLimit {
+		return false
+	}
+	if len(this.Fee) != len(that1.Fee) {
+		return false
+	}
+	for i := range this.Fee {
+		if !this.Fee[i].Equal(&that1.Fee[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ContractStorageStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ContractStorageStats)
+	if !ok {
+		that2, ok := that.(ContractStorageStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.KeyCount != that1.KeyCount {
+		return false
+	}
+	if this.ByteCount != that1.ByteCount {
+		return false
+	}
+	return true
+}
+func (this *StorageDeposit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StorageDeposit)
+	if !ok {
+		that2, ok := that.(StorageDeposit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (m *AccessTypeParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccessTypeParam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccessTypeParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.Value != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.Value))
 		i--
@@ -816,6 +1468,15 @@ func (m *AccessConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Address) > 0 {
 		i -= len(m.Address)
 		copy(dAtA[i:], m.Address)
@@ -851,6 +1512,171 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.StorageDeleteCost != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StorageDeleteCost))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.StorageIterNextCost != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StorageIterNextCost))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.StorageWriteCostPerByte != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StorageWriteCostPerByte))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.StorageReadCostPerByte != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StorageReadCostPerByte))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.MaxScheduledCallbackGas != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxScheduledCallbackGas))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.MinCallbackFee) > 0 {
+		for iNdEx := len(m.MinCallbackFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinCallbackFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if m.DeduplicateCodeUploads {
+		i--
+		if m.DeduplicateCodeUploads {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.StorageDepositFromContract {
+		i--
+		if m.StorageDepositFromContract {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.StorageDepositPerByte) > 0 {
+		for iNdEx := len(m.StorageDepositPerByte) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StorageDepositPerByte[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.CodeStorageLimits) > 0 {
+		for iNdEx := len(m.CodeStorageLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CodeStorageLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.MaxContractStorageBytes != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxContractStorageBytes))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.ContractMemoryLimit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ContractMemoryLimit))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.CanonicalizeCost != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CanonicalizeCost))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.HumanizeCost != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.HumanizeCost))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.CompileCost != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CompileCost))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.InstanceCost != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.InstanceCost))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.GasMultiplier != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GasMultiplier))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.DeniedStargateMsgs) > 0 {
+		for iNdEx := len(m.DeniedStargateMsgs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedStargateMsgs[iNdEx])
+			copy(dAtA[i:], m.DeniedStargateMsgs[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.DeniedStargateMsgs[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.AcceptedStargateMsgs) > 0 {
+		for iNdEx := len(m.AcceptedStargateMsgs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AcceptedStargateMsgs[iNdEx])
+			copy(dAtA[i:], m.AcceptedStargateMsgs[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.AcceptedStargateMsgs[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.AcceptedStargateQueries) > 0 {
+		for iNdEx := len(m.AcceptedStargateQueries) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AcceptedStargateQueries[iNdEx])
+			copy(dAtA[i:], m.AcceptedStargateQueries[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.AcceptedStargateQueries[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.MaxWasmCodeSize != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.MaxWasmCodeSize))
 		i--
@@ -874,6 +1700,39 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CodeStorageLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CodeStorageLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CodeStorageLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxBytes != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxBytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CodeInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -955,6 +1814,35 @@ func (m *ContractInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MigrationAllowlist != nil {
+		{
+			size, err := m.MigrationAllowlist.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.PendingAdmin) > 0 {
+		i -= len(m.PendingAdmin)
+		copy(dAtA[i:], m.PendingAdmin)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.PendingAdmin)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.IBCPortID) > 0 {
 		i -= len(m.IBCPortID)
 		copy(dAtA[i:], m.IBCPortID)
@@ -1003,6 +1891,56 @@ func (m *ContractInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MigrationAllowlist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MigrationAllowlist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MigrationAllowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CodeCreators) > 0 {
+		for iNdEx := len(m.CodeCreators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CodeCreators[iNdEx])
+			copy(dAtA[i:], m.CodeCreators[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.CodeCreators[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.CodeIDs) > 0 {
+		dAtA6 := make([]byte, len(m.CodeIDs)*10)
+		var j5 int
+		for _, num := range m.CodeIDs {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintTypes(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ContractCodeHistoryEntry) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1125,27 +2063,175 @@ func (m *Model) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ScheduledCallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *AccessTypeParam) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Value != 0 {
-		n += 1 + sovTypes(uint64(m.Value))
-	}
-	return n
+
+func (m *ScheduledCallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Interval != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractStorageStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractStorageStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractStorageStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ByteCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ByteCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.KeyCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.KeyCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StorageDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StorageDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StorageDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AccessTypeParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovTypes(uint64(m.Value))
+	}
+	return n
 }
 
 func (m *AccessConfig) Size() (n int) {
@@ -1161,6 +2247,12 @@ func (m *AccessConfig) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1178,6 +2270,99 @@ func (m *Params) Size() (n int) {
 	if m.MaxWasmCodeSize != 0 {
 		n += 1 + sovTypes(uint64(m.MaxWasmCodeSize))
 	}
+	if len(m.AcceptedStargateQueries) > 0 {
+		for _, s := range m.AcceptedStargateQueries {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.AcceptedStargateMsgs) > 0 {
+		for _, s := range m.AcceptedStargateMsgs {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.DeniedStargateMsgs) > 0 {
+		for _, s := range m.DeniedStargateMsgs {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.GasMultiplier != 0 {
+		n += 1 + sovTypes(uint64(m.GasMultiplier))
+	}
+	if m.InstanceCost != 0 {
+		n += 1 + sovTypes(uint64(m.InstanceCost))
+	}
+	if m.CompileCost != 0 {
+		n += 1 + sovTypes(uint64(m.CompileCost))
+	}
+	if m.HumanizeCost != 0 {
+		n += 1 + sovTypes(uint64(m.HumanizeCost))
+	}
+	if m.CanonicalizeCost != 0 {
+		n += 1 + sovTypes(uint64(m.CanonicalizeCost))
+	}
+	if m.ContractMemoryLimit != 0 {
+		n += 1 + sovTypes(uint64(m.ContractMemoryLimit))
+	}
+	if m.MaxContractStorageBytes != 0 {
+		n += 1 + sovTypes(uint64(m.MaxContractStorageBytes))
+	}
+	if len(m.CodeStorageLimits) > 0 {
+		for _, e := range m.CodeStorageLimits {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.StorageDepositPerByte) > 0 {
+		for _, e := range m.StorageDepositPerByte {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.StorageDepositFromContract {
+		n += 3
+	}
+	if m.DeduplicateCodeUploads {
+		n += 3
+	}
+	if len(m.MinCallbackFee) > 0 {
+		for _, e := range m.MinCallbackFee {
+			l = e.Size()
+			n += 2 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.MaxScheduledCallbackGas != 0 {
+		n += 2 + sovTypes(uint64(m.MaxScheduledCallbackGas))
+	}
+	if m.StorageReadCostPerByte != 0 {
+		n += 2 + sovTypes(uint64(m.StorageReadCostPerByte))
+	}
+	if m.StorageWriteCostPerByte != 0 {
+		n += 2 + sovTypes(uint64(m.StorageWriteCostPerByte))
+	}
+	if m.StorageIterNextCost != 0 {
+		n += 2 + sovTypes(uint64(m.StorageIterNextCost))
+	}
+	if m.StorageDeleteCost != 0 {
+		n += 2 + sovTypes(uint64(m.StorageDeleteCost))
+	}
+	return n
+}
+
+func (m *CodeStorageLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeID != 0 {
+		n += 1 + sovTypes(uint64(m.CodeID))
+	}
+	if m.MaxBytes != 0 {
+		n += 1 + sovTypes(uint64(m.MaxBytes))
+	}
 	return n
 }
 
@@ -1237,6 +2422,39 @@ func (m *ContractInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.Paused {
+		n += 2
+	}
+	l = len(m.PendingAdmin)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.MigrationAllowlist != nil {
+		l = m.MigrationAllowlist.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *MigrationAllowlist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CodeIDs) > 0 {
+		l = 0
+		for _, e := range m.CodeIDs {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	if len(m.CodeCreators) > 0 {
+		for _, s := range m.CodeCreators {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1295,6 +2513,75 @@ func (m *Model) Size() (n int) {
 	return n
 }
 
+func (m *ScheduledCallback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovTypes(uint64(m.ID))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovTypes(uint64(m.Interval))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTypes(uint64(m.GasLimit))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractStorageStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.KeyCount != 0 {
+		n += 1 + sovTypes(uint64(m.KeyCount))
+	}
+	if m.ByteCount != 0 {
+		n += 1 + sovTypes(uint64(m.ByteCount))
+	}
+	return n
+}
+
+func (m *StorageDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1450,6 +2737,38 @@ func (m *AccessConfig) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1571,17 +2890,1263 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedStargateQueries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AcceptedStargateQueries = append(m.AcceptedStargateQueries, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedStargateMsgs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AcceptedStargateMsgs = append(m.AcceptedStargateMsgs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedStargateMsgs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedStargateMsgs = append(m.DeniedStargateMsgs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasMultiplier", wireType)
+			}
+			m.GasMultiplier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasMultiplier |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstanceCost", wireType)
+			}
+			m.InstanceCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InstanceCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompileCost", wireType)
+			}
+			m.CompileCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompileCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HumanizeCost", wireType)
+			}
+			m.HumanizeCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HumanizeCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalizeCost", wireType)
+			}
+			m.CanonicalizeCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CanonicalizeCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractMemoryLimit", wireType)
+			}
+			m.ContractMemoryLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractMemoryLimit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxContractStorageBytes", wireType)
+			}
+			m.MaxContractStorageBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxContractStorageBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeStorageLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeStorageLimits = append(m.CodeStorageLimits, CodeStorageLimit{})
+			if err := m.CodeStorageLimits[len(m.CodeStorageLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageDepositPerByte", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageDepositPerByte = append(m.StorageDepositPerByte, types.DecCoin{})
+			if err := m.StorageDepositPerByte[len(m.StorageDepositPerByte)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageDepositFromContract", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StorageDepositFromContract = bool(v != 0)
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeduplicateCodeUploads", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DeduplicateCodeUploads = bool(v != 0)
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCallbackFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinCallbackFee = append(m.MinCallbackFee, types.Coin{})
+			if err := m.MinCallbackFee[len(m.MinCallbackFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxScheduledCallbackGas", wireType)
+			}
+			m.MaxScheduledCallbackGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxScheduledCallbackGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageReadCostPerByte", wireType)
+			}
+			m.StorageReadCostPerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageReadCostPerByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageWriteCostPerByte", wireType)
+			}
+			m.StorageWriteCostPerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageWriteCostPerByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageIterNextCost", wireType)
+			}
+			m.StorageIterNextCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageIterNextCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageDeleteCost", wireType)
+			}
+			m.StorageDeleteCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageDeleteCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CodeStorageLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CodeStorageLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CodeStorageLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBytes", wireType)
+			}
+			m.MaxBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CodeInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CodeInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CodeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHash = append(m.CodeHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.CodeHash == nil {
+				m.CodeHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Builder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Builder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantiateConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InstantiateConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Created == nil {
+				m.Created = &AbsoluteTxPosition{}
+			}
+			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IBCPortID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IBCPortID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingAdmin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingAdmin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationAllowlist", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MigrationAllowlist == nil {
+				m.MigrationAllowlist = &MigrationAllowlist{}
+			}
+			if err := m.MigrationAllowlist.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MigrationAllowlist) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MigrationAllowlist: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MigrationAllowlist: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CodeIDs = append(m.CodeIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.CodeIDs) == 0 {
+					m.CodeIDs = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CodeIDs = append(m.CodeIDs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeIDs", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeCreators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeCreators = append(m.CodeCreators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -1592,7 +4157,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CodeInfo) Unmarshal(dAtA []byte) error {
+func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1615,17 +4180,55 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CodeInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractCodeHistoryEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CodeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractCodeHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			m.Operation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Operation |= ContractCodeHistoryOperationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1635,31 +4238,33 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CodeHash = append(m.CodeHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.CodeHash == nil {
-				m.CodeHash = []byte{}
+			if m.Updated == nil {
+				m.Updated = &AbsoluteTxPosition{}
+			}
+			if err := m.Updated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1669,29 +4274,81 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Msg == nil {
+				m.Msg = []byte{}
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AbsoluteTxPosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AbsoluteTxPosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AbsoluteTxPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1701,29 +4358,85 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BlockHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			m.TxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Model) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Model: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Model: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Builder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1733,29 +4446,31 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Builder = string(dAtA[iNdEx:postIndex])
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstantiateConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1765,23 +4480,24 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InstantiateConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -1805,7 +4521,7 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractInfo) Unmarshal(dAtA []byte) error {
+func (m *ScheduledCallback) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1828,17 +4544,17 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScheduledCallback: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScheduledCallback: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			m.CodeID = 0
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1848,14 +4564,14 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CodeID |= uint64(b&0x7F) << shift
+				m.ID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1883,11 +4599,11 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1915,13 +4631,13 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1931,29 +4647,31 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Label = string(dAtA[iNdEx:postIndex])
+			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Msg == nil {
+				m.Msg = []byte{}
+			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1963,115 +4681,16 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Created == nil {
-				m.Created = &AbsoluteTxPosition{}
-			}
-			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IBCPortID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.IBCPortID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ContractCodeHistoryEntry: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractCodeHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
-			m.Operation = 0
+			m.Interval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2081,16 +4700,16 @@ func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Operation |= ContractCodeHistoryOperationType(b&0x7F) << shift
+				m.Interval |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
 			}
-			m.CodeID = 0
+			m.GasLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2100,14 +4719,14 @@ func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CodeID |= uint64(b&0x7F) << shift
+				m.GasLimit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2134,47 +4753,11 @@ func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Updated == nil {
-				m.Updated = &AbsoluteTxPosition{}
-			}
-			if err := m.Updated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
-			if m.Msg == nil {
-				m.Msg = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2196,7 +4779,7 @@ func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AbsoluteTxPosition) Unmarshal(dAtA []byte) error {
+func (m *ContractStorageStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2219,17 +4802,17 @@ func (m *AbsoluteTxPosition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AbsoluteTxPosition: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractStorageStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AbsoluteTxPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractStorageStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyCount", wireType)
 			}
-			m.BlockHeight = 0
+			m.KeyCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2239,16 +4822,16 @@ func (m *AbsoluteTxPosition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
+				m.KeyCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ByteCount", wireType)
 			}
-			m.TxIndex = 0
+			m.ByteCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2258,7 +4841,7 @@ func (m *AbsoluteTxPosition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TxIndex |= uint64(b&0x7F) << shift
+				m.ByteCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2284,7 +4867,7 @@ func (m *AbsoluteTxPosition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Model) Unmarshal(dAtA []byte) error {
+func (m *StorageDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2307,51 +4890,17 @@ func (m *Model) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Model: wiretype end group for non-group")
+			return fmt.Errorf("proto: StorageDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Model: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StorageDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2361,24 +4910,24 @@ func (m *Model) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
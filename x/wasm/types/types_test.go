@@ -1,9 +1,13 @@
 package types
 
 import (
+	"bytes"
 	"strings"
 	"testing"
 
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -33,6 +37,38 @@ func TestContractInfoValidateBasic(t *testing.T) {
 			srcMutator: func(c *ContractInfo) { c.Admin = "invalid address" },
 			expError:   true,
 		},
+		"pending admin set": {
+			srcMutator: func(c *ContractInfo) {
+				c.Admin = c.Creator
+				c.PendingAdmin = sdk.AccAddress(make([]byte, sdk.AddrLen)).String()
+			},
+		},
+		"pending admin not an address": {
+			srcMutator: func(c *ContractInfo) {
+				c.Admin = c.Creator
+				c.PendingAdmin = "invalid address"
+			},
+			expError: true,
+		},
+		"pending admin without admin": {
+			srcMutator: func(c *ContractInfo) {
+				c.Admin = ""
+				c.PendingAdmin = sdk.AccAddress(make([]byte, sdk.AddrLen)).String()
+			},
+			expError: true,
+		},
+		"migration allowlist set": {
+			srcMutator: func(c *ContractInfo) {
+				c.MigrationAllowlist = &MigrationAllowlist{CodeIDs: []uint64{1}, CodeCreators: []string{c.Creator}}
+			},
+		},
+		"migration allowlist empty": {
+			srcMutator: func(c *ContractInfo) { c.MigrationAllowlist = &MigrationAllowlist{} },
+		},
+		"migration allowlist invalid": {
+			srcMutator: func(c *ContractInfo) { c.MigrationAllowlist = &MigrationAllowlist{CodeIDs: []uint64{0}} },
+			expError:   true,
+		},
 		"label empty": {
 			srcMutator: func(c *ContractInfo) { c.Label = "" },
 			expError:   true,
@@ -55,6 +91,69 @@ func TestContractInfoValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMigrationAllowlistValidateBasic(t *testing.T) {
+	myAddr := sdk.AccAddress(make([]byte, sdk.AddrLen)).String()
+	specs := map[string]struct {
+		src    MigrationAllowlist
+		expErr bool
+	}{
+		"all good": {
+			src: MigrationAllowlist{CodeIDs: []uint64{1, 2}, CodeCreators: []string{myAddr}},
+		},
+		"empty": {
+			src: MigrationAllowlist{},
+		},
+		"code id zero": {
+			src:    MigrationAllowlist{CodeIDs: []uint64{0}},
+			expErr: true,
+		},
+		"duplicate code id": {
+			src:    MigrationAllowlist{CodeIDs: []uint64{1, 1}},
+			expErr: true,
+		},
+		"invalid creator": {
+			src:    MigrationAllowlist{CodeCreators: []string{"invalid address"}},
+			expErr: true,
+		},
+		"duplicate creator": {
+			src:    MigrationAllowlist{CodeCreators: []string{myAddr, myAddr}},
+			expErr: true,
+		},
+		"upper case creator": {
+			src:    MigrationAllowlist{CodeCreators: []string{strings.ToUpper(myAddr)}},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMigrationAllowlistAllows(t *testing.T) {
+	myAddr := sdk.AccAddress(make([]byte, sdk.AddrLen)).String()
+	otherAddr := sdk.AccAddress(bytes.Repeat([]byte{1}, sdk.AddrLen)).String()
+	allowlist := MigrationAllowlist{CodeIDs: []uint64{1}, CodeCreators: []string{myAddr}}
+
+	assert.True(t, allowlist.Allows(1, otherAddr))
+	assert.True(t, allowlist.Allows(2, myAddr))
+	assert.False(t, allowlist.Allows(2, otherAddr))
+	assert.False(t, MigrationAllowlist{}.Allows(1, myAddr))
+
+	assert.True(t, MigrationAllowlist{}.IsSubsetOf(allowlist))
+	assert.True(t, MigrationAllowlist{CodeIDs: []uint64{1}}.IsSubsetOf(allowlist))
+	assert.True(t, allowlist.IsSubsetOf(allowlist))
+	assert.False(t, MigrationAllowlist{CodeIDs: []uint64{2}}.IsSubsetOf(allowlist))
+	assert.False(t, MigrationAllowlist{CodeCreators: []string{otherAddr}}.IsSubsetOf(allowlist))
+	assert.False(t, allowlist.IsSubsetOf(MigrationAllowlist{}))
+}
+
 func TestCodeInfoValidateBasic(t *testing.T) {
 	specs := map[string]struct {
 		srcMutator func(*CodeInfo)
@@ -104,6 +203,15 @@ func TestCodeInfoValidateBasic(t *testing.T) {
 			srcMutator: func(c *CodeInfo) { c.InstantiateConfig = AccessConfig{} },
 			expError:   true,
 		},
+		"Instantiate config with any of addresses": {
+			srcMutator: func(c *CodeInfo) { c.InstantiateConfig = AccessTypeAnyOfAddresses.With(make([]byte, sdk.AddrLen)) },
+		},
+		"Instantiate config with invalid addresses": {
+			srcMutator: func(c *CodeInfo) {
+				c.InstantiateConfig = AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: []string{"invalid"}}
+			},
+			expError: true,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -117,3 +225,105 @@ func TestCodeInfoValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestParseEvents(t *testing.T) {
+	contractAddr := sdk.AccAddress(bytes.Repeat([]byte{0x1}, sdk.AddrLen))
+	specs := map[string]struct {
+		src       []wasmvmtypes.EventAttribute
+		expEvents sdk.Events
+		expErr    bool
+	}{
+		"all good": {
+			src: []wasmvmtypes.EventAttribute{{Key: "action", Value: "release"}},
+			expEvents: sdk.Events{sdk.NewEvent("wasm",
+				sdk.NewAttribute("contract_address", contractAddr.String()),
+				sdk.NewAttribute("action", "release"),
+			)},
+		},
+		"empty": {
+			expEvents: sdk.Events{sdk.NewEvent("wasm", sdk.NewAttribute("contract_address", contractAddr.String()))},
+		},
+		"contract address key dropped": {
+			src:       []wasmvmtypes.EventAttribute{{Key: "contract_address", Value: "other"}},
+			expEvents: sdk.Events{sdk.NewEvent("wasm", sdk.NewAttribute("contract_address", contractAddr.String()))},
+		},
+		"reserved attribute key": {
+			src:    []wasmvmtypes.EventAttribute{{Key: "_contract_address", Value: "other"}},
+			expErr: true,
+		},
+		"reserved attribute key with leading space": {
+			src:    []wasmvmtypes.EventAttribute{{Key: " _code_id", Value: "1"}},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			got, err := ParseEvents(spec.src, contractAddr)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, spec.expEvents, got)
+		})
+	}
+}
+
+func TestNewCustomEvents(t *testing.T) {
+	contractAddr := sdk.AccAddress(bytes.Repeat([]byte{0x1}, sdk.AddrLen))
+	specs := map[string]struct {
+		src       []wasmvmtypes.Event
+		expEvents sdk.Events
+		expErr    bool
+	}{
+		"all good": {
+			src: []wasmvmtypes.Event{{
+				Type:       "transfer",
+				Attributes: []wasmvmtypes.EventAttribute{{Key: "token_id", Value: "1"}},
+			}},
+			expEvents: sdk.Events{sdk.NewEvent("wasm-transfer",
+				sdk.NewAttribute("_contract_address", contractAddr.String()),
+				sdk.NewAttribute("token_id", "1"),
+			)},
+		},
+		"multiple events": {
+			src: []wasmvmtypes.Event{{Type: "foo"}, {Type: "bar"}},
+			expEvents: sdk.Events{
+				sdk.NewEvent("wasm-foo", sdk.NewAttribute("_contract_address", contractAddr.String())),
+				sdk.NewEvent("wasm-bar", sdk.NewAttribute("_contract_address", contractAddr.String())),
+			},
+		},
+		"empty": {
+			expEvents: sdk.Events{},
+		},
+		"event type too short": {
+			src:    []wasmvmtypes.Event{{Type: " a "}},
+			expErr: true,
+		},
+		"empty attribute key": {
+			src: []wasmvmtypes.Event{{
+				Type:       "transfer",
+				Attributes: []wasmvmtypes.EventAttribute{{Key: " ", Value: "1"}},
+			}},
+			expErr: true,
+		},
+		"reserved attribute key": {
+			src: []wasmvmtypes.Event{{
+				Type:       "transfer",
+				Attributes: []wasmvmtypes.EventAttribute{{Key: "_contract_address", Value: "1"}},
+			}},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			got, err := NewCustomEvents(spec.src, contractAddr)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, spec.expEvents, got)
+		})
+	}
+}
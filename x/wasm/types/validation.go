@@ -13,6 +13,9 @@ const (
 	// MaxLabelSize is the longest label that can be used when Instantiating a contract
 	MaxLabelSize = 128
 
+	// MaxSaltSize is the longest salt that can be used when instantiating a contract with a predictable address
+	MaxSaltSize = 64
+
 	// BuildTagRegexp is a docker image regexp.
 	// We only support max 128 characters, with at least one organization name (subset of all legal names).
 	//
@@ -78,3 +81,13 @@ func validateLabel(label string) error {
 	}
 	return nil
 }
+
+func validateSalt(salt []byte) error {
+	switch n := len(salt); {
+	case n == 0:
+		return sdkerrors.Wrap(ErrEmpty, "is required")
+	case n > MaxSaltSize:
+		return sdkerrors.Wrapf(ErrLimit, "cannot be longer than %d bytes", MaxSaltSize)
+	}
+	return nil
+}
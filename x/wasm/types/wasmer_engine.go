@@ -221,3 +221,12 @@ type WasmerEngine interface {
 	// Unpin is idempotent.
 	Unpin(checksum wasmvm.Checksum) error
 }
+
+// CodeRemover is an optional extension of the WasmerEngine. When implemented, the artifacts of deleted
+// codes are removed from persistent storage. Engines without it keep the files on disk.
+type CodeRemover interface {
+	// RemoveCode deletes the original wasm code and all compiled artifacts for the given checksum
+	// from persistent storage. The caller must ensure that the code is not referenced anymore.
+	// RemoveCode is idempotent.
+	RemoveCode(checksum wasmvm.Checksum) error
+}
@@ -0,0 +1,62 @@
+package types
+
+import (
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+)
+
+// ExtensionMsg contains the contract message variants that are not part of the wasmvm CosmosMsg type in use.
+// Contracts send them within the `custom` variant, for example `{"custom":{"gov":{"vote":{...}}}}`.
+type ExtensionMsg struct {
+	Gov          *GovMsg          `json:"gov,omitempty"`
+	Distribution *DistributionMsg `json:"distribution,omitempty"`
+}
+
+// GovMsg is the contract message to take part in governance
+type GovMsg struct {
+	// Vote casts a vote with a single option
+	Vote *VoteMsg `json:"vote,omitempty"`
+	// VoteWeighted casts a vote that is split between multiple options
+	VoteWeighted *VoteWeightedMsg `json:"vote_weighted,omitempty"`
+}
+
+// VoteOption is the option of a gov vote. The values are `yes`, `no`, `abstain` and `no_with_veto`.
+type VoteOption string
+
+const (
+	VoteOptionYes        VoteOption = "yes"
+	VoteOptionNo         VoteOption = "no"
+	VoteOptionAbstain    VoteOption = "abstain"
+	VoteOptionNoWithVeto VoteOption = "no_with_veto"
+)
+
+type VoteMsg struct {
+	ProposalID uint64     `json:"proposal_id"`
+	Vote       VoteOption `json:"vote"`
+}
+
+type VoteWeightedMsg struct {
+	ProposalID uint64               `json:"proposal_id"`
+	Options    []WeightedVoteOption `json:"options"`
+}
+
+type WeightedVoteOption struct {
+	Option VoteOption `json:"option"`
+	// Weight is a decimal string, the weights of all options sum up to 1
+	Weight string `json:"weight"`
+}
+
+// DistributionMsg is the contract message to manage staking rewards and the community pool
+type DistributionMsg struct {
+	// SetWithdrawAddress sets the address that receives the staking rewards of the contract
+	SetWithdrawAddress *SetWithdrawAddressMsg `json:"set_withdraw_address,omitempty"`
+	// FundCommunityPool sends funds from the contract to the community pool
+	FundCommunityPool *FundCommunityPoolMsg `json:"fund_community_pool,omitempty"`
+}
+
+type SetWithdrawAddressMsg struct {
+	Address string `json:"address"`
+}
+
+type FundCommunityPoolMsg struct {
+	Amount wasmvmtypes.Coins `json:"amount"`
+}
@@ -0,0 +1,144 @@
+package types
+
+import (
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+)
+
+// ExtensionQuery contains the contract query variants that are not part of the wasmvm QueryRequest type in use.
+// Contracts send them within the `custom` variant, for example `{"custom":{"mint":{"inflation":{}}}}`.
+type ExtensionQuery struct {
+	Distribution *DistributionQuery `json:"distribution,omitempty"`
+	Gov          *GovQuery          `json:"gov,omitempty"`
+	Mint         *MintQuery         `json:"mint,omitempty"`
+	IBCTransfer  *IBCTransferQuery  `json:"ibc_transfer,omitempty"`
+	Wasm         *WasmQuery         `json:"wasm,omitempty"`
+}
+
+type DistributionQuery struct {
+	// DelegatorRewards returns the pending rewards of a delegator from all validators
+	DelegatorRewards *DelegatorRewardsQuery `json:"delegator_rewards,omitempty"`
+	// CommunityPool returns the funds of the community pool
+	CommunityPool *CommunityPoolQuery `json:"community_pool,omitempty"`
+}
+
+type DelegatorRewardsQuery struct {
+	Delegator string `json:"delegator"`
+}
+
+// DelegatorRewardsResponse is the response to a DelegatorRewardsQuery. Decimal amounts are truncated.
+type DelegatorRewardsResponse struct {
+	Rewards []ValidatorRewards `json:"rewards"`
+	Total   wasmvmtypes.Coins  `json:"total"`
+}
+
+type ValidatorRewards struct {
+	Validator string            `json:"validator"`
+	Reward    wasmvmtypes.Coins `json:"reward"`
+}
+
+type CommunityPoolQuery struct{}
+
+// CommunityPoolResponse is the response to a CommunityPoolQuery. Decimal amounts are truncated.
+type CommunityPoolResponse struct {
+	Pool wasmvmtypes.Coins `json:"pool"`
+}
+
+type GovQuery struct {
+	// Proposal returns the status and the current tally of a proposal
+	Proposal *ProposalQuery `json:"proposal,omitempty"`
+}
+
+type ProposalQuery struct {
+	ProposalID uint64 `json:"proposal_id"`
+}
+
+type ProposalResponse struct {
+	ProposalID uint64 `json:"proposal_id"`
+	// Status is one of `deposit_period`, `voting_period`, `passed`, `rejected` or `failed`
+	Status string      `json:"status"`
+	Tally  TallyResult `json:"tally"`
+}
+
+// TallyResult contains the voting power per option as integer strings
+type TallyResult struct {
+	Yes        string `json:"yes"`
+	No         string `json:"no"`
+	Abstain    string `json:"abstain"`
+	NoWithVeto string `json:"no_with_veto"`
+}
+
+type MintQuery struct {
+	// Inflation returns the current annual inflation rate
+	Inflation *InflationQuery `json:"inflation,omitempty"`
+}
+
+type InflationQuery struct{}
+
+type InflationResponse struct {
+	// Inflation is a decimal string
+	Inflation string `json:"inflation"`
+}
+
+type IBCTransferQuery struct {
+	// DenomTrace resolves an `ibc/<hash>` denom into its origin
+	DenomTrace *DenomTraceQuery `json:"denom_trace,omitempty"`
+}
+
+type DenomTraceQuery struct {
+	Denom string `json:"denom"`
+}
+
+type DenomTraceResponse struct {
+	Path      string `json:"path"`
+	BaseDenom string `json:"base_denom"`
+}
+
+// WasmQuery contains the metadata queries for other contracts and codes. Smart and raw queries are part of the
+// wasmvm QueryRequest.
+type WasmQuery struct {
+	// ContractInfo returns the metadata of a contract
+	ContractInfo *ContractInfoQuery `json:"contract_info,omitempty"`
+	// CodeInfo returns the metadata of a stored code
+	CodeInfo *CodeInfoQuery `json:"code_info,omitempty"`
+}
+
+type ContractInfoQuery struct {
+	ContractAddr string `json:"contract_addr"`
+}
+
+type ContractInfoQueryResponse struct {
+	CodeID  uint64 `json:"code_id"`
+	Creator string `json:"creator"`
+	// Admin is empty when the contract can not be migrated
+	Admin string `json:"admin,omitempty"`
+	Label string `json:"label"`
+	// Pinned is true when the code of the contract is pinned to the wasmvm cache
+	Pinned bool `json:"pinned"`
+	Paused bool `json:"paused"`
+	// IBCPort is set when the contract is IBC enabled
+	IBCPort string `json:"ibc_port,omitempty"`
+}
+
+type CodeInfoQuery struct {
+	CodeID uint64 `json:"code_id"`
+}
+
+type CodeInfoQueryResponse struct {
+	CodeID  uint64 `json:"code_id"`
+	Creator string `json:"creator"`
+	// Checksum is the hex encoded sha256 hash of the wasm code
+	Checksum string `json:"checksum"`
+	// Pinned is true when the code is pinned to the wasmvm cache
+	Pinned            bool                 `json:"pinned"`
+	InstantiateConfig AccessConfigResponse `json:"instantiate_config"`
+}
+
+// AccessConfigResponse is the JSON representation of an AccessConfig for contracts
+type AccessConfigResponse struct {
+	// Permission is the name of the access type, for example "Everybody"
+	Permission string `json:"permission"`
+	// Address is set for the OnlyAddress and OnlyContract types
+	Address string `json:"address,omitempty"`
+	// Addresses is set for the AnyOfAddresses type
+	Addresses []string `json:"addresses,omitempty"`
+}